@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: cosmos/crypto/keyring/v1/remote_signer.proto
+
+package keyringv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RemoteSigner_Keys_FullMethodName         = "/cosmos.crypto.keyring.v1.RemoteSigner/Keys"
+	RemoteSigner_Key_FullMethodName          = "/cosmos.crypto.keyring.v1.RemoteSigner/Key"
+	RemoteSigner_KeyByAddress_FullMethodName = "/cosmos.crypto.keyring.v1.RemoteSigner/KeyByAddress"
+	RemoteSigner_Sign_FullMethodName         = "/cosmos.crypto.keyring.v1.RemoteSigner/Sign"
+)
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RemoteSigner is the protocol between a keyring using the "remote" backend and
+// an external signer process. The signer holds the private keys and only ever
+// returns public keys and signatures, the keyring never handles key material.
+// The connection is either a unix socket or a mutually authenticated TLS one.
+type RemoteSignerClient interface {
+	// Keys lists the keys of the signer.
+	Keys(ctx context.Context, in *RemoteSignerKeysRequest, opts ...grpc.CallOption) (*RemoteSignerKeysResponse, error)
+	// Key returns a key of the signer by name, it fails with the NOT_FOUND code
+	// if the signer has no such key.
+	Key(ctx context.Context, in *RemoteSignerKeyRequest, opts ...grpc.CallOption) (*RemoteSignerKeyResponse, error)
+	// KeyByAddress returns a key of the signer by address, it fails with the
+	// NOT_FOUND code if the signer has no such key.
+	KeyByAddress(ctx context.Context, in *RemoteSignerKeyByAddressRequest, opts ...grpc.CallOption) (*RemoteSignerKeyByAddressResponse, error)
+	// Sign signs the sign bytes of a transaction with a key of the signer.
+	Sign(ctx context.Context, in *RemoteSignerSignRequest, opts ...grpc.CallOption) (*RemoteSignerSignResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteSignerClient(cc grpc.ClientConnInterface) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) Keys(ctx context.Context, in *RemoteSignerKeysRequest, opts ...grpc.CallOption) (*RemoteSignerKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoteSignerKeysResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_Keys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Key(ctx context.Context, in *RemoteSignerKeyRequest, opts ...grpc.CallOption) (*RemoteSignerKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoteSignerKeyResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_Key_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) KeyByAddress(ctx context.Context, in *RemoteSignerKeyByAddressRequest, opts ...grpc.CallOption) (*RemoteSignerKeyByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoteSignerKeyByAddressResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_KeyByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *RemoteSignerSignRequest, opts ...grpc.CallOption) (*RemoteSignerSignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoteSignerSignResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+// All implementations must embed UnimplementedRemoteSignerServer
+// for forward compatibility.
+//
+// RemoteSigner is the protocol between a keyring using the "remote" backend and
+// an external signer process. The signer holds the private keys and only ever
+// returns public keys and signatures, the keyring never handles key material.
+// The connection is either a unix socket or a mutually authenticated TLS one.
+type RemoteSignerServer interface {
+	// Keys lists the keys of the signer.
+	Keys(context.Context, *RemoteSignerKeysRequest) (*RemoteSignerKeysResponse, error)
+	// Key returns a key of the signer by name, it fails with the NOT_FOUND code
+	// if the signer has no such key.
+	Key(context.Context, *RemoteSignerKeyRequest) (*RemoteSignerKeyResponse, error)
+	// KeyByAddress returns a key of the signer by address, it fails with the
+	// NOT_FOUND code if the signer has no such key.
+	KeyByAddress(context.Context, *RemoteSignerKeyByAddressRequest) (*RemoteSignerKeyByAddressResponse, error)
+	// Sign signs the sign bytes of a transaction with a key of the signer.
+	Sign(context.Context, *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error)
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+// UnimplementedRemoteSignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRemoteSignerServer struct{}
+
+func (UnimplementedRemoteSignerServer) Keys(context.Context, *RemoteSignerKeysRequest) (*RemoteSignerKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Keys not implemented")
+}
+func (UnimplementedRemoteSignerServer) Key(context.Context, *RemoteSignerKeyRequest) (*RemoteSignerKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Key not implemented")
+}
+func (UnimplementedRemoteSignerServer) KeyByAddress(context.Context, *RemoteSignerKeyByAddressRequest) (*RemoteSignerKeyByAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KeyByAddress not implemented")
+}
+func (UnimplementedRemoteSignerServer) Sign(context.Context, *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedRemoteSignerServer) mustEmbedUnimplementedRemoteSignerServer() {}
+func (UnimplementedRemoteSignerServer) testEmbeddedByValue()                      {}
+
+// UnsafeRemoteSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteSignerServer will
+// result in compilation errors.
+type UnsafeRemoteSignerServer interface {
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+func RegisterRemoteSignerServer(s grpc.ServiceRegistrar, srv RemoteSignerServer) {
+	// If the following call panics, it indicates UnimplementedRemoteSignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RemoteSigner_ServiceDesc, srv)
+}
+
+func _RemoteSigner_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_Keys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Keys(ctx, req.(*RemoteSignerKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_Key_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Key(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_Key_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Key(ctx, req.(*RemoteSignerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_KeyByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerKeyByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).KeyByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_KeyByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).KeyByAddress(ctx, req.(*RemoteSignerKeyByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*RemoteSignerSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteSigner_ServiceDesc is the grpc.ServiceDesc for RemoteSigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteSigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crypto.keyring.v1.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Keys",
+			Handler:    _RemoteSigner_Keys_Handler,
+		},
+		{
+			MethodName: "Key",
+			Handler:    _RemoteSigner_Key_Handler,
+		},
+		{
+			MethodName: "KeyByAddress",
+			Handler:    _RemoteSigner_KeyByAddress_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSigner_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crypto/keyring/v1/remote_signer.proto",
+}
@@ -169,6 +169,10 @@ func (app *BaseApp) Query(ctx context.Context, req *abci.RequestQuery) (resp *ab
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
 	defer func() {
 		if r := recover(); r != nil {
+			if err, ok := storePrunedError(r); ok {
+				resp = sdkerrors.QueryResult(err, app.trace)
+				return
+			}
 			resp = sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), app.trace)
 		}
 	}()
@@ -434,8 +438,9 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 	// Overlap is undesirable, since `internalFinalizeBlock` and `PrepareProoposal` could share access to
 	// in-memory structs depending on application implementation.
 	// No-op if OE is not enabled.
-	// Similar call to Abort() is done in `ProcessProposal`.
-	app.optimisticExec.Abort()
+	// Similar call to Abort() is done in `ProcessProposal`. A completed OE is kept for the
+	// next rounds if its cache is enabled.
+	app.optimisticExec.AbortAndCache(app.stateManager.GetState(execModeFinalize))
 
 	// Always reset state given that PrepareProposal can timeout and be called
 	// again in a subsequent round.
@@ -505,6 +510,10 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
 	}
 
+	if app.txReorderer != nil {
+		resp.Txs = app.reorderTxs(ctx, resp.Txs)
+	}
+
 	return resp, nil
 }
 
@@ -552,8 +561,8 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
 	if req.Height > app.initialHeight {
-		// abort any running OE
-		app.optimisticExec.Abort()
+		// abort any running OE, keeping its result if it completed and the cache is enabled
+		app.optimisticExec.AbortAndCache(app.stateManager.GetState(execModeFinalize))
 		app.stateManager.SetState(execModeFinalize, app.cms, header, app.logger, app.streamingManager)
 	}
 
@@ -615,9 +624,12 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// After the first block has been processed, the next blocks will get executed
 	// optimistically, so that when the ABCI client calls `FinalizeBlock` the app
 	// can have a response ready.
+	// A proposal of an earlier round proposed again is not re-executed, its cached result
+	// is reused by FinalizeBlock.
 	if resp.Status == abci.ResponseProcessProposal_ACCEPT &&
 		app.optimisticExec.Enabled() &&
-		req.Height > app.initialHeight {
+		req.Height > app.initialHeight &&
+		!app.optimisticExec.Cached(req.Hash) {
 		app.optimisticExec.Execute(req)
 	}
 
@@ -1001,6 +1013,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 
 		// only return if we are not aborting
 		if !aborted {
+			app.optimisticExec.ClearCache()
 			if res != nil {
 				whStart := time.Now()
 				res.AppHash = app.workingHash()
@@ -1015,6 +1028,27 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 		app.optimisticExec.Reset()
 	}
 
+	// reuse the OE result of the proposal from an earlier round if it's cached
+	if cachedRes, cachedState, ok := app.optimisticExec.TakeCached(req.Hash); ok {
+		if inst != nil {
+			inst.OECacheHits.Add(app.metricsCtx(), 1)
+		}
+		finalizeState := cachedState.(*state.State)
+		// the state is bound to the context of the aborted OE, which is canceled
+		finalizeState.SetContext(finalizeState.Context().WithContext(context.Background()))
+		app.stateManager.ReplaceState(execModeFinalize, finalizeState)
+		if checkState := app.stateManager.GetState(execModeCheck); checkState != nil {
+			checkState.SetContext(checkState.Context().
+				WithBlockGasMeter(finalizeState.Context().BlockGasMeter()).
+				WithHeaderHash(req.Hash))
+		}
+
+		whStart := time.Now()
+		cachedRes.AppHash = app.workingHash()
+		measureSince(app.metricsCtx(), func() metric.Int64Histogram { return inst.WorkingHashTime }, whStart)
+		return cachedRes, nil
+	}
+
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
 	nonOEStart := time.Now()
 	res, err = app.internalFinalizeBlock(context.Background(), req)
@@ -1071,17 +1105,29 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		rms.SetCommitHeader(header)
 	}
 
-	app.cms.Commit()
-
 	resp := &abci.ResponseCommit{
 		RetainHeight: retainHeight,
 	}
 
 	abciListeners := app.streamingManager.ABCIListeners
+	var changeSet []*storetypes.StoreKVPair
+	if len(abciListeners) > 0 {
+		changeSet = app.cms.PopStateCache()
+
+		// the file listeners write the block before the state is committed, so that the node
+		// replays the block instead of losing it if it stops in between
+		for _, abciListener := range abciListeners {
+			if listener, ok := abciListener.(fileListener); ok {
+				listener.listenCommit(finalizeState.Context(), *resp, changeSet)
+			}
+		}
+	}
+
+	app.cms.Commit()
+
 	if len(abciListeners) > 0 {
 		ctx := finalizeState.Context()
 		blockHeight := ctx.BlockHeight()
-		changeSet := app.cms.PopStateCache()
 
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
@@ -1297,6 +1343,18 @@ func (app *BaseApp) handleQueryGRPC(goCtx context.Context, handler GRPCQueryHand
 	return resp
 }
 
+// storePrunedError returns the error a store panics with when it is queried at
+// a height pruned by the pruning strategy of that store, while the other stores
+// still hold it.
+func storePrunedError(r any) (error, bool) {
+	err, ok := r.(error)
+	if !ok {
+		return nil, false
+	}
+
+	return err, errors.Is(err, storetypes.ErrStorePruned)
+}
+
 func gRPCErrorToSDKError(err error) error {
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
@@ -30,6 +30,7 @@ import (
 	"cosmossdk.io/log/v2"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
@@ -2537,6 +2538,74 @@ func TestOptimisticExecution(t *testing.T) {
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
 
+func TestOptimisticExecution_CachedRounds(t *testing.T) {
+	var (
+		executions atomic.Int32
+		optimistic *oe.OptimisticExecution
+	)
+	// the pre blocker writes the hash of the executed proposal, so the app hash depends on it
+	preBlockerOpt := func(app *baseapp.BaseApp) {
+		app.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			executions.Add(1)
+			ctx.KVStore(capKey1).Set([]byte("hash"), req.Hash)
+			return &sdk.ResponsePreBlock{}, nil
+		})
+	}
+	// the reused state must not carry the canceled context of the aborted execution
+	var precommitErr error
+	precommiterOpt := func(app *baseapp.BaseApp) {
+		app.SetPrecommiter(func(ctx sdk.Context) {
+			precommitErr = ctx.Err()
+		})
+	}
+	suite := NewBaseAppSuite(t, preBlockerOpt, precommiterOpt, baseapp.SetOptimisticExecution(
+		oe.WithCacheSize(2),
+		func(o *oe.OptimisticExecution) { optimistic = o },
+	))
+	reference := NewBaseAppSuite(t, preBlockerOpt)
+
+	for _, app := range []*baseapp.BaseApp{suite.baseApp, reference.baseApp} {
+		_, err := app.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+		require.NoError(t, err)
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+	executions.Store(0)
+
+	processProposal := func(hash string) {
+		resp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Hash: []byte(hash)})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resp.Status)
+		if optimistic.Initialized() {
+			_, err = optimistic.WaitResult()
+			require.NoError(t, err)
+		}
+	}
+
+	// round 0 and 1 execute different proposals, the proposal of round 0 is proposed again in round 2
+	processProposal("proposal-a")
+	processProposal("proposal-b")
+	require.True(t, optimistic.Cached([]byte("proposal-a")))
+	processProposal("proposal-a")
+	require.Equal(t, int32(2), executions.Load())
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Hash: []byte("proposal-a")})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), executions.Load(), "the cached execution must be reused")
+	require.False(t, optimistic.Cached([]byte("proposal-b")))
+
+	expected, err := reference.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Hash: []byte("proposal-a")})
+	require.NoError(t, err)
+	require.Equal(t, expected.AppHash, res.AppHash)
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.NoError(t, precommitErr)
+	require.Equal(t, int64(2), suite.baseApp.LastBlockHeight())
+}
+
 func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
 		TxPriority:      mempool.NewDefaultTxPriority(),
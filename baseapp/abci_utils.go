@@ -216,11 +216,19 @@ type (
 	}
 )
 
+// NewDefaultProposalHandler returns a DefaultProposalHandler selecting the
+// transactions of the provided mempool. If the mempool is a
+// mempool.LaneMempool, the handler enforces the block space of every lane.
 func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) *DefaultProposalHandler {
+	txSelector := NewDefaultTxSelector()
+	if laneMempool, ok := mp.(*mempool.LaneMempool); ok {
+		txSelector = NewLaneTxSelector(laneMempool)
+	}
+
 	return &DefaultProposalHandler{
 		mempool:          mp,
 		txVerifier:       txVerifier,
-		txSelector:       NewDefaultTxSelector(),
+		txSelector:       txSelector,
 		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
 	}
 }
@@ -335,9 +343,9 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 				// so we add them and continue given that we don't need to check the sequence.
 				shouldAdd := true
 				for _, signer := range signerData {
-					seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+					seq, ok := selectedTxsSignersSeqs[signer.Sender()]
 					if !ok {
-						txSignersSeqs[signer.Signer.String()] = signer.Sequence
+						txSignersSeqs[signer.Sender()] = signer.Sequence
 						continue
 					}
 
@@ -348,7 +356,7 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 						shouldAdd = false
 						break
 					}
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
+					txSignersSeqs[signer.Sender()] = signer.Sequence
 				}
 				if !shouldAdd {
 					return true
@@ -423,6 +431,11 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
+//
+// If the mempool is a mempool.LaneMempool, the proposal is also rejected if its
+// transactions are not ordered by lane or if a lane exceeds its block space.
+// As the exact MaxTxBytes of the proposal is not known in ProcessProposal, the
+// byte limits of the lanes are verified against the block MaxBytes.
 func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	// If the mempool is nil or NoOp we simply return ACCEPT,
 	// because PrepareProposal may have included txs that could fail verification.
@@ -431,12 +444,20 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		return NoOpProcessProposal()
 	}
 
+	laneMempool, hasLanes := h.mempool.(*mempool.LaneMempool)
+
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
 		var totalTxGas uint64
 
-		var maxBlockGas int64
+		var maxBlockGas, maxBlockBytes int64
 		if b := ctx.ConsensusParams().Block; b != nil {
 			maxBlockGas = b.MaxGas
+			maxBlockBytes = b.MaxBytes
+		}
+
+		var lanes *laneVerifier
+		if hasLanes {
+			lanes = newLaneVerifier(laneMempool, maxBlockBytes, maxBlockGas)
 		}
 
 		for _, txBytes := range req.Txs {
@@ -445,12 +466,20 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 			}
 
+			txGas := txGasForBlockAccounting(tx, gasWanted)
 			if maxBlockGas > 0 {
-				totalTxGas += txGasForBlockAccounting(tx, gasWanted)
+				totalTxGas += txGas
 				if totalTxGas > uint64(maxBlockGas) {
 					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 				}
 			}
+
+			if lanes != nil {
+				if err := lanes.verifyTx(tx, txBytes, txGas); err != nil {
+					ctx.Logger().Debug("proposal rejected by lane verification", "err", err)
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+			}
 		}
 
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
@@ -507,6 +536,15 @@ type TxSelector interface {
 	SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte, gasWanted uint64) bool
 }
 
+// TxReorderer defines a helper type that reorders the transactions of a proposal
+// after PrepareProposal selected them, e.g. to reduce conflicts during parallel
+// execution. Implementations must return a permutation of txs, must keep the
+// relative order of transactions of the same sender and must be deterministic
+// for a given input.
+type TxReorderer interface {
+	ReorderTxs(ctx sdk.Context, txs [][]byte) [][]byte
+}
+
 type defaultTxSelector struct {
 	totalTxBytes uint64
 	totalTxGas   uint64
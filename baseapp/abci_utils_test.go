@@ -20,6 +20,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
@@ -772,6 +773,120 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_RejectsOverGasTxs() {
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LaneMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx       sdk.Tx
+		bz       []byte
+		priority int64
+	}
+	newTestTx := func(value, secret string, priority int64) testTx {
+		tx := buildMsg(s.T(), txConfig, []byte(value), [][]byte{[]byte(secret)}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		return testTx{tx: tx, bz: bz, priority: priority}
+	}
+	oracleTx1 := newTestTx("oracle1", "secret1", 2)
+	oracleTx2 := newTestTx("oracle2", "secret2", 1)
+	defaultTx := newTestTx("default", "secret3", 10)
+	size := func(txs ...testTx) int64 {
+		var size int64
+		for _, tx := range txs {
+			size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.bz})
+		}
+		return size
+	}
+
+	// the oracle lane holds the txs whose value starts with "oracle" and is
+	// allotted half of the block space
+	isOracleTx := func(tx sdk.Tx) bool {
+		return bytes.HasPrefix(tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue).Value, []byte("oracle"))
+	}
+	newLaneMempool := func() mempool.Mempool {
+		return mempool.NewLaneMempool(
+			mempool.Lane{Name: "oracle", Mempool: mempool.DefaultPriorityMempool(), Match: isOracleTx, MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(5, 1)},
+			mempool.Lane{Name: "default", Mempool: mempool.DefaultPriorityMempool(), Match: mempool.MatchAll},
+		)
+	}
+	newPriorityMempool := func() mempool.Mempool { return mempool.DefaultPriorityMempool() }
+
+	testCases := map[string]struct {
+		// proposer is the mempool of the node preparing the proposal
+		proposer mempool.Mempool
+		txs      []testTx
+		maxBytes int64
+		expTxs   []testTx
+		expLanes abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"lanes prepare a proposal ordered by lane": {
+			proposer: newLaneMempool(),
+			txs:      []testTx{defaultTx, oracleTx1},
+			maxBytes: 2 * size(defaultTx, oracleTx1),
+			expTxs:   []testTx{oracleTx1, defaultTx},
+			expLanes: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lanes prepare a proposal within the lane space": {
+			proposer: newLaneMempool(),
+			txs:      []testTx{oracleTx1, oracleTx2, defaultTx},
+			maxBytes: size(oracleTx1, defaultTx) + 1,
+			expTxs:   []testTx{oracleTx1, defaultTx},
+			expLanes: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"proposal not ordered by lane": {
+			proposer: newPriorityMempool(),
+			txs:      []testTx{oracleTx1, defaultTx},
+			maxBytes: 2 * size(defaultTx, oracleTx1),
+			expTxs:   []testTx{defaultTx, oracleTx1},
+			expLanes: abci.ResponseProcessProposal_REJECT,
+		},
+		"proposal exceeding the lane space": {
+			proposer: newPriorityMempool(),
+			txs:      []testTx{oracleTx1, oracleTx2},
+			maxBytes: size(oracleTx1, oracleTx2) + 1,
+			expTxs:   []testTx{oracleTx1, oracleTx2},
+			expLanes: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			app := mock.NewMockProposalTxVerifier(gomock.NewController(s.T()))
+			for _, tx := range []testTx{oracleTx1, oracleTx2, defaultTx} {
+				app.EXPECT().PrepareProposalVerifyTx(tx.tx).Return(tx.bz, nil).AnyTimes()
+				app.EXPECT().ProcessProposalVerifyTx(tx.bz).Return(tx.tx, uint64(0), nil).AnyTimes()
+			}
+			ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxBytes: tc.maxBytes},
+			})
+
+			for _, tx := range tc.txs {
+				s.Require().NoError(tc.proposer.Insert(ctx.WithPriority(tx.priority), tx.tx, mempool.InsertOption{}))
+			}
+			proposer := baseapp.NewDefaultProposalHandler(tc.proposer, app)
+			prepResp, err := proposer.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: tc.maxBytes})
+			s.Require().NoError(err)
+			expTxs := make([][]byte, len(tc.expTxs))
+			for i, tx := range tc.expTxs {
+				expTxs[i] = tx.bz
+			}
+			s.Require().Equal(expTxs, prepResp.Txs)
+
+			// the proposal is accepted by the node that prepared it
+			procResp, err := proposer.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepResp.Txs})
+			s.Require().NoError(err)
+			s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, procResp.Status)
+
+			lanes := baseapp.NewDefaultProposalHandler(newLaneMempool(), app)
+			procResp, err = lanes.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepResp.Txs})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expLanes, procResp.Status)
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
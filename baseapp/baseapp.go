@@ -165,6 +165,14 @@ type BaseApp struct {
 
 	// Optional alternative tx runner, used for block-stm parallel transaction execution. If nil, default txRunner is used.
 	txRunner sdk.TxRunner
+
+	// Optional reordering of the transactions returned by the PrepareProposal handler, used to produce blocks
+	// that execute well with block-stm. If nil, the proposal is returned as is.
+	txReorderer TxReorderer
+
+	// trackTxWrites records the keys written by the messages of each transaction, post handlers can inspect
+	// them with `WrittenKeys`, e.g. to enforce declared access lists.
+	trackTxWrites bool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -932,6 +940,9 @@ func (app *BaseApp) RunTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
 	runMsgCtx, msCache := app.cacheTxContext(ctx)
+	if app.trackTxWrites {
+		runMsgCtx = runMsgCtx.WithMultiStore(newWriteTrackingMultiStore(msCache))
+	}
 
 	// Attempt to execute all messages and only update state if all messages pass
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
@@ -949,6 +960,10 @@ func (app *BaseApp) RunTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex
 		// We clear this to correctly order events without duplicates.
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		// the writes of the post handler are not tracked, it only sees the ones of the messages
+		if ms, ok := runMsgCtx.MultiStore().(*writeTrackingMultiStore); ok {
+			postCtx = postCtx.WithMultiStore(ms.untrackedView())
+		}
 
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
 		if errPostHandler != nil {
@@ -1175,6 +1190,20 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the tx runner, e.g. the block-stm conflict report writer installed by blockexec.Apply
+	if err := closeTxRunner(app.txRunner); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Close the file streaming listener, opened by RegisterStreamingServices
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if listener, ok := abciListener.(fileListener); ok {
+			if err := listener.listener.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -467,6 +467,26 @@ func (r unwrappableRunner) Run(ctx context.Context, ms storetypes.MultiStore, tx
 
 func (r unwrappableRunner) Unwrap() sdk.TxRunner { return r.inner }
 
+// closingRunner records whether it was closed.
+type closingRunner struct {
+	sdk.TxRunner
+	closed bool
+}
+
+func (r *closingRunner) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestCloseTxRunner(t *testing.T) {
+	bap := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	runner := &closingRunner{TxRunner: txnrunner.NewDefaultRunner(nil)}
+	bap.SetBlockSTMTxRunner(unwrappableRunner{inner: runner})
+
+	require.NoError(t, bap.Close())
+	require.True(t, runner.closed)
+}
+
 func TestBlockGasMeterParallelRunnerPanic(t *testing.T) {
 	db := dbm.NewMemDB()
 
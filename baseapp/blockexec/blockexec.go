@@ -3,13 +3,16 @@ package blockexec
 import (
 	"cmp"
 	"fmt"
+	"path/filepath"
 	goruntime "runtime"
 	"slices"
+	"sync"
 
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/baseapp/txnrunner"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -23,7 +26,9 @@ type Option func(*options)
 type options struct {
 	defaultExecutor    string
 	defaultPreEstimate bool
+	defaultReorder     bool
 	wrapRunner         func(sdk.TxRunner) sdk.TxRunner
+	commutativeKeys    map[string][][]byte
 }
 
 // WithDefaultExecutor sets the executor used when appOpts has no block-executor
@@ -39,12 +44,35 @@ func WithDefaultPreEstimate(v bool) Option {
 	return func(o *options) { o.defaultPreEstimate = v }
 }
 
+// WithDefaultReorderProposals sets whether proposals are reordered for block-stm
+// when appOpts has no block-stm-reorder-proposals value; a bound flag/app.toml
+// value still wins.
+func WithDefaultReorderProposals(v bool) Option {
+	return func(o *options) { o.defaultReorder = v }
+}
+
 // WithRunnerWrap wraps the TxRunner before installation — e.g. EVM chains
 // use this so PatchTxResponses runs once per block regardless of executor.
 func WithRunnerWrap(wrap func(sdk.TxRunner) sdk.TxRunner) Option {
 	return func(o *options) { o.wrapRunner = wrap }
 }
 
+// WithCommutativeKeys marks the keys of the store under the prefixes as
+// commutative for block-stm, all of its keys if no prefix is given — e.g. the
+// per-transaction slots of the deferred accumulators in `types/accumulator`,
+// such as the bank virtual balances. See STMRunner.SetCommutativeKeys.
+func WithCommutativeKeys(storeName string, prefixes ...[]byte) Option {
+	return func(o *options) {
+		if o.commutativeKeys == nil {
+			o.commutativeKeys = make(map[string][][]byte)
+		}
+		if len(prefixes) == 0 {
+			prefixes = [][]byte{{}}
+		}
+		o.commutativeKeys[storeName] = append(o.commutativeKeys[storeName], prefixes...)
+	}
+}
+
 // Apply resolves the executor from appOpts (with Option overrides) and
 // installs the corresponding TxRunner on bApp. Unknown executors panic.
 func Apply(
@@ -67,9 +95,12 @@ func Apply(
 		executor = o.defaultExecutor
 	}
 
-	var runner sdk.TxRunner
+	var (
+		runner       sdk.TxRunner
+		reportWriter *txnrunner.ReportWriter
+	)
 	switch executor {
-	case config.BlockExecutorBlockSTM:
+	case config.BlockExecutorBlockSTM, config.BlockExecutorVerify:
 		workers := cast.ToInt(appOpts.Get(server.FlagBlockSTMWorkers))
 		if workers <= 0 {
 			workers = min(goruntime.GOMAXPROCS(0), goruntime.NumCPU())
@@ -85,9 +116,38 @@ func Apply(
 			return cmp.Compare(a.Name(), b.Name())
 		})
 
+		reorder := o.defaultReorder
+		if v := appOpts.Get(server.FlagBlockSTMReorder); v != nil {
+			reorder = cast.ToBool(v)
+		}
+
 		bApp.Logger().Info("installing block-stm tx runner",
-			"workers", workers, "pre_estimate", preEstimate, "wrapped", o.wrapRunner != nil)
-		runner = txnrunner.NewSTMRunner(txDecoder, sorted, workers, preEstimate, coinDenom)
+			"workers", workers, "pre_estimate", preEstimate, "reorder_proposals", reorder, "wrapped", o.wrapRunner != nil)
+		stmRunner := txnrunner.NewSTMRunner(txDecoder, sorted, workers, preEstimate, coinDenom)
+		for storeName, prefixes := range o.commutativeKeys {
+			stmRunner.SetCommutativeKeys(storeName, prefixes...)
+		}
+		if reorder {
+			bApp.SetTxReorderer(stmRunner)
+		}
+		if cast.ToBool(appOpts.Get(server.FlagBlockSTMReport)) {
+			reportDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "blockstm-reports")
+			maxFiles := config.DefaultBlockSTMConflictReportMaxFiles
+			if v := appOpts.Get(server.FlagBlockSTMReportMaxFiles); v != nil {
+				maxFiles = cast.ToInt(v)
+			}
+			bApp.Logger().Info("writing block-stm conflict reports", "dir", reportDir, "max_files", maxFiles)
+			writer := txnrunner.NewReportWriter(reportDir, maxFiles, func(height int64, err error) {
+				bApp.Logger().Error("failed to write block-stm conflict report", "height", height, "err", err)
+			})
+			stmRunner.SetConflictReporter(writer.Write)
+			reportWriter = writer
+		}
+		runner = stmRunner
+		if executor == config.BlockExecutorVerify {
+			bApp.Logger().Info("verifying block-stm against sequential execution, the sequential outcome is committed")
+			runner = txnrunner.NewVerifyRunner(txDecoder, stmRunner)
+		}
 
 		// Disable the block gas meter before installing a parallel runner:
 		// SetBlockSTMTxRunner panics if the meter is still enabled.
@@ -108,5 +168,27 @@ func Apply(
 	if o.wrapRunner != nil {
 		runner = o.wrapRunner(runner)
 	}
+	if reportWriter != nil {
+		runner = &reportingRunner{TxRunner: runner, writer: reportWriter}
+	}
 	bApp.SetBlockSTMTxRunner(runner)
 }
+
+// reportingRunner stops the conflict report writer of the runner when the app is closed, see
+// BaseApp.Close.
+type reportingRunner struct {
+	sdk.TxRunner
+	writer *txnrunner.ReportWriter
+	once   sync.Once
+}
+
+// Unwrap returns the wrapped runner.
+func (r *reportingRunner) Unwrap() sdk.TxRunner {
+	return r.TxRunner
+}
+
+// Close writes the queued reports and stops the writer.
+func (r *reportingRunner) Close() error {
+	r.once.Do(r.writer.Close)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/baseapp/blockexec"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -48,6 +49,14 @@ func TestApplyDefaultExecutor(t *testing.T) {
 		require.True(t, installedParallel(bApp))
 	})
 
+	t.Run("verify installs a parallel runner", func(t *testing.T) {
+		bApp := newApp(t)
+		blockexec.Apply(bApp, simtestutil.AppOptionsMap{
+			server.FlagBlockExecutor: config.BlockExecutorVerify,
+		}, nil, nil, denom)
+		require.True(t, installedParallel(bApp))
+	})
+
 	t.Run("appOpts flag overrides the default", func(t *testing.T) {
 		bApp := newApp(t)
 		blockexec.Apply(bApp, simtestutil.AppOptionsMap{
@@ -58,3 +67,17 @@ func TestApplyDefaultExecutor(t *testing.T) {
 		require.False(t, installedParallel(bApp))
 	})
 }
+
+func TestApplyClosesReportWriter(t *testing.T) {
+	bApp := newApp(t)
+	blockexec.Apply(bApp, simtestutil.AppOptionsMap{
+		server.FlagBlockExecutor:  config.BlockExecutorBlockSTM,
+		server.FlagBlockSTMReport: true,
+		flags.FlagHome:            t.TempDir(),
+	}, nil, nil, denom)
+	require.True(t, installedParallel(bApp))
+
+	// the writer is stopped once, however many times the app is closed
+	require.NoError(t, bApp.Close())
+	require.NoError(t, bApp.Close())
+}
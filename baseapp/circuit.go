@@ -6,3 +6,21 @@ import "context"
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// CircuitBreakers is a CircuitBreaker allowing a message only if all of its circuit breakers
+// allow it, e.g. to use x/circuit along with the x/upgrade keeper.
+type CircuitBreakers []CircuitBreaker
+
+var _ CircuitBreaker = CircuitBreakers{}
+
+// IsAllowed implements CircuitBreaker, the circuit breakers are called in order until one of
+// them rejects the message.
+func (cbs CircuitBreakers) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	for _, cb := range cbs {
+		allowed, err := cb.IsAllowed(ctx, typeURL)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,50 @@
+package baseapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// circuitBreakerFunc is a CircuitBreaker recording its calls.
+type circuitBreakerFunc struct {
+	calls   *int
+	allowed bool
+	err     error
+}
+
+func (cb circuitBreakerFunc) IsAllowed(context.Context, string) (bool, error) {
+	*cb.calls++
+	return cb.allowed, cb.err
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	var calls int
+	allow := circuitBreakerFunc{calls: &calls, allowed: true}
+	reject := circuitBreakerFunc{calls: &calls}
+	fail := circuitBreakerFunc{calls: &calls, err: errors.New("failure")}
+
+	allowed, err := baseapp.CircuitBreakers{allow, allow}.IsAllowed(context.Background(), "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.Equal(t, 2, calls)
+
+	// the breakers after the first rejecting one are not called
+	calls = 0
+	allowed, err = baseapp.CircuitBreakers{allow, reject, allow}.IsAllowed(context.Background(), "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, 2, calls)
+
+	allowed, err = baseapp.CircuitBreakers{fail, allow}.IsAllowed(context.Background(), "/cosmos.bank.v1beta1.MsgSend")
+	require.ErrorContains(t, err, "failure")
+	require.False(t, allowed)
+
+	allowed, err = baseapp.CircuitBreakers{}.IsAllowed(context.Background(), "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -66,7 +66,11 @@ func (app *BaseApp) grpcQueryInterceptor(skipCheckHeader bool) grpc.UnaryServerI
 				case storetypes.ErrorOutOfGas:
 					err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "Query gas limit exceeded: %v, out of gas in location: %v", sdkCtx.GasMeter().Limit(), rType.Descriptor)
 				default:
-					panic(r)
+					prunedErr, ok := storePrunedError(r)
+					if !ok {
+						panic(r)
+					}
+					err = prunedErr
 				}
 			}
 		}()
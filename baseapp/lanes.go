@@ -0,0 +1,162 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ TxSelector = (*laneTxSelector)(nil)
+
+// laneTxSelector is a TxSelector enforcing the block space of every lane of a
+// mempool.LaneMempool, on top of the block limits. It expects the transactions
+// to be offered lane after lane, as the LaneMempool selects them, and skips the
+// transactions that would break the lane order.
+type laneTxSelector struct {
+	mempool *mempool.LaneMempool
+
+	totalTxBytes uint64
+	totalTxGas   uint64
+	selectedTxs  [][]byte
+
+	lane        int
+	laneTxBytes uint64
+	laneTxGas   uint64
+}
+
+// NewLaneTxSelector returns a TxSelector allotting to every lane of the
+// provided mempool at most its share of the block bytes and gas.
+func NewLaneTxSelector(mp *mempool.LaneMempool) TxSelector {
+	return &laneTxSelector{mempool: mp}
+}
+
+func (ts *laneTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *laneTxSelector) Clear() {
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.selectedTxs = nil
+	ts.lane = 0
+	ts.laneTxBytes = 0
+	ts.laneTxGas = 0
+}
+
+func (ts *laneTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte, gasWanted uint64) bool {
+	if lane := ts.mempool.LaneIndex(memTx); lane >= ts.lane {
+		if lane != ts.lane {
+			ts.lane = lane
+			ts.laneTxBytes = 0
+			ts.laneTxGas = 0
+		}
+
+		txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+		txGas := txGasForBlockAccounting(memTx, gasWanted)
+		l := ts.mempool.Lanes()[lane]
+
+		fitsBlock := ts.totalTxBytes+txSize <= maxTxBytes && (maxBlockGas == 0 || ts.totalTxGas+txGas <= maxBlockGas)
+		fitsLane := ts.laneTxBytes+txSize <= l.MaxSpace(maxTxBytes) && (maxBlockGas == 0 || ts.laneTxGas+txGas <= l.MaxSpace(maxBlockGas))
+		if fitsBlock && fitsLane {
+			ts.totalTxBytes += txSize
+			ts.totalTxGas += txGas
+			ts.laneTxBytes += txSize
+			ts.laneTxGas += txGas
+			ts.selectedTxs = append(ts.selectedTxs, txBz)
+		}
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && (ts.totalTxGas >= maxBlockGas))
+}
+
+// laneVerifier verifies that the transactions of a proposal are ordered by lane
+// and that every lane stays within its block space.
+type laneVerifier struct {
+	mempool       *mempool.LaneMempool
+	maxBlockBytes uint64
+	maxBlockGas   uint64
+
+	lane        int
+	laneTxBytes uint64
+	laneTxGas   uint64
+}
+
+// newLaneVerifier returns a laneVerifier for the provided block limits, where a
+// non-positive limit is unbounded.
+func newLaneVerifier(mp *mempool.LaneMempool, maxBlockBytes, maxBlockGas int64) *laneVerifier {
+	v := &laneVerifier{mempool: mp}
+	if maxBlockBytes > 0 {
+		v.maxBlockBytes = uint64(maxBlockBytes)
+	}
+	if maxBlockGas > 0 {
+		v.maxBlockGas = uint64(maxBlockGas)
+	}
+	return v
+}
+
+// verifyTx verifies the next transaction of the proposal.
+func (v *laneVerifier) verifyTx(tx sdk.Tx, txBz []byte, txGas uint64) error {
+	lanes := v.mempool.Lanes()
+	lane := v.mempool.LaneIndex(tx)
+	switch {
+	case lane < 0:
+		return errors.New("tx matches no lane")
+	case lane < v.lane:
+		return fmt.Errorf("tx of lane %s included after lane %s", lanes[lane].Name, lanes[v.lane].Name)
+	case lane > v.lane:
+		v.lane = lane
+		v.laneTxBytes = 0
+		v.laneTxGas = 0
+	}
+
+	v.laneTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	v.laneTxGas += txGas
+
+	if v.maxBlockBytes > 0 && v.laneTxBytes > lanes[lane].MaxSpace(v.maxBlockBytes) {
+		return fmt.Errorf("lane %s exceeds its block bytes", lanes[lane].Name)
+	}
+	if v.maxBlockGas > 0 && v.laneTxGas > lanes[lane].MaxSpace(v.maxBlockGas) {
+		return fmt.Errorf("lane %s exceeds its block gas", lanes[lane].Name)
+	}
+	return nil
+}
+
+// reorderTxs applies the TxReorderer to the transactions of a proposal. With a
+// mempool.LaneMempool, the transactions of every lane are reordered separately
+// so that the proposal remains ordered by lane.
+func (app *BaseApp) reorderTxs(ctx sdk.Context, txs [][]byte) [][]byte {
+	laneMempool, ok := app.mempool.(*mempool.LaneMempool)
+	if !ok {
+		return app.txReorderer.ReorderTxs(ctx, txs)
+	}
+
+	reordered := make([][]byte, 0, len(txs))
+	start, startLane := 0, -1
+	for i, txBz := range txs {
+		tx, err := app.txDecoder(txBz)
+		if err != nil {
+			// the proposal is verified again in ProcessProposal, keep it as is.
+			return txs
+		}
+
+		lane := laneMempool.LaneIndex(tx)
+		if i > 0 && lane != startLane {
+			reordered = append(reordered, app.txReorderer.ReorderTxs(ctx, txs[start:i])...)
+			start = i
+		}
+		startLane = lane
+	}
+	if start < len(txs) {
+		reordered = append(reordered, app.txReorderer.ReorderTxs(ctx, txs[start:])...)
+	}
+
+	return reordered
+}
@@ -0,0 +1,187 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// laneTestTx is a transaction encoded as its own bytes, belonging to the
+// oracle lane if it starts with "oracle". Every laneTestTx used below is 10
+// bytes long, 12 bytes in a proposal.
+type laneTestTx string
+
+func (laneTestTx) GetMsgs() []sdk.Msg                    { return nil }
+func (laneTestTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+const laneTestTxSize = 12
+
+func decodeLaneTestTx(bz []byte) (sdk.Tx, error) {
+	if strings.HasPrefix(string(bz), "bad") {
+		return nil, errors.New("invalid tx")
+	}
+	return laneTestTx(bz), nil
+}
+
+func isOracleTx(tx sdk.Tx) bool {
+	return strings.HasPrefix(string(tx.(laneTestTx)), "oracle")
+}
+
+// newTestLaneMempool returns a mempool with an oracle lane allotted a quarter of
+// the block space, followed by a default lane.
+func newTestLaneMempool() *mempool.LaneMempool {
+	return mempool.NewLaneMempool(
+		mempool.Lane{Name: "oracle", Mempool: mempool.NoOpMempool{}, Match: isOracleTx, MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2)},
+		mempool.Lane{Name: "default", Mempool: mempool.NoOpMempool{}, Match: mempool.MatchAll},
+	)
+}
+
+func TestLaneTxSelector_SelectTxForProposal(t *testing.T) {
+	ctx := context.Background()
+	ts := NewLaneTxSelector(newTestLaneMempool())
+
+	// the block fits 4 txs, the oracle lane 1
+	maxTxBytes := uint64(4 * laneTestTxSize)
+	selectTx := func(tx laneTestTx, gas uint64) bool {
+		return ts.SelectTxForProposal(ctx, maxTxBytes, 0, tx, []byte(tx), gas)
+	}
+	require.False(t, selectTx("oracle-001", 0))
+	require.False(t, selectTx("oracle-002", 0)) // exceeds the oracle lane bytes
+	require.False(t, selectTx("default-01", 0))
+	require.False(t, selectTx("oracle-003", 0)) // offered after the default lane
+	require.False(t, selectTx("default-02", 0))
+	require.True(t, selectTx("default-03", 0))
+	require.Equal(t, [][]byte{[]byte("oracle-001"), []byte("default-01"), []byte("default-02"), []byte("default-03")}, ts.SelectedTxs(ctx))
+
+	ts.Clear()
+	require.Empty(t, ts.SelectedTxs(ctx))
+
+	// the block fits 100 gas, the oracle lane 25
+	selectTx = func(tx laneTestTx, gas uint64) bool {
+		return ts.SelectTxForProposal(ctx, maxTxBytes, 100, tx, []byte(tx), gas)
+	}
+	require.False(t, selectTx("oracle-001", 20))
+	require.False(t, selectTx("oracle-002", 10)) // exceeds the oracle lane gas
+	require.False(t, selectTx("default-01", 90)) // exceeds the block gas
+	require.True(t, selectTx("default-02", 80))
+	require.Equal(t, [][]byte{[]byte("oracle-001"), []byte("default-02")}, ts.SelectedTxs(ctx))
+}
+
+func TestLaneVerifier(t *testing.T) {
+	type verifiedTx struct {
+		tx  laneTestTx
+		gas uint64
+	}
+
+	testCases := map[string]struct {
+		mempool       *mempool.LaneMempool
+		maxBlockBytes int64
+		maxBlockGas   int64
+		txs           []verifiedTx
+		expErr        string
+	}{
+		"lanes in order": {
+			maxBlockBytes: 4 * laneTestTxSize,
+			maxBlockGas:   100,
+			txs:           []verifiedTx{{"oracle-001", 25}, {"default-01", 50}, {"default-02", 50}},
+		},
+		"non-positive limits are unbounded": {
+			maxBlockBytes: 0,
+			maxBlockGas:   -1,
+			txs:           []verifiedTx{{"oracle-001", 1000}, {"oracle-002", 1000}, {"oracle-003", 1000}},
+		},
+		"lane included after the next lane": {
+			txs:    []verifiedTx{{"oracle-001", 0}, {"default-01", 0}, {"oracle-002", 0}},
+			expErr: "tx of lane oracle included after lane default",
+		},
+		"tx matching no lane": {
+			mempool: mempool.NewLaneMempool(mempool.Lane{Name: "oracle", Mempool: mempool.NoOpMempool{}, Match: isOracleTx}),
+			txs:     []verifiedTx{{"oracle-001", 0}, {"default-01", 0}},
+			expErr:  "tx matches no lane",
+		},
+		"lane exceeding its block bytes": {
+			maxBlockBytes: 4 * laneTestTxSize,
+			txs:           []verifiedTx{{"oracle-001", 0}, {"oracle-002", 0}},
+			expErr:        "lane oracle exceeds its block bytes",
+		},
+		"lane exceeding its block gas": {
+			maxBlockGas: 100,
+			txs:         []verifiedTx{{"oracle-001", 20}, {"oracle-002", 10}},
+			expErr:      "lane oracle exceeds its block gas",
+		},
+		"default lane using the remaining block gas": {
+			maxBlockGas: 100,
+			txs:         []verifiedTx{{"default-01", 60}, {"default-02", 41}},
+			expErr:      "lane default exceeds its block gas",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mp := tc.mempool
+			if mp == nil {
+				mp = newTestLaneMempool()
+			}
+			v := newLaneVerifier(mp, tc.maxBlockBytes, tc.maxBlockGas)
+
+			var err error
+			for _, tx := range tc.txs {
+				if err = v.verifyTx(tx.tx, []byte(tx.tx), tx.gas); err != nil {
+					break
+				}
+			}
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+// reverseTxReorderer reverses the transactions it reorders.
+type reverseTxReorderer struct{}
+
+func (reverseTxReorderer) ReorderTxs(_ sdk.Context, txs [][]byte) [][]byte {
+	reversed := slices.Clone(txs)
+	slices.Reverse(reversed)
+	return reversed
+}
+
+func TestReorderTxs(t *testing.T) {
+	toBytes := func(txs ...string) [][]byte {
+		bz := make([][]byte, len(txs))
+		for i, tx := range txs {
+			bz[i] = []byte(tx)
+		}
+		return bz
+	}
+
+	app := &BaseApp{
+		mempool:     newTestLaneMempool(),
+		txDecoder:   decodeLaneTestTx,
+		txReorderer: reverseTxReorderer{},
+	}
+	txs := toBytes("oracle-001", "oracle-002", "default-01", "default-02", "default-03")
+
+	// the txs of every lane are reordered separately
+	require.Equal(t, toBytes("oracle-002", "oracle-001", "default-03", "default-02", "default-01"), app.reorderTxs(sdk.Context{}, txs))
+
+	// a proposal with an undecodable tx is kept as is
+	invalid := toBytes("oracle-001", "bad-000001", "default-01")
+	require.Equal(t, invalid, app.reorderTxs(sdk.Context{}, invalid))
+
+	// without lanes, the whole proposal is reordered
+	app.mempool = mempool.NoOpMempool{}
+	require.Equal(t, toBytes("default-03", "default-02", "default-01", "oracle-002", "oracle-001"), app.reorderTxs(sdk.Context{}, txs))
+}
@@ -34,6 +34,7 @@ type instrument struct {
 	BlockCount              metric.Int64Counter
 	TxCount                 metric.Int64Counter
 	OEAborted               metric.Int64Counter
+	OECacheHits             metric.Int64Counter
 	OETime                  metric.Int64Histogram
 	NonOEInternalFinalize   metric.Int64Histogram
 	WorkingHashTime         metric.Int64Histogram
@@ -75,6 +76,13 @@ func (i *instrument) Start(cfg map[string]any) error {
 	if err != nil {
 		return err
 	}
+	i.OECacheHits, err = i.Meter.Int64Counter(
+		"oe.cache_hits",
+		metric.WithDescription("Total number of blocks finalized with the cached optimistic execution of an earlier round"),
+	)
+	if err != nil {
+		return err
+	}
 	i.OETime, err = i.Meter.Int64Histogram(
 		"oe.time",
 		metric.WithDescription("Time spent waiting for optimistic execution to finish"),
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/rand"
+	"slices"
 	"sync"
 	"time"
 
@@ -31,10 +32,22 @@ type OptimisticExecution struct {
 	cancelFunc  func() // cancel function for the context
 	initialized bool   // A boolean value indicating whether the struct has been initialized
 
+	// completed executions of the proposals of earlier rounds, oldest first
+	cacheSize int
+	cache     []cachedExecution
+
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
 }
 
+// cachedExecution is a completed speculative execution together with the state it was
+// executed on, which is opaque to the OE.
+type cachedExecution struct {
+	request  *abci.RequestFinalizeBlock
+	response *abci.ResponseFinalizeBlock
+	state    any
+}
+
 // NewOptimisticExecution initializes the Optimistic Execution context but does not start it.
 func NewOptimisticExecution(logger log.Logger, fn FinalizeBlockFunc, opts ...func(*OptimisticExecution)) *OptimisticExecution {
 	logger = logger.With(log.ModuleKey, "oe")
@@ -54,6 +67,15 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
+// WithCacheSize keeps the completed executions of up to size proposals of the current height,
+// so when the network finalizes a proposal of an earlier round, or the proposal is proposed
+// again, its result is reused instead of re-executed. Disabled by default.
+func WithCacheSize(size int) func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		oe.cacheSize = size
+	}
+}
+
 // Reset resets the OE context. Must be called whenever we want to invalidate
 // the current OE.
 func (oe *OptimisticExecution) Reset() {
@@ -98,6 +120,8 @@ func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 		ProposerAddress:    req.ProposerAddress,
 	}
 
+	oe.evictLocked(req.Height, nil)
+
 	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
 	ctx, cancel := context.WithCancel(context.Background())
 	oe.cancelFunc = cancel
@@ -118,6 +142,105 @@ func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 	}()
 }
 
+// AbortAndCache aborts the OE like Abort, and if the execution completed successfully it
+// keeps the result together with the state it was executed on for the next rounds of the
+// same height, see WithCacheSize. The OE is reset when caching is enabled, so a later
+// FinalizeBlock of the proposal is served by TakeCached.
+//
+// The context of the execution is canceled by the abort, so the state must be rebound to
+// a live context before it's reused.
+func (oe *OptimisticExecution) AbortAndCache(state any) {
+	if oe == nil || oe.cancelFunc == nil {
+		return
+	}
+
+	oe.Abort()
+	if oe.cacheSize <= 0 {
+		return
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	if oe.initialized && oe.err == nil && oe.response != nil {
+		oe.evictLocked(oe.request.Height, oe.request.Hash)
+		oe.cache = append(oe.cache, cachedExecution{
+			request:  oe.request,
+			response: oe.response,
+			state:    state,
+		})
+		if len(oe.cache) > oe.cacheSize {
+			oe.cache = oe.cache[len(oe.cache)-oe.cacheSize:]
+		}
+		oe.logger.Debug("OE result cached", "height", oe.request.Height, "hash", hex.EncodeToString(oe.request.Hash), "cached", len(oe.cache))
+	}
+
+	oe.request = nil
+	oe.response = nil
+	oe.err = nil
+	oe.initialized = false
+}
+
+// Cached returns true if the result of the proposal with hash is cached.
+func (oe *OptimisticExecution) Cached(hash []byte) bool {
+	if oe == nil {
+		return false
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	for _, cached := range oe.cache {
+		if bytes.Equal(cached.request.Hash, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// TakeCached returns the cached result of the proposal with hash and the state it was
+// executed on. The whole cache is cleared, since the height is being finalized.
+func (oe *OptimisticExecution) TakeCached(hash []byte) (*abci.ResponseFinalizeBlock, any, bool) {
+	if oe == nil {
+		return nil, nil, false
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	defer oe.clearCacheLocked()
+	for _, cached := range oe.cache {
+		if bytes.Equal(cached.request.Hash, hash) {
+			oe.logger.Debug("OE result reused", "height", cached.request.Height, "hash", hex.EncodeToString(hash))
+			return cached.response, cached.state, true
+		}
+	}
+	return nil, nil, false
+}
+
+// ClearCache drops the cached results.
+func (oe *OptimisticExecution) ClearCache() {
+	if oe == nil {
+		return
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	oe.clearCacheLocked()
+}
+
+func (oe *OptimisticExecution) clearCacheLocked() {
+	clear(oe.cache)
+	oe.cache = oe.cache[:0]
+}
+
+// evictLocked removes the cached results of the other heights and of the proposal with hash, if any.
+func (oe *OptimisticExecution) evictLocked(height int64, hash []byte) {
+	oe.cache = slices.DeleteFunc(oe.cache, func(cached cachedExecution) bool {
+		return cached.request.Height != height || (hash != nil && bytes.Equal(cached.request.Hash, hash))
+	})
+}
+
 // AbortIfNeeded aborts the OE if the request hash is not the same as the one in
 // the running OE. Returns true if the OE was aborted.
 func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
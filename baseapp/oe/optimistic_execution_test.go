@@ -32,3 +32,46 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestOptimisticExecutionCache(t *testing.T) {
+	finalizeBlock := func(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		return &abci.ResponseFinalizeBlock{AppHash: req.Hash}, nil
+	}
+	oe := NewOptimisticExecution(log.NewNopLogger(), finalizeBlock, WithCacheSize(2))
+
+	execute := func(height int64, hash string) {
+		oe.Execute(&abci.RequestProcessProposal{Height: height, Hash: []byte(hash)})
+		_, err := oe.WaitResult()
+		assert.NoError(t, err)
+		oe.AbortAndCache("state-" + hash)
+	}
+
+	execute(1, "a")
+	execute(1, "b")
+	execute(1, "c")
+	assert.False(t, oe.Initialized())
+	// bounded to the last 2 rounds
+	assert.False(t, oe.Cached([]byte("a")))
+	assert.True(t, oe.Cached([]byte("b")))
+	assert.True(t, oe.Cached([]byte("c")))
+
+	resp, state, ok := oe.TakeCached([]byte("b"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("b"), resp.AppHash)
+	assert.Equal(t, "state-b", state)
+	// the cache is cleared once the height is finalized
+	assert.False(t, oe.Cached([]byte("c")))
+
+	// the executions of the previous heights are evicted
+	execute(1, "a")
+	oe.Execute(&abci.RequestProcessProposal{Height: 2, Hash: []byte("d")})
+	assert.False(t, oe.Cached([]byte("a")))
+	_, _, ok = oe.TakeCached([]byte("a"))
+	assert.False(t, ok)
+
+	// failed executions are not cached
+	failing := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock, WithCacheSize(2))
+	failing.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("a")})
+	failing.AbortAndCache(nil)
+	assert.False(t, failing.Cached([]byte("a")))
+}
@@ -1,7 +1,9 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -26,6 +28,20 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning strategy of a single store, overriding the
+// one set with SetPruning for that store.
+func SetStorePruning(storeName string, opts pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		cms, ok := bapp.cms.(interface {
+			SetStorePruning(string, pruningtypes.PruningOptions)
+		})
+		if !ok {
+			panic(fmt.Errorf("multistore %T doesn't support per-store pruning", bapp.cms))
+		}
+		cms.SetStorePruning(storeName, opts)
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -89,6 +105,21 @@ func SetIAVLSyncPruning(syncPruning bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLSyncPruning(syncPruning) }
 }
 
+// SetIAVLStoreLoader replaces the loader of the IAVL stores of the multistore, it allows using another
+// storage engine such as the changeset IAVL of the iavl package. It panics if the multistore doesn't
+// support it.
+func SetIAVLStoreLoader(loader func(db dbm.DB, key storetypes.StoreKey, id storetypes.CommitID, initialVersion uint64) (storetypes.CommitKVStore, error)) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		cms, ok := bapp.cms.(interface {
+			SetIAVLStoreLoader(func(dbm.DB, storetypes.StoreKey, storetypes.CommitID, uint64) (storetypes.CommitKVStore, error))
+		})
+		if !ok {
+			panic(fmt.Errorf("multistore %T doesn't support replacing the IAVL store loader", bapp.cms))
+		}
+		cms.SetIAVLStoreLoader(loader)
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -115,7 +146,8 @@ func SetStoreLoader(loader StoreLoader) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetStoreLoader(loader) }
 }
 
-// SetOptimisticExecution enables optimistic execution.
+// SetOptimisticExecution enables optimistic execution. Use oe.WithCacheSize to reuse
+// the executions of the proposals of earlier rounds of the same height.
 func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
 	return func(app *BaseApp) {
 		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
@@ -139,6 +171,23 @@ func isParallelTxRunner(r sdk.TxRunner) bool {
 	return false
 }
 
+// closeTxRunner closes r, and the runners it wraps via Unwrap() sdk.TxRunner, which implement
+// io.Closer, e.g. to stop their background workers.
+func closeTxRunner(r sdk.TxRunner) error {
+	var errs []error
+	for r != nil {
+		if closer, ok := r.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+		u, ok := r.(interface{ Unwrap() sdk.TxRunner })
+		if !ok {
+			break
+		}
+		r = u.Unwrap()
+	}
+	return errors.Join(errs...)
+}
+
 // guardParallelGasMeter panics with msg if r runs in parallel while the block
 // gas meter is enabled (disabled is false) — a non-deterministic combination.
 func guardParallelGasMeter(r sdk.TxRunner, disabled bool, msg string) {
@@ -154,6 +203,26 @@ func (app *BaseApp) SetBlockSTMTxRunner(txRunner sdk.TxRunner) {
 	app.txRunner = txRunner
 }
 
+// SetTxReorderer sets the TxReorderer applied to the transactions returned by the
+// PrepareProposal handler.
+func (app *BaseApp) SetTxReorderer(txReorderer TxReorderer) {
+	if app.sealed {
+		panic("SetTxReorderer() on sealed BaseApp")
+	}
+
+	app.txReorderer = txReorderer
+}
+
+// SetTrackTxWrites enables the tracking of the keys written by the messages of each transaction,
+// see `WrittenKeys`.
+func (app *BaseApp) SetTrackTxWrites(track bool) {
+	if app.sealed {
+		panic("SetTrackTxWrites() on sealed BaseApp")
+	}
+
+	app.trackTxWrites = track
+}
+
 // EnableBlockGasMeter enables the block gas meter.
 func EnableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(false) }
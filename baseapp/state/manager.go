@@ -126,6 +126,23 @@ func (mgr *Manager) SetState(
 	}
 }
 
+// ReplaceState replaces the FinalizeBlock state with a state created by an earlier
+// SetState, e.g. the state of a cached optimistic execution. The replaced state is
+// cleared.
+func (mgr *Manager) ReplaceState(mode sdk.ExecMode, st *State) {
+	mgr.stateMut.Lock()
+	defer mgr.stateMut.Unlock()
+
+	if mode != sdk.ExecModeFinalize {
+		panic(fmt.Sprintf("invalid runTxMode for replaceState: %d", mode))
+	}
+
+	if mgr.finalizeBlockState != nil && mgr.finalizeBlockState != st {
+		mgr.finalizeBlockState.span.End()
+	}
+	mgr.finalizeBlockState = st
+}
+
 func (mgr *Manager) ClearState(mode sdk.ExecMode) {
 	mgr.stateMut.Lock()
 	defer mgr.stateMut.Unlock()
@@ -1,16 +1,27 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/spf13/cast"
 
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/indexer"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/v2/streaming"
+	"github.com/cosmos/cosmos-sdk/store/v2/streaming/file"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 )
 
@@ -20,13 +31,99 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey               = "file"
+	StreamingFileKeysTomlKey           = "keys"
+	StreamingFileDirTomlKey            = "dir"
+	StreamingFileMaxSegmentSizeTomlKey = "max-segment-size"
+	StreamingFileDisableFsyncTomlKey   = "disable-fsync"
+
+	IndexerTomlKey = "indexer"
 )
 
+// EnableIndexer enables the built-in state indexer with the configuration of the indexer section of
+// app.toml. The writes committed to the stores are decoded with the codecs of the app modules
+// implementing schema.HasModuleCodec, and sent to the configured indexer targets. appModules is
+// keyed by the name of the store each module owns, e.g. "acc" for x/auth. It must be called after
+// RegisterStreamingServices.
+func (app *BaseApp) EnableIndexer(
+	indexerOpts any,
+	keys map[string]*storetypes.KVStoreKey,
+	appModules map[string]any,
+	addressCodec addressutil.AddressCodec,
+) error {
+	target, err := indexer.StartIndexing(indexer.IndexingOptions{
+		Config:       indexerOpts,
+		Resolver:     decoding.ModuleSetDecoderResolver(appModules),
+		Logger:       app.logger.With(log.ModuleKey, "indexer"),
+		AddressCodec: addressCodec,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start indexer: %w", err)
+	}
+
+	app.cms.AddListeners(exposeStoreKeysSorted([]string{"*"}, keys))
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listenerWrapper{target.Listener})
+
+	return nil
+}
+
+// listenerWrapper adapts an appdata.Listener to the ABCIListener interface.
+type listenerWrapper struct {
+	listener appdata.Listener
+}
+
+func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	if p.listener.StartBlock != nil {
+		return p.listener.StartBlock(appdata.StartBlockData{
+			Height: uint64(req.Height),
+		})
+	}
+	return nil
+}
+
+func (p listenerWrapper) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if p.listener.OnKVPair != nil {
+		updates := make([]appdata.ActorKVPairUpdate, len(changeSet))
+		for i, pair := range changeSet {
+			updates[i] = appdata.ActorKVPairUpdate{
+				Actor: []byte(pair.StoreKey),
+				StateChanges: []schema.KVPairUpdate{{
+					Key:    pair.Key,
+					Value:  pair.Value,
+					Remove: pair.Delete,
+				}},
+			}
+		}
+		if err := p.listener.OnKVPair(appdata.KVPairData{Updates: updates}); err != nil {
+			return err
+		}
+	}
+
+	if p.listener.Commit != nil {
+		commitCb, err := p.listener.Commit(appdata.CommitData{})
+		if err != nil {
+			return err
+		}
+		if commitCb != nil {
+			return commitCb()
+		}
+	}
+
+	return nil
+}
+
 // RegisterStreamingServices registers streaming services with the BaseApp.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
 	// register streaming services
 	streamingCfg := cast.ToStringMap(appOpts.Get(StreamingTomlKey))
 	for service := range streamingCfg {
+		if service == StreamingFileTomlKey {
+			if err := app.registerFileListener(appOpts, keys); err != nil {
+				return fmt.Errorf("failed to register file streaming listener: %w", err)
+			}
+			continue
+		}
 		pluginKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, StreamingABCIPluginTomlKey)
 		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
 		if len(pluginName) > 0 {
@@ -71,12 +168,63 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
-	app.SetStreamingManager(
-		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
-		},
-	)
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, abciListener)
+	app.streamingManager.StopNodeOnErr = stopNodeOnErr
+}
+
+// registerFileListener registers the file streaming listener if a directory is configured in
+// the streaming.file section of app.toml. The block is written before the state is committed and
+// the node is stopped when it can't be written, so that no height is missing from the directory.
+func (app *BaseApp) registerFileListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	optKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, key)
+	}
+
+	dir := strings.TrimSpace(cast.ToString(appOpts.Get(optKey(StreamingFileDirTomlKey))))
+	if dir == "" {
+		return nil
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	listener, err := file.NewListener(dir, file.Options{
+		MaxSegmentSize: cast.ToInt64(appOpts.Get(optKey(StreamingFileMaxSegmentSizeTomlKey))),
+		DisableFsync:   cast.ToBool(appOpts.Get(optKey(StreamingFileDisableFsyncTomlKey))),
+	})
+	if err != nil {
+		return err
+	}
+
+	exposeKeysStr := cast.ToStringSlice(appOpts.Get(optKey(StreamingFileKeysTomlKey)))
+	app.cms.AddListeners(exposeStoreKeysSorted(exposeKeysStr, keys))
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, fileListener{listener})
+	return nil
+}
+
+// fileListener wraps the file streaming listener. Its change set is written before the state is
+// committed, see Commit, and the node is stopped when a block can't be written, regardless of
+// the stop-node-on-err option of the other listeners.
+type fileListener struct {
+	listener *file.Listener
+}
+
+func (l fileListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	if err := l.listener.ListenFinalizeBlock(ctx, req, res); err != nil {
+		panic(fmt.Errorf("failed to stream block %d: %w", req.Height, err))
+	}
+	return nil
+}
+
+// ListenCommit is a no-op, the change set is written by listenCommit before the state is committed.
+func (l fileListener) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+func (l fileListener) listenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) {
+	if err := l.listener.ListenCommit(ctx, res, changeSet); err != nil {
+		panic(fmt.Errorf("failed to stream the change set of the block: %w", err))
+	}
 }
 
 func exposeAll(list []string) bool {
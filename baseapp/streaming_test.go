@@ -9,9 +9,15 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/store/v2/streaming/file"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -148,3 +154,157 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+type kvModule struct{}
+
+func (kvModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: schema.MustCompileModuleSchema(schema.StateObjectType{
+			Name:        "kv",
+			KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+		}),
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+			return []schema.StateObjectUpdate{{
+				TypeName: "kv",
+				Key:      string(update.Key),
+				Value:    string(update.Value),
+				Delete:   update.Remove,
+			}}, nil
+		},
+	}, nil
+}
+
+func TestABCI_Indexer(t *testing.T) {
+	var (
+		heights []uint64
+		updates []schema.StateObjectUpdate
+	)
+	indexer.Register("baseapp-test", indexer.Initializer{
+		InitFunc: func(indexer.InitParams) (indexer.InitResult, error) {
+			return indexer.InitResult{Listener: appdata.Listener{
+				StartBlock: func(data appdata.StartBlockData) error {
+					heights = append(heights, data.Height)
+					return nil
+				},
+				OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+					require.Equal(t, distKey1.Name(), data.ModuleName)
+					updates = append(updates, data.Updates...)
+					return nil
+				},
+			}}, nil
+		},
+	})
+
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	suite := NewBaseAppSuite(t, distOpt)
+	err := suite.baseApp.EnableIndexer(
+		map[string]any{"target": map[string]any{"test": map[string]any{"type": "baseapp-test"}}},
+		map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1},
+		map[string]any{distKey1.Name(): kvModule{}},
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte("a"), []byte("1"))
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	require.NoError(t, err)
+	getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Delete([]byte("a"))
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 2}, heights)
+	require.Equal(t, []schema.StateObjectUpdate{
+		{TypeName: "kv", Key: "a", Value: "1"},
+		{TypeName: "kv", Key: "a", Value: "", Delete: true},
+	}, updates)
+}
+
+func TestABCI_FileStreaming(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		baseapp.StreamingTomlKey: map[string]any{baseapp.StreamingFileTomlKey: map[string]any{}},
+		"streaming.file.keys":    []string{"*"},
+		"streaming.file.dir":     dir,
+	}
+
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	suite := NewBaseAppSuite(t, distOpt)
+	err := suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set(fmt.Appendf(nil, "key%d", height), []byte("value"))
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	var heights []int64
+	err = file.Replay(dir, 0, func(block *file.Block) error {
+		heights = append(heights, block.Height)
+		require.Len(t, block.Commit.ChangeSet, 1)
+		require.Equal(t, distKey1.Name(), block.Commit.ChangeSet[0].StoreKey)
+		require.Equal(t, fmt.Appendf(nil, "key%d", block.Height), block.Commit.ChangeSet[0].Key)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights)
+	require.False(t, suite.baseApp.StreamingManager().StopNodeOnErr)
+}
+
+func TestABCI_FileStreaming_ReplayedBlocks(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		baseapp.StreamingTomlKey: map[string]any{baseapp.StreamingFileTomlKey: map[string]any{}},
+		"streaming.file.keys":    []string{"*"},
+		"streaming.file.dir":     dir,
+	}
+
+	// the second app replays the blocks written by the first one, as a node stopped after
+	// writing a block but before committing its state does
+	for _, lastHeight := range []int64{2, 3} {
+		distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+		suite := NewBaseAppSuite(t, distOpt)
+		err := suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1})
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		for height := int64(1); height <= lastHeight; height++ {
+			_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+			require.NoError(t, err)
+			getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set(fmt.Appendf(nil, "key%d", height), []byte("value"))
+			_, err = suite.baseApp.Commit()
+			require.NoError(t, err)
+		}
+		require.NoError(t, suite.baseApp.Close())
+	}
+
+	var heights []int64
+	err := file.Replay(dir, 0, func(block *file.Block) error {
+		heights = append(heights, block.Height)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, heights)
+}
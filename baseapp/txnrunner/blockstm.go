@@ -11,6 +11,22 @@ var _ sdk.TxRunner = &STMRunner{}
 // STMRunner is a public export of the internal implementation of a BlockSTM TxRunner.
 type STMRunner = blockstm.STMRunner
 
+// BlockReport is a public export of the internal per-transaction conflict report of a block.
+type BlockReport = blockstm.BlockReport
+
+// WriteBlockReport is a public export of the internal conflict report file writer.
+func WriteBlockReport(dir string, report *BlockReport) error {
+	return blockstm.WriteBlockReport(dir, report)
+}
+
+// ReportWriter is a public export of the internal background conflict report writer.
+type ReportWriter = blockstm.ReportWriter
+
+// NewReportWriter is a public export of the internal ReportWriter constructor.
+func NewReportWriter(dir string, maxFiles int, onError func(height int64, err error)) *ReportWriter {
+	return blockstm.NewReportWriter(dir, maxFiles, onError)
+}
+
 // NewSTMRunner is a public export of the internal implementation of a BlockSTM TxRunner constructor.
 func NewSTMRunner(
 	txDecoder sdk.TxDecoder,
@@ -21,3 +37,13 @@ func NewSTMRunner(
 ) *STMRunner {
 	return blockstm.NewSTMRunner(txDecoder, stores, workers, estimate, coinDenom)
 }
+
+// VerifyRunner is a public export of the internal implementation of the TxRunner that
+// checks BlockSTM against sequential execution.
+type VerifyRunner = blockstm.VerifyRunner
+
+// NewVerifyRunner is a public export of the internal implementation of a VerifyRunner constructor,
+// the sequential execution uses the DefaultRunner.
+func NewVerifyRunner(txDecoder sdk.TxDecoder, stm *STMRunner) *VerifyRunner {
+	return blockstm.NewVerifyRunner(NewDefaultRunner(txDecoder), stm)
+}
@@ -0,0 +1,140 @@
+package baseapp
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/v2/cachemulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WrittenKeys returns the keys written by the messages of the transaction so far, by store name
+// and in ascending order. It returns false if write tracking is not enabled, see `SetTrackTxWrites`.
+// Only KV stores are tracked, and only the writes of the messages: the post handler runs on the
+// same branch but its writes are not recorded, and the writes of the ante handler are not included.
+func WrittenKeys(ctx sdk.Context) (map[string][][]byte, bool) {
+	ms, ok := ctx.MultiStore().(*writeTrackingMultiStore)
+	if !ok {
+		return nil, false
+	}
+	return ms.writtenKeys(), true
+}
+
+// writeTrackingMultiStore wraps the branch of the messages of a transaction, and records the keys
+// written through its KV stores, including the ones written by nested branches.
+type writeTrackingMultiStore struct {
+	parent storetypes.MultiStore
+
+	written map[string]map[string]struct{}
+	// untracked is set on the multistore of the post handler, which reports the keys written by
+	// the messages without recording its own writes.
+	untracked bool
+}
+
+var _ storetypes.MultiStore = (*writeTrackingMultiStore)(nil)
+
+func newWriteTrackingMultiStore(parent storetypes.MultiStore) *writeTrackingMultiStore {
+	return &writeTrackingMultiStore{
+		parent:  parent,
+		written: make(map[string]map[string]struct{}),
+	}
+}
+
+// untrackedView returns a multistore on the same branch reporting the keys written so far, but
+// not recording the keys written through it.
+func (ms *writeTrackingMultiStore) untrackedView() *writeTrackingMultiStore {
+	return &writeTrackingMultiStore{parent: ms.parent, written: ms.written, untracked: true}
+}
+
+func (ms *writeTrackingMultiStore) GetStoreType() storetypes.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+func (ms *writeTrackingMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+func (ms *writeTrackingMultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	return ms.parent.CacheMultiStoreWithVersion(version)
+}
+
+func (ms *writeTrackingMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+func (ms *writeTrackingMultiStore) GetObjKVStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	return ms.parent.GetObjKVStore(key)
+}
+
+func (ms *writeTrackingMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	store := ms.parent.GetStore(key)
+	if kv, ok := store.(storetypes.KVStore); ok {
+		return ms.track(key, kv)
+	}
+	return store
+}
+
+func (ms *writeTrackingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return ms.track(key, ms.parent.GetKVStore(key))
+}
+
+// CacheMultiStore branches on top of the tracked stores, so the writes of the branch are recorded
+// when it's written back.
+func (ms *writeTrackingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return cachemulti.NewFromParent(func(key storetypes.StoreKey) storetypes.CacheWrapper {
+		return ms.GetStore(key)
+	})
+}
+
+func (ms *writeTrackingMultiStore) track(key storetypes.StoreKey, store storetypes.KVStore) storetypes.KVStore {
+	if ms.untracked {
+		return store
+	}
+	written, ok := ms.written[key.Name()]
+	if !ok {
+		written = make(map[string]struct{})
+		ms.written[key.Name()] = written
+	}
+	return &writeTrackingStore{KVStore: store, written: written}
+}
+
+func (ms *writeTrackingMultiStore) writtenKeys() map[string][][]byte {
+	result := make(map[string][][]byte, len(ms.written))
+	for name, written := range ms.written {
+		if len(written) == 0 {
+			continue
+		}
+		keys := make([][]byte, 0, len(written))
+		for key := range written {
+			keys = append(keys, []byte(key))
+		}
+		slices.SortFunc(keys, bytes.Compare)
+		result[name] = keys
+	}
+	return result
+}
+
+// writeTrackingStore records the keys set or deleted.
+type writeTrackingStore struct {
+	storetypes.KVStore
+
+	written map[string]struct{}
+}
+
+func (s *writeTrackingStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.written[string(key)] = struct{}{}
+}
+
+func (s *writeTrackingStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.written[string(key)] = struct{}{}
+}
+
+// CacheWrap branches on top of the tracked store, so the writes of the branch are recorded when
+// it's written back.
+func (s *writeTrackingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
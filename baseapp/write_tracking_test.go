@@ -0,0 +1,62 @@
+package baseapp
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/v2/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWriteTrackingMultiStore(t *testing.T) {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	accKey := storetypes.NewKVStoreKey("acc")
+	parent := cachemulti.NewStore(map[storetypes.StoreKey]storetypes.CacheWrapper{
+		bankKey: dbadapter.Store{DB: dbm.NewMemDB()},
+		accKey:  dbadapter.Store{DB: dbm.NewMemDB()},
+	})
+	parent.GetKVStore(accKey).Set([]byte("existing"), []byte{1})
+
+	ms := newWriteTrackingMultiStore(parent)
+	ctx := sdk.Context{}.WithMultiStore(ms)
+
+	ctx.MultiStore().GetKVStore(bankKey).Set([]byte("b"), []byte{1})
+	ctx.MultiStore().GetKVStore(bankKey).Set([]byte("a"), []byte{1})
+	// reads are not tracked
+	ctx.MultiStore().GetKVStore(accKey).Get([]byte("existing"))
+
+	// nested branches are tracked when written back
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx.MultiStore().GetKVStore(accKey).Delete([]byte("existing"))
+	discarded, _ := ctx.CacheContext()
+	discarded.MultiStore().GetKVStore(accKey).Set([]byte("discarded"), []byte{1})
+
+	written, ok := WrittenKeys(ctx)
+	require.True(t, ok)
+	require.Equal(t, map[string][][]byte{"bank": {[]byte("a"), []byte("b")}}, written)
+
+	write()
+	written, _ = WrittenKeys(ctx)
+	require.Equal(t, [][]byte{[]byte("existing")}, written["acc"])
+	require.Nil(t, parent.GetKVStore(accKey).Get([]byte("existing")))
+
+	// the post handler sees the keys written so far, but its writes are not tracked
+	postCtx := ctx.WithMultiStore(ms.untrackedView())
+	postCtx.MultiStore().GetKVStore(bankKey).Set([]byte("fee"), []byte{1})
+	postCacheCtx, postWrite := postCtx.CacheContext()
+	postCacheCtx.MultiStore().GetKVStore(accKey).Set([]byte("refund"), []byte{1})
+	postWrite()
+	postWritten, ok := WrittenKeys(postCtx)
+	require.True(t, ok)
+	require.Equal(t, written, postWritten)
+	written, _ = WrittenKeys(ctx)
+	require.Equal(t, postWritten, written)
+	require.Equal(t, []byte{1}, parent.GetKVStore(bankKey).Get([]byte("fee")))
+
+	_, ok = WrittenKeys(sdk.Context{}.WithMultiStore(parent))
+	require.False(t, ok)
+}
@@ -22,7 +22,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -39,6 +38,9 @@ const (
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
 	flagMnemonicSrc  = "source"
+	flagPKCS11Module = "pkcs11-module"
+	flagPKCS11Token  = "pkcs11-token"
+	flagPKCS11Key    = "pkcs11-key"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -72,6 +74,21 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Passing the weight of each key through --multisig-weights creates a weighted threshold
+multisig key instead, whose keys can be of any type and whose threshold is the weight the
+signers must reach.
+Example:
+
+    keys add treasury --multisig "hsm1,hsm2,wallet1" --multisig-weights 2,2,1 --multisig-threshold 3
+
+You can store a reference to a secp256r1 key pair of a PKCS#11 token, e.g. an HSM, by passing
+the PKCS#11 library of the token, the label of the token and the label of the key pair. The
+private key never leaves the token, which signs the transactions. The PIN of the token is read
+from the PKCS11_PIN environment variable, or prompted for.
+Example:
+
+    keys add hsm1 --pkcs11-module /usr/lib/softhsm/libsofthsm2.so --pkcs11-token validators --pkcs11-key hsm1
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -79,11 +96,15 @@ Example:
 	f := cmd.Flags()
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	f.UintSlice(flagMultiSigWeights, nil, "Weights of the keys passed to --multisig, in the same order, to construct a weighted threshold multisig key")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	f.String(flagPKCS11Key, "", "Store a local reference to the secp256r1 key pair with this label on a PKCS#11 token")
+	f.String(flagPKCS11Module, "", "Path of the PKCS#11 library of the token. For use in conjunction with --pkcs11-key")
+	f.String(flagPKCS11Token, "", "Label of the PKCS#11 token. For use in conjunction with --pkcs11-key")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
@@ -180,7 +201,8 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			multisigWeights, _ := cmd.Flags().GetUintSlice(flagMultiSigWeights)
+			if err := validateMultisigWeights(multisigThreshold, multisigWeights, len(multisigKeys)); err != nil {
 				return err
 			}
 
@@ -204,12 +226,10 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			}
 
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
-				})
+				sort.Sort(multisigKeysByAddress{pks: pks, weights: multisigWeights})
 			}
 
-			pk := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			pk := newMultisigPubKey(multisigThreshold, multisigWeights, pks)
 			k, err := kb.SaveMultisig(name, pk)
 			if err != nil {
 				return err
@@ -273,6 +293,21 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
+	if pkcs11Key, _ := cmd.Flags().GetString(flagPKCS11Key); pkcs11Key != "" {
+		pkcs11Module, _ := cmd.Flags().GetString(flagPKCS11Module)
+		pkcs11Token, _ := cmd.Flags().GetString(flagPKCS11Token)
+		if pkcs11Module == "" || pkcs11Token == "" {
+			return fmt.Errorf("flag %s requires flags %s and %s", flagPKCS11Key, flagPKCS11Module, flagPKCS11Token)
+		}
+
+		k, err := kb.SavePKCS11Key(name, pkcs11Module, pkcs11Token, pkcs11Key)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
@@ -385,6 +420,26 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
 }
 
+// multisigKeysByAddress sorts the keys of a multisig key by address, their weights, if any,
+// following them.
+type multisigKeysByAddress struct {
+	pks     []cryptotypes.PubKey
+	weights []uint
+}
+
+func (m multisigKeysByAddress) Len() int { return len(m.pks) }
+
+func (m multisigKeysByAddress) Less(i, j int) bool {
+	return bytes.Compare(m.pks[i].Address(), m.pks[j].Address()) < 0
+}
+
+func (m multisigKeysByAddress) Swap(i, j int) {
+	m.pks[i], m.pks[j] = m.pks[j], m.pks[i]
+	if len(m.weights) != 0 {
+		m.weights[i], m.weights[j] = m.weights[j], m.weights[i]
+	}
+}
+
 func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
 	switch outputFormat {
 	case flags.OutputFormatText:
@@ -54,7 +54,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 					return err
 				}
 
-				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypePKCS11 {
 					cmd.PrintErrln("Public key reference deleted")
 					continue
 				}
@@ -65,7 +65,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 		},
 	}
 
-	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting offline or ledger key references")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting offline, ledger or PKCS#11 key references")
 	cmd.Flags().BoolP(flagForce, "f", false, "Remove the key unconditionally without asking for the passphrase. Deprecated.")
 
 	return cmd
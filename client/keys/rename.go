@@ -56,7 +56,7 @@ private keys stored in a ledger device cannot be renamed with the CLI.
 				return err
 			}
 
-			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypePKCS11 {
 				cmd.PrintErrln("Public key reference renamed")
 				return nil
 			}
@@ -67,7 +67,7 @@ private keys stored in a ledger device cannot be renamed with the CLI.
 		},
 	}
 
-	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when renaming offline or ledger key references")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when renaming offline, ledger or PKCS#11 key references")
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package keys
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/mdp/qrterminal/v3"
 	"github.com/spf13/cobra"
@@ -30,6 +31,7 @@ const (
 	FlagDevice = "device"
 
 	flagMultiSigThreshold = "multisig-threshold"
+	flagMultiSigWeights   = "multisig-weights"
 	flagQRCode            = "qrcode"
 )
 
@@ -40,7 +42,9 @@ func ShowKeysCmd() *cobra.Command {
 		Short: "Retrieve key information by name or address",
 		Long: `Display keys details. If multiple names or addresses are provided,
 then an ephemeral multisig key will be created under the name "multi"
-consisting of all the keys provided by name and multisig threshold.`,
+consisting of all the keys provided by name and multisig threshold.
+If --multisig-weights is set, the ephemeral key is a weighted threshold multisig
+key, whose threshold is the weight the signers must reach.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: runShowCmd,
 	}
@@ -50,6 +54,7 @@ consisting of all the keys provided by name and multisig threshold.`,
 	f.BoolP(FlagPublicKey, "p", false, "Output the public key only (cannot be used with --output)")
 	f.BoolP(FlagDevice, "d", false, "Output the address in a ledger device (cannot be used with --pubkey)")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures")
+	f.UintSlice(flagMultiSigWeights, nil, "Weights of the keys, in the same order, of a weighted threshold multisig key")
 	f.Bool(flagQRCode, false, "Display key address QR code (will be ignored if -a or --address is false)")
 
 	return cmd
@@ -92,12 +97,13 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		}
 
 		multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
+		multisigWeights, _ := cmd.Flags().GetUintSlice(flagMultiSigWeights)
 
-		if err := validateMultisigThreshold(multisigThreshold, len(args)); err != nil {
+		if err := validateMultisigWeights(multisigThreshold, multisigWeights, len(args)); err != nil {
 			return err
 		}
 
-		multikey := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+		multikey := newMultisigPubKey(multisigThreshold, multisigWeights, pks)
 		k, err = keyring.NewMultiRecord(k.Name, multikey)
 		if err != nil {
 			return err
@@ -226,3 +232,42 @@ func getBechKeyOut(bechPrefix string) (bechKeyOutFn, error) {
 
 	return nil, fmt.Errorf("invalid Bech32 prefix encoding provided: %s", bechPrefix)
 }
+
+// validateMultisigWeights validates the threshold of a multisig key of nKeys keys,
+// which is a weight if weights are provided.
+func validateMultisigWeights(k int, weights []uint, nKeys int) error {
+	if len(weights) == 0 {
+		return validateMultisigThreshold(k, nKeys)
+	}
+	if k <= 0 || k > math.MaxUint32 {
+		return fmt.Errorf("threshold must be a positive 32-bit integer")
+	}
+	if len(weights) != nKeys {
+		return fmt.Errorf("%d weights provided for %d keys", len(weights), nKeys)
+	}
+	var total uint64
+	for _, weight := range weights {
+		if weight == 0 || weight > math.MaxUint32 {
+			return fmt.Errorf("weights must be positive 32-bit integers")
+		}
+		total += uint64(weight)
+	}
+	if total < uint64(k) {
+		return fmt.Errorf(
+			"weighted threshold multisignature: total weight %d < %d", total, k)
+	}
+	return nil
+}
+
+// newMultisigPubKey returns a k of n multisig key, or a weighted threshold multisig
+// key if weights are provided.
+func newMultisigPubKey(k int, weights []uint, pks []cryptotypes.PubKey) cryptotypes.PubKey {
+	if len(weights) == 0 {
+		return multisig.NewLegacyAminoPubKey(k, pks)
+	}
+	weights32 := make([]uint32, len(weights))
+	for i, weight := range weights {
+		weights32[i] = uint32(weight)
+	}
+	return multisig.NewWeightedThresholdPubKey(uint32(k), pks, weights32)
+}
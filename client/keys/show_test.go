@@ -208,6 +208,55 @@ func Test_runShowCmd(t *testing.T) {
 
 	// try fetch by name
 	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// weighted threshold multisig key
+	cmd.SetArgs([]string{
+		fakeKeyName1, fakeKeyName2,
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=true", FlagAddress),
+		fmt.Sprintf("--%s=false", flagQRCode),
+		fmt.Sprintf("--%s=3", flagMultiSigThreshold),
+		fmt.Sprintf("--%s=2,1", flagMultiSigWeights),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	cmd.SetArgs([]string{
+		fakeKeyName1, fakeKeyName2,
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=4", flagMultiSigThreshold),
+		fmt.Sprintf("--%s=2,1", flagMultiSigWeights),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "weighted threshold multisignature: total weight 3 < 4")
+}
+
+func Test_validateMultisigWeights(t *testing.T) {
+	type args struct {
+		k       int
+		weights []uint
+		nKeys   int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"no weights", args{2, nil, 1}, true},
+		{"zero threshold", args{0, []uint{1, 1}, 2}, true},
+		{"weights count", args{1, []uint{1, 1}, 3}, true},
+		{"zero weight", args{1, []uint{1, 0}, 2}, true},
+		{"threshold over total weight", args{4, []uint{2, 1}, 2}, true},
+		{"heavy key", args{2, []uint{2, 1}, 2}, false},
+		{"total weight", args{3, []uint{2, 1}, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMultisigWeights(tt.args.k, tt.args.weights, tt.args.nKeys); (err != nil) != tt.wantErr {
+				t.Errorf("validateMultisigWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
 }
 
 func Test_validateMultisigThreshold(t *testing.T) {
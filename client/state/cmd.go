@@ -0,0 +1,17 @@
+package state
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the state group command, the codecs of the provider decode the state of the modules.
+func Cmd(codecsProvider ModuleCodecsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect the application state stored by the node",
+	}
+	cmd.AddCommand(
+		DiffCmd(codecsProvider),
+	)
+	return cmd
+}
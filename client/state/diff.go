@@ -0,0 +1,428 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/iavl"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+const (
+	FlagFrom  = "from"
+	FlagTo    = "to"
+	FlagStore = "store"
+)
+
+// ModuleCodecsProvider is implemented by the applications which can decode the
+// state of their modules, see module.Manager.ModuleCodecs. The codecs are keyed by
+// the name of the store they decode, which isn't always the module name, e.g. the
+// store of x/auth is named "acc".
+type ModuleCodecsProvider interface {
+	ModuleCodecs() (map[string]schema.ModuleCodec, error)
+}
+
+// stateChangesTraverser is implemented by the IAVL stores which can list the keys written by each
+// version from the tree nodes of that version, without iterating the whole store.
+type stateChangesTraverser interface {
+	TraverseStateChanges(startVersion, endVersion int64, fn func(version int64, changeSet *iavl.ChangeSet) error) error
+}
+
+// KVChange is a key set or deleted in a store between two heights.
+type KVChange struct {
+	Store  string            `json:"store"`
+	Key    cmtbytes.HexBytes `json:"key"`
+	Delete bool              `json:"delete"`
+	// Old is empty if the key was created.
+	Old cmtbytes.HexBytes `json:"old,omitempty"`
+	// New is empty if the key was deleted.
+	New cmtbytes.HexBytes `json:"new,omitempty"`
+	// Decoded is set if the key belongs to a collection of the module codec of the store.
+	Decoded *DecodedChange `json:"decoded,omitempty"`
+}
+
+// DecodedChange is a KVChange decoded with the module codec of the store.
+type DecodedChange struct {
+	Type string `json:"type"`
+	Key  any    `json:"key"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+// DiffCmd returns a command printing the key changes of the IAVL stores between two heights.
+// The values are decoded with the codecs of the provider, which may be nil.
+func DiffCmd(codecsProvider ModuleCodecsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Print the key changes of the stores between two heights",
+		Long: `Print every key set or deleted in the IAVL stores between two heights retained by the node, with the
+values at both heights. The values of the stores owned by modules with a collections schema are also printed decoded.
+
+The changed keys are read from the IAVL tree nodes written at each height in between, so the cost grows with the
+number of writes rather than with the size of the stores. If a height in between was pruned, or the store uses
+another IAVL backend, the whole store is iterated at both heights instead. The stores with their own pruning
+strategy which no longer retain the --from height are skipped with a warning, unless given with --store.
+
+The node must be stopped.`,
+		Example: "diff --from 100 --to 200 --store bank",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(FlagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(FlagTo)
+			if err != nil {
+				return err
+			}
+			if from <= 0 || to <= from {
+				return fmt.Errorf("invalid heights --from %d --to %d, expected 0 < from < to", from, to)
+			}
+			stores, err := cmd.Flags().GetStringSlice(FlagStore)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), filepath.Join(ctx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			rs, err := openMultiStore(ctx, db)
+			if err != nil {
+				return err
+			}
+
+			var codecs map[string]schema.ModuleCodec
+			if codecsProvider != nil {
+				if codecs, err = codecsProvider.ModuleCodecs(); err != nil {
+					return err
+				}
+			}
+
+			printChange := printChangeText
+			if output == flags.OutputFormatJSON {
+				printChange = printChangeJSON
+			}
+
+			return diffStores(ctx.Logger, rs, from, to, stores, codecs, func(change KVChange) error {
+				return printChange(cmd.OutOrStdout(), change)
+			})
+		},
+	}
+
+	cmd.Flags().Int64(FlagFrom, 0, "Height to diff from")
+	cmd.Flags().Int64(FlagTo, 0, "Height to diff to")
+	cmd.Flags().StringSlice(FlagStore, nil, "Stores to diff, all the IAVL stores if empty")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+	_ = cmd.MarkFlagRequired(FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagTo)
+
+	return cmd
+}
+
+// openMultiStore loads the IAVL stores of the last committed height from the application
+// database, without creating the application whose constructor may start services writing to the
+// data directory, such as the indexer or the file streaming listener.
+func openMultiStore(ctx *server.Context, db dbm.DB) (*rootmulti.Store, error) {
+	latest := rootmulti.GetLatestVersion(db)
+	if latest == 0 {
+		return nil, errors.New("no height committed in the application database")
+	}
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger())
+	rs.SetIAVLDisableFastNode(cast.ToBool(ctx.Viper.Get(server.FlagDisableIAVLFastNode)))
+	loader, err := server.GetIAVLStoreLoader(ctx.Viper, log.NewNopLogger())
+	if err != nil {
+		return nil, err
+	}
+	if loader != nil {
+		rs.SetIAVLStoreLoader(loader)
+	}
+	storePruning, err := server.GetStorePruningOptionsFromFlags(ctx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	for name, opts := range storePruning {
+		rs.SetStorePruning(name, opts)
+	}
+
+	// only the IAVL stores are committed, the store keys are those of the last commit
+	info, err := rs.GetCommitInfo(latest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", latest, err)
+	}
+	for _, storeInfo := range info.StoreInfos {
+		rs.MountStoreWithDB(storetypes.NewKVStoreKey(storeInfo.Name), storetypes.StoreTypeIAVL, nil)
+	}
+	if err := rs.LoadVersion(latest); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func diffStores(logger log.Logger, rs *rootmulti.Store, from, to int64, stores []string, codecs map[string]schema.ModuleCodec, fn func(KVChange) error) error {
+	keysByName := rs.StoreKeysByName()
+	for _, name := range stores {
+		if _, ok := keysByName[name]; !ok {
+			return fmt.Errorf("unknown store %s", name)
+		}
+	}
+
+	fromStore, err := rs.CacheMultiStoreWithVersion(from)
+	if err != nil {
+		return fmt.Errorf("failed to load height %d: %w", from, err)
+	}
+	toStore, err := rs.CacheMultiStoreWithVersion(to)
+	if err != nil {
+		return fmt.Errorf("failed to load height %d: %w", to, err)
+	}
+
+	names := make([]string, 0, len(keysByName))
+	for name, key := range keysByName {
+		if len(stores) > 0 && !slices.Contains(stores, name) {
+			continue
+		}
+		if rs.GetStore(key).GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+		// the stores with their own pruning strategy may not retain the from height anymore
+		if earliest := rs.StoreEarliestVersion(name); from < earliest {
+			if len(stores) > 0 {
+				return errorsmod.Wrapf(storetypes.ErrStorePruned, "store %s at height %d, the earliest height available for this store is %d", name, from, earliest)
+			}
+			logger.Warn("skipping the store pruned at the from height", "store", name, "from", from, "earliest", earliest)
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key := keysByName[name]
+		cdc, hasCodec := codecs[name]
+		fromKV, toKV := fromStore.GetKVStore(key), toStore.GetKVStore(key)
+
+		diff := func(fn func(key, oldValue, newValue []byte) error) error {
+			return DiffKVStores(fromKV, toKV, fn)
+		}
+		if traverser, ok := rs.GetStore(key).(stateChangesTraverser); ok {
+			// fall back to the full scan if a height in between isn't available
+			if keys, err := changedKeys(traverser, from, to); err == nil {
+				diff = func(fn func(key, oldValue, newValue []byte) error) error {
+					return DiffKeys(fromKV, toKV, keys, fn)
+				}
+			}
+		}
+
+		err := diff(func(k, oldValue, newValue []byte) error {
+			change := KVChange{
+				Store:  name,
+				Key:    k,
+				Delete: newValue == nil,
+				Old:    oldValue,
+				New:    newValue,
+			}
+			if hasCodec && cdc.KVDecoder != nil {
+				decoded, err := decodeChange(cdc.KVDecoder, k, oldValue, newValue)
+				if err != nil {
+					return fmt.Errorf("failed to decode key %X of store %s: %w", k, name, err)
+				}
+				change.Decoded = decoded
+			}
+			return fn(change)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// changedKeys returns in order the keys written or deleted in the store by the versions after from
+// up to to.
+func changedKeys(store stateChangesTraverser, from, to int64) ([][]byte, error) {
+	seen := make(map[string]struct{})
+	var keys [][]byte
+	err := store.TraverseStateChanges(from+1, to, func(_ int64, changeSet *iavl.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			if _, ok := seen[string(pair.Key)]; ok {
+				continue
+			}
+			seen[string(pair.Key)] = struct{}{}
+			keys = append(keys, pair.Key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(keys, bytes.Compare)
+	return keys, nil
+}
+
+// DiffKeys is like DiffKVStores but only compares the given keys, which must be sorted.
+func DiffKeys(from, to storetypes.KVStore, keys [][]byte, fn func(key, oldValue, newValue []byte) error) error {
+	for _, key := range keys {
+		oldValue, newValue := existingKey(from, key), existingKey(to, key)
+		if oldValue == nil && newValue == nil {
+			continue
+		}
+		if oldValue != nil && newValue != nil && bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		if err := fn(key, oldValue, newValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// existingKey returns the value of the key in the store, it is nil only if the key doesn't exist.
+func existingKey(store storetypes.KVStore, key []byte) []byte {
+	if value := store.Get(key); value != nil {
+		return value
+	}
+	if store.Has(key) {
+		return []byte{}
+	}
+	return nil
+}
+
+// DiffKVStores calls fn in key order with the keys whose value differs between the two stores.
+// oldValue is nil if the key is only in the to store, newValue is nil if it is only in the from store.
+// Both stores are iterated in full.
+func DiffKVStores(from, to storetypes.KVStore, fn func(key, oldValue, newValue []byte) error) error {
+	fromIt := from.Iterator(nil, nil)
+	defer fromIt.Close()
+	toIt := to.Iterator(nil, nil)
+	defer toIt.Close()
+
+	for fromIt.Valid() || toIt.Valid() {
+		var cmp int
+		switch {
+		case !fromIt.Valid():
+			cmp = 1
+		case !toIt.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIt.Key(), toIt.Key())
+		}
+
+		var err error
+		switch {
+		case cmp < 0:
+			err = fn(fromIt.Key(), existingValue(fromIt), nil)
+			fromIt.Next()
+		case cmp > 0:
+			err = fn(toIt.Key(), nil, existingValue(toIt))
+			toIt.Next()
+		default:
+			if !bytes.Equal(fromIt.Value(), toIt.Value()) {
+				err = fn(toIt.Key(), existingValue(fromIt), existingValue(toIt))
+			}
+			fromIt.Next()
+			toIt.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(fromIt.Error(), toIt.Error())
+}
+
+// existingValue returns the value of the iterator, which is not nil so that empty values are told
+// apart from missing keys.
+func existingValue(it storetypes.Iterator) []byte {
+	if value := it.Value(); value != nil {
+		return value
+	}
+	return []byte{}
+}
+
+// decodeChange decodes a key change with the decoder of a module codec, it returns nil if the key
+// doesn't belong to an object of the module schema.
+func decodeChange(decoder schema.KVDecoder, key, oldValue, newValue []byte) (*DecodedChange, error) {
+	decode := func(value []byte, remove bool) (*schema.StateObjectUpdate, error) {
+		updates, err := decoder(schema.KVPairUpdate{Key: key, Value: value, Remove: remove})
+		if err != nil || len(updates) == 0 {
+			return nil, err
+		}
+		return &updates[0], nil
+	}
+
+	var decoded *DecodedChange
+	if oldValue != nil {
+		update, err := decode(oldValue, false)
+		if err != nil || update == nil {
+			return nil, err
+		}
+		decoded = &DecodedChange{Type: update.TypeName, Key: update.Key, Old: update.Value}
+	}
+
+	update, err := decode(newValue, newValue == nil)
+	if err != nil || update == nil {
+		return decoded, err
+	}
+	if decoded == nil {
+		decoded = &DecodedChange{Type: update.TypeName, Key: update.Key}
+	}
+	if !update.Delete {
+		decoded.New = update.Value
+	}
+
+	return decoded, nil
+}
+
+func printChangeJSON(w io.Writer, change KVChange) error {
+	bz, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bz))
+	return err
+}
+
+func printChangeText(w io.Writer, change KVChange) error {
+	op := "set"
+	if change.Delete {
+		op = "delete"
+	}
+	if _, err := fmt.Fprintf(w, "%s %s %s old=%s new=%s\n", change.Store, op, change.Key, change.Old, change.New); err != nil {
+		return err
+	}
+	if d := change.Decoded; d != nil {
+		if _, err := fmt.Fprintf(w, "  %s %v old=%v new=%v\n", d.Type, d.Key, d.Old, d.New); err != nil {
+			return err
+		}
+	}
+	return nil
+}
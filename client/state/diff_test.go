@@ -0,0 +1,176 @@
+package state
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/dbadapter"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	from := dbadapter.Store{DB: dbm.NewMemDB()}
+	from.Set([]byte("a"), []byte("1"))
+	from.Set([]byte("b"), []byte("2"))
+	from.Set([]byte("c"), []byte("3"))
+	from.Set([]byte("e"), []byte{})
+
+	to := dbadapter.Store{DB: dbm.NewMemDB()}
+	to.Set([]byte("b"), []byte("2"))
+	to.Set([]byte("c"), []byte("4"))
+	to.Set([]byte("d"), []byte("5"))
+	to.Set([]byte("f"), []byte{})
+
+	type change struct{ key, oldValue, newValue string }
+	var changes []change
+	var deleted []bool
+	err := DiffKVStores(from, to, func(key, oldValue, newValue []byte) error {
+		changes = append(changes, change{string(key), string(oldValue), string(newValue)})
+		deleted = append(deleted, newValue == nil)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []change{
+		{"a", "1", ""},
+		{"c", "3", "4"},
+		{"d", "", "5"},
+		{"e", "", ""},
+		{"f", "", ""},
+	}, changes)
+	require.Equal(t, []bool{true, false, false, true, false}, deleted)
+}
+
+func TestDecodeChange(t *testing.T) {
+	decoder := func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+		if update.Key[0] != 'b' {
+			return nil, nil
+		}
+		return []schema.StateObjectUpdate{{
+			TypeName: "balances",
+			Key:      string(update.Key[1:]),
+			Value:    string(update.Value),
+			Delete:   update.Remove,
+		}}, nil
+	}
+
+	decoded, err := decodeChange(decoder, []byte("balice"), []byte("10"), []byte("20"))
+	require.NoError(t, err)
+	require.Equal(t, &DecodedChange{Type: "balances", Key: "alice", Old: "10", New: "20"}, decoded)
+
+	decoded, err = decodeChange(decoder, []byte("bbob"), nil, []byte("5"))
+	require.NoError(t, err)
+	require.Equal(t, &DecodedChange{Type: "balances", Key: "bob", New: "5"}, decoded)
+
+	decoded, err = decodeChange(decoder, []byte("bbob"), []byte("5"), nil)
+	require.NoError(t, err)
+	require.Equal(t, &DecodedChange{Type: "balances", Key: "bob", Old: "5"}, decoded)
+
+	decoded, err = decodeChange(decoder, []byte("params"), []byte("x"), []byte("y"))
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+}
+
+func TestDiffStores(t *testing.T) {
+	accKey := storetypes.NewKVStoreKey("acc")
+	otherKey := storetypes.NewKVStoreKey("other")
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	acc := rs.GetKVStore(accKey)
+	acc.Set([]byte("balice"), []byte("10"))
+	acc.Set([]byte("bbob"), []byte("5"))
+	acc.Set([]byte("params"), []byte("x"))
+	rs.GetKVStore(otherKey).Set([]byte("k"), []byte("v"))
+	rs.Commit()
+
+	acc.Set([]byte("balice"), []byte("20"))
+	acc.Set([]byte("bcarol"), []byte("1"))
+	acc.Set([]byte("params"), []byte("y"))
+	rs.Commit()
+
+	acc.Delete([]byte("bbob"))
+	acc.Delete([]byte("bcarol"))
+	acc.Set([]byte("params"), []byte("x"))
+	rs.Commit()
+
+	// the codecs are keyed by store name, the store of x/auth is named "acc"
+	codecs := map[string]schema.ModuleCodec{
+		"acc": {KVDecoder: func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+			if update.Key[0] != 'b' {
+				return nil, nil
+			}
+			return []schema.StateObjectUpdate{{
+				TypeName: "balances",
+				Key:      string(update.Key[1:]),
+				Value:    string(update.Value),
+				Delete:   update.Remove,
+			}}, nil
+		}},
+	}
+
+	var out bytes.Buffer
+	err := diffStores(log.NewNopLogger(), rs, 1, 3, nil, codecs, func(change KVChange) error {
+		return printChangeJSON(&out, change)
+	})
+	require.NoError(t, err)
+	// bcarol is created and deleted in between, params is set back to its value at height 1
+	require.Equal(t, `{"store":"acc","key":"62616C696365","delete":false,"old":"3130","new":"3230","decoded":{"type":"balances","key":"alice","old":"10","new":"20"}}
+{"store":"acc","key":"62626F62","delete":true,"old":"35","decoded":{"type":"balances","key":"bob","old":"5"}}
+`, out.String())
+
+	out.Reset()
+	err = diffStores(log.NewNopLogger(), rs, 1, 2, []string{"acc"}, codecs, func(change KVChange) error {
+		return printChangeText(&out, change)
+	})
+	require.NoError(t, err)
+	require.Equal(t, `acc set 62616C696365 old=3130 new=3230
+  balances alice old=10 new=20
+acc set 626361726F6C old= new=31
+  balances carol old=<nil> new=1
+acc set 706172616D73 old=78 new=79
+`, out.String())
+}
+
+func TestDiffStores_PrunedStore(t *testing.T) {
+	accKey := storetypes.NewKVStoreKey("acc")
+	otherKey := storetypes.NewKVStoreKey("other")
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	rs.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	rs.SetStorePruning(otherKey.Name(), pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for i := 1; i <= 5; i++ {
+		rs.GetKVStore(accKey).Set([]byte("k"), []byte{byte(i)})
+		rs.GetKVStore(otherKey).Set([]byte("k"), []byte{byte(i)})
+		rs.Commit()
+	}
+	require.Equal(t, int64(3), rs.StoreEarliestVersion(otherKey.Name()))
+
+	// the pruned store is skipped
+	var changes []KVChange
+	err := diffStores(log.NewNopLogger(), rs, 1, 5, nil, nil, func(change KVChange) error {
+		changes = append(changes, change)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "acc", changes[0].Store)
+
+	// unless it is requested
+	err = diffStores(log.NewNopLogger(), rs, 1, 5, []string{"other"}, nil, func(KVChange) error {
+		return nil
+	})
+	require.ErrorIs(t, err, storetypes.ErrStorePruned)
+}
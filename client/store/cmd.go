@@ -0,0 +1,17 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the store group command.
+func Cmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage the application stores",
+	}
+	cmd.AddCommand(
+		MigrateIAVLCmd(defaultNodeHome),
+	)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	dbm "github.com/cosmos/cosmos-db"
+	legacyiavl "github.com/cosmos/iavl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/iavl"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2/wrapper"
+)
+
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagHistory      = "history"
+)
+
+// MigrateIAVLCmd migrates the IAVL stores of the application database to the changeset storage
+// engine selected with iavl-backend = "changeset" in app.toml.
+func MigrateIAVLCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-iavl",
+		Short: "Migrate the IAVL stores to the changeset storage engine",
+		Long: `Migrate the latest version of all the IAVL stores, and optionally the versions before it, from the
+application database to the changeset storage engine under <home>/data/iavl.
+
+The node must be stopped before running the command, and must not be started until the migration completes.
+
+The root hash of every migrated version is verified against the legacy store, and the latest one against the
+commit info of the application. An interrupted migration is resumed by running the command again, stores that
+were fully migrated are verified and skipped.
+
+The changeset backend loads every IAVL store from <home>/data/iavl, so all the stores are always migrated.
+Once the migration completes, set iavl-backend = "changeset" in app.toml. The legacy IAVL data is left in the
+application database.`,
+		Example: "migrate-iavl --history 1000",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if home == "" {
+				home = defaultNodeHome
+			}
+			history := vp.GetUint32(FlagHistory)
+
+			// the database is locked while the node is running, opening it fails in that case
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			return migrateIAVLStores(ctx, db, filepath.Join(home, "data", "iavl"), history, logger)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Uint32(FlagHistory, 0, "Number of versions before the latest one to migrate, limited to the versions kept by pruning")
+
+	return cmd
+}
+
+func migrateIAVLStores(ctx context.Context, db dbm.DB, dir string, history uint32, logger log.Logger) error {
+	latest := rootmulti.GetLatestVersion(db)
+	if latest <= 0 {
+		return fmt.Errorf("the database has no valid heights to migrate, the latest height: %v", latest)
+	}
+
+	cInfo, err := rootmulti.NewStore(db, logger).GetCommitInfo(latest)
+	if err != nil {
+		return err
+	}
+
+	opts := iavl.MigrateOptions{
+		Options:         iavl.DefaultOptions(),
+		HistoryVersions: history,
+	}
+
+	migrated := 0
+	for _, storeInfo := range cInfo.StoreInfos {
+		storeLogger := logger.With("store", storeInfo.Name)
+		prefixDB := dbm.NewPrefixDB(db, []byte("s/k:"+storeInfo.Name+"/"))
+		legacy := legacyiavl.NewMutableTree(wrapper.NewDBWrapper(prefixDB), 0, true, storeLogger)
+		version, err := legacy.GetLatestVersion()
+		if err != nil {
+			return fmt.Errorf("failed to load store %s: %w", storeInfo.Name, err)
+		}
+		if version == 0 {
+			// stores of other types don't have an IAVL tree
+			storeLogger.Info("skipping store without IAVL data")
+			continue
+		}
+		if _, err := legacy.LoadVersion(latest); err != nil {
+			return fmt.Errorf("failed to load store %s: %w", storeInfo.Name, err)
+		}
+
+		opts.Logger = storeLogger
+		hash, err := iavl.MigrateStore(ctx, legacy, filepath.Join(dir, storeInfo.Name), latest, opts)
+		if err != nil {
+			return fmt.Errorf("failed to migrate store %s: %w", storeInfo.Name, err)
+		}
+		if want := storeInfo.GetHash(); !bytes.Equal(hash, want) {
+			return fmt.Errorf("store %s has root hash %X after migration, expected %X from the commit info", storeInfo.Name, hash, want)
+		}
+
+		storeLogger.Info("migrated store", "version", latest, "hash", fmt.Sprintf("%X", hash))
+		migrated++
+	}
+
+	logger.Info("migration complete, set iavl-backend = \"changeset\" in app.toml to use the migrated stores", "stores", migrated, "version", latest)
+	return nil
+}
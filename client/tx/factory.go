@@ -490,13 +490,20 @@ func (f Factory) getSimPK() (cryptotypes.PubKey, error) {
 // getSimSignatureData based on the pubKey type gets the correct SignatureData type
 // to use for building a simulation tx.
 func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureData {
-	multisigPubKey, ok := pk.(*multisig.LegacyAminoPubKey)
-	if !ok {
+	var numSigs int
+	switch multisigPubKey := pk.(type) {
+	case *multisig.LegacyAminoPubKey:
+		numSigs = int(multisigPubKey.Threshold)
+	case *multisig.WeightedThresholdPubKey:
+		// the number of signers reaching the threshold depends on their weights,
+		// estimate for all of them.
+		numSigs = len(multisigPubKey.PubKeys)
+	default:
 		return &signing.SingleSignatureData{SignMode: f.signMode}
 	}
 
-	multiSignatureData := make([]signing.SignatureData, 0, multisigPubKey.Threshold)
-	for i := uint32(0); i < multisigPubKey.Threshold; i++ {
+	multiSignatureData := make([]signing.SignatureData, 0, numSigs)
+	for range numSigs {
 		multiSignatureData = append(multiSignatureData, &signing.SingleSignatureData{
 			SignMode: f.SignMode(),
 		})
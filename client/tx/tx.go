@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -203,6 +204,42 @@ func SignWithPrivKey(
 	return sigV2, nil
 }
 
+// AggregateBLSSignatures aggregates the SIGN_MODE_BLS_AGGREGATE signatures of the given signatures,
+// which sign the same bytes, and returns the signatures with the aggregated signature set on the
+// first of them and the signature of the others emptied. The signatures of the other sign modes
+// are left untouched.
+func AggregateBLSSignatures(sigs []signing.SignatureV2) ([]signing.SignatureV2, error) {
+	first := -1
+	var blsSigs [][]byte
+	aggSigs := make([]signing.SignatureV2, len(sigs))
+	for i, sig := range sigs {
+		aggSigs[i] = sig
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_BLS_AGGREGATE {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		blsSigs = append(blsSigs, data.Signature)
+		aggSigs[i].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_BLS_AGGREGATE}
+	}
+	if first < 0 {
+		return nil, fmt.Errorf("no %s signature to aggregate", signing.SignMode_SIGN_MODE_BLS_AGGREGATE)
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(blsSigs)
+	if err != nil {
+		return nil, err
+	}
+	aggSigs[first].Data = &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_BLS_AGGREGATE,
+		Signature: aggSig,
+	}
+
+	return aggSigs, nil
+}
+
 // countDirectSigners counts the number of DIRECT signers in a signature data.
 func countDirectSigners(data signing.SignatureData) int {
 	switch data := data.(type) {
@@ -435,3 +435,14 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestAggregateBLSSignatures_NoBLSSignature(t *testing.T) {
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+	sigs := []signingtypes.SignatureV2{{
+		PubKey: pubKey,
+		Data:   &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: []byte("sig")},
+	}}
+
+	_, err := AggregateBLSSignatures(sigs)
+	require.ErrorContains(t, err, "no SIGN_MODE_BLS_AGGREGATE signature to aggregate")
+}
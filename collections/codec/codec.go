@@ -124,6 +124,70 @@ type UntypedValueCodec struct {
 	ValueType  func() string
 }
 
+// NewUntypedKeyCodec returns an UntypedKeyCodec for the provided KeyCodec.
+func NewUntypedKeyCodec[K any](k KeyCodec[K]) UntypedKeyCodec {
+	typeName := fmt.Sprintf("%T", *new(K))
+	checkType := func(key interface{}) (k K, err error) {
+		concrete, ok := key.(K)
+		if !ok {
+			return k, fmt.Errorf("%w: expected key of type %s, got %T", ErrEncoding, typeName, key)
+		}
+		return concrete, nil
+	}
+	return UntypedKeyCodec{
+		Decode: func(b []byte) (interface{}, error) {
+			read, key, err := k.Decode(b)
+			if err != nil {
+				return nil, err
+			}
+			if read != len(b) {
+				return nil, fmt.Errorf("%w: key decoded %d bytes out of %d", ErrEncoding, read, len(b))
+			}
+			return key, nil
+		},
+		Encode: func(key interface{}) ([]byte, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return nil, err
+			}
+			buffer := make([]byte, k.Size(concrete))
+			written, err := k.Encode(buffer, concrete)
+			if err != nil {
+				return nil, err
+			}
+			return buffer[:written], nil
+		},
+		DecodeJSON: func(b []byte) (interface{}, error) {
+			return k.DecodeJSON(b)
+		},
+		EncodeJSON: func(key interface{}) ([]byte, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return nil, err
+			}
+			return k.EncodeJSON(concrete)
+		},
+		Stringify: func(key interface{}) (string, error) {
+			concrete, err := checkType(key)
+			if err != nil {
+				return "", err
+			}
+			return k.Stringify(concrete), nil
+		},
+		KeyType: func() string { return k.KeyType() },
+	}
+}
+
+// UntypedKeyCodec wraps a KeyCodec to expose an untyped API for encoding and decoding keys.
+type UntypedKeyCodec struct {
+	Decode     func(b []byte) (interface{}, error)
+	Encode     func(key interface{}) ([]byte, error)
+	DecodeJSON func(b []byte) (interface{}, error)
+	EncodeJSON func(key interface{}) ([]byte, error)
+	Stringify  func(key interface{}) (string, error)
+	KeyType    func() string
+}
+
 // KeyToValueCodec converts a KeyCodec into a ValueCodec.
 func KeyToValueCodec[K any](keyCodec KeyCodec[K]) NameableValueCodec[K] {
 	return keyToValueCodec[K]{kc: keyCodec}
@@ -37,3 +37,38 @@ func TestUntypedValueCodec(t *testing.T) {
 		require.Equal(t, "hello", s)
 	})
 }
+
+func TestUntypedKeyCodec(t *testing.T) {
+	kc := NewUntypedKeyCodec(KeyCodec[uint64](NewUint64Key[uint64]()))
+
+	t.Run("encode/decode", func(t *testing.T) {
+		_, err := kc.Encode("hello")
+		require.ErrorIs(t, err, ErrEncoding)
+		b, err := kc.Encode(uint64(42))
+		require.NoError(t, err)
+		key, err := kc.Decode(b)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), key)
+		_, err = kc.Decode(append(b, 0))
+		require.ErrorIs(t, err, ErrEncoding)
+	})
+
+	t.Run("json encode/decode", func(t *testing.T) {
+		_, err := kc.EncodeJSON("hello")
+		require.ErrorIs(t, err, ErrEncoding)
+		b, err := kc.EncodeJSON(uint64(42))
+		require.NoError(t, err)
+		key, err := kc.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), key)
+	})
+
+	t.Run("stringify", func(t *testing.T) {
+		_, err := kc.Stringify("hello")
+		require.ErrorIs(t, err, ErrEncoding)
+		s, err := kc.Stringify(uint64(42))
+		require.NoError(t, err)
+		require.Equal(t, "42", s)
+		require.Equal(t, "uint64", kc.KeyType())
+	})
+}
@@ -2,6 +2,7 @@ package codec_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/colltest"
@@ -37,6 +38,11 @@ func TestKeyCorrectness(t *testing.T) {
 		colltest.TestKeyCodec(t, collections.Int32Key, -500)
 	})
 
+	t.Run("time", func(t *testing.T) {
+		colltest.TestKeyCodec(t, collections.TimeKey, time.Date(2026, 1, 25, 12, 34, 56, 123456789, time.UTC))
+		colltest.TestKeyCodec(t, collections.TimeKey, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))
+	})
+
 	t.Run("int64", func(t *testing.T) {
 		colltest.TestKeyCodec(t, collections.Int64Key, -100)
 	})
@@ -4,7 +4,10 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math"
 	"time"
+
+	"cosmossdk.io/schema"
 )
 
 // TimeValue returns a ValueCodec for time.Time.
@@ -62,4 +65,94 @@ func (timeValueCodec) ValueType() string {
 	return "time"
 }
 
+// SchemaCodec implements HasSchemaCodec, the time is indexed with nanosecond precision.
+func (timeValueCodec) SchemaCodec() (SchemaCodec[time.Time], error) {
+	return timeSchemaCodec(), nil
+}
+
 var _ ValueCodec[time.Time] = timeValueCodec{}
+
+func timeSchemaCodec() SchemaCodec[time.Time] {
+	return SchemaCodec[time.Time]{
+		Fields: []schema.Field{{Kind: schema.TimeKind}},
+		ToSchemaType: func(t time.Time) (any, error) {
+			return t.UTC(), nil
+		},
+		FromSchemaType: func(a any) (time.Time, error) {
+			t, ok := a.(time.Time)
+			if !ok {
+				return time.Time{}, fmt.Errorf("expected time.Time, got %T", a)
+			}
+			return t.UTC(), nil
+		},
+	}
+}
+
+// NewTimeKey returns a KeyCodec for time.Time.
+//
+// Binary format: 8 bytes of the int64 UnixNano encoded like Int64Key, which retains
+// ordering. Times out of the range of UnixNano, approximately years 1678 to 2262, can't be
+// encoded.
+// JSON format: RFC3339Nano string.
+func NewTimeKey() NameableKeyCodec[time.Time] {
+	return timeKey{}
+}
+
+type timeKey struct{}
+
+var (
+	minTimeKey = time.Unix(0, math.MinInt64)
+	maxTimeKey = time.Unix(0, math.MaxInt64)
+)
+
+func (timeKey) Encode(buffer []byte, key time.Time) (int, error) {
+	if key.Before(minTimeKey) || key.After(maxTimeKey) {
+		return 0, fmt.Errorf("%w: time %s out of the UnixNano range", ErrEncoding, key)
+	}
+	return int64Key[int64]{}.Encode(buffer, key.UnixNano())
+}
+
+func (timeKey) Decode(buffer []byte) (int, time.Time, error) {
+	n, nanos, err := int64Key[int64]{}.Decode(buffer)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return n, time.Unix(0, nanos).UTC(), nil
+}
+
+func (timeKey) Size(time.Time) int { return 8 }
+
+func (timeKey) EncodeJSON(value time.Time) ([]byte, error) {
+	return timeValueCodec{}.EncodeJSON(value)
+}
+
+func (timeKey) DecodeJSON(b []byte) (time.Time, error) {
+	return timeValueCodec{}.DecodeJSON(b)
+}
+
+func (timeKey) Stringify(key time.Time) string {
+	return key.UTC().Format(time.RFC3339Nano)
+}
+
+func (timeKey) KeyType() string {
+	return "time"
+}
+
+func (t timeKey) EncodeNonTerminal(buffer []byte, key time.Time) (int, error) {
+	return t.Encode(buffer, key)
+}
+
+func (t timeKey) DecodeNonTerminal(buffer []byte) (int, time.Time, error) {
+	return t.Decode(buffer)
+}
+
+func (timeKey) SizeNonTerminal(time.Time) int { return 8 }
+
+// SchemaCodec implements HasSchemaCodec, the time is indexed with nanosecond precision.
+func (timeKey) SchemaCodec() (SchemaCodec[time.Time], error) {
+	return timeSchemaCodec(), nil
+}
+
+func (t timeKey) WithName(name string) KeyCodec[time.Time] {
+	return NamedKeyCodec[time.Time]{KeyCodec: t, Name: name}
+}
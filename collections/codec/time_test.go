@@ -1,10 +1,13 @@
 package codec
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
 )
 
 func TestTimeValue_BinaryRoundTrip(t *testing.T) {
@@ -54,3 +57,54 @@ func TestTimeValue_Stringify(t *testing.T) {
 	require.Contains(t, s, "2026-01-25T12:34:56")
 	require.Contains(t, s, "Z")
 }
+
+func TestTimeKey_Ordering(t *testing.T) {
+	cdc := NewTimeKey()
+
+	times := []time.Time{
+		time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Unix(0, 0),
+		time.Unix(0, 1),
+		time.Date(2026, 1, 25, 12, 34, 56, 123456789, time.UTC),
+	}
+	var previous []byte
+	for _, tt := range times {
+		b := make([]byte, cdc.Size(tt))
+		_, err := cdc.Encode(b, tt)
+		require.NoError(t, err)
+		require.Equal(t, -1, bytes.Compare(previous, b))
+		previous = b
+	}
+
+	_, err := cdc.Encode(make([]byte, 8), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, ErrEncoding)
+}
+
+func TestTime_SchemaCodec(t *testing.T) {
+	tt := time.Date(2026, 1, 25, 12, 34, 56, 123456789, time.FixedZone("CET", 3600))
+
+	keyCdc, err := KeySchemaCodec(NewTimeKey())
+	require.NoError(t, err)
+	valueCdc, err := ValueSchemaCodec(TimeValue())
+	require.NoError(t, err)
+
+	for _, cdc := range []SchemaCodec[time.Time]{keyCdc, valueCdc} {
+		require.Equal(t, []schema.Field{{Kind: schema.TimeKind}}, cdc.Fields)
+
+		value, err := cdc.ToSchemaType(tt)
+		require.NoError(t, err)
+		require.NoError(t, schema.ValidateObjectValue([]schema.Field{{Name: "time", Kind: schema.TimeKind}}, value, nil))
+
+		got, err := cdc.FromSchemaType(value)
+		require.NoError(t, err)
+		require.True(t, tt.Equal(got))
+		require.Equal(t, time.UTC, got.Location())
+
+		_, err = cdc.FromSchemaType("2026-01-25")
+		require.Error(t, err)
+	}
+
+	named, err := NamedKeyCodec[time.Time]{KeyCodec: NewTimeKey(), Name: "expiration"}.SchemaCodec()
+	require.NoError(t, err)
+	require.Equal(t, []schema.Field{{Name: "expiration", Kind: schema.TimeKind}}, named.Fields)
+}
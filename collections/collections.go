@@ -1,12 +1,15 @@
 package collections
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"math"
 
 	"cosmossdk.io/collections/codec"
+	store "cosmossdk.io/collections/corecompat"
 	"cosmossdk.io/schema"
 )
 
@@ -52,6 +55,9 @@ var (
 	// BoolKey can be used to encode booleans. It uses a single byte to represent the boolean.
 	// 0x0 is used to represent false, and 0x1 is used to represent true.
 	BoolKey = codec.NewBoolKey[bool]()
+	// TimeKey can be used to encode time.Time keys. The encoding is the UnixNano of the
+	// time encoded like Int64Key, which retains ordering. Decoded times are in UTC.
+	TimeKey = codec.NewTimeKey()
 )
 
 // VALUES
@@ -90,6 +96,19 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// KeyCodec returns the codec used to encode/decode keys of the collection.
+	KeyCodec() codec.UntypedKeyCodec
+
+	// GetRaw returns the raw value bytes stored under the provided encoded key.
+	// Returns ErrNotFound if the key does not exist.
+	GetRaw(ctx context.Context, key []byte) ([]byte, error)
+
+	// WalkRaw walks over the encoded keys and raw values of the collection
+	// in the [start, end) range, where a nil bound means unbounded. Keys do
+	// not include the collection prefix. Walking stops when walkFunc returns
+	// true or an error.
+	WalkRaw(ctx context.Context, start, end []byte, reverse bool, walkFunc func(key, value []byte) (stop bool, err error)) error
+
 	genesisHandler
 
 	// collectionSchemaCodec returns the schema codec for this collection.
@@ -159,6 +178,58 @@ func (c collectionImpl[K, V]) ValueCodec() codec.UntypedValueCodec {
 	return codec.NewUntypedValueCodec(c.m.vc)
 }
 
+func (c collectionImpl[K, V]) KeyCodec() codec.UntypedKeyCodec {
+	return codec.NewUntypedKeyCodec(c.m.kc)
+}
+
+func (c collectionImpl[K, V]) GetRaw(ctx context.Context, key []byte) ([]byte, error) {
+	value, err := c.m.sa(ctx).Get(concatNew(c.m.prefix, key))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("%w: key '%X' of type %s", ErrNotFound, key, c.m.kc.KeyType())
+	}
+	return value, nil
+}
+
+func (c collectionImpl[K, V]) WalkRaw(ctx context.Context, start, end []byte, reverse bool, walkFunc func(key, value []byte) (stop bool, err error)) error {
+	prefixedStart := concatNew(c.m.prefix, start)
+	prefixedEnd := nextBytesPrefixKey(c.m.prefix)
+	if end != nil {
+		prefixedEnd = concatNew(c.m.prefix, end)
+	}
+	if bytes.Compare(prefixedStart, prefixedEnd) >= 0 {
+		return nil
+	}
+
+	s := c.m.sa(ctx)
+	var (
+		iter store.Iterator
+		err  error
+	)
+	if reverse {
+		iter, err = s.ReverseIterator(prefixedStart, prefixedEnd)
+	} else {
+		iter, err = s.Iterator(prefixedStart, prefixedEnd)
+	}
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stop, err := walkFunc(iter.Key()[len(c.m.prefix):], iter.Value())
+		if err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+	}
+	return nil
+}
+
 func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
@@ -41,3 +41,54 @@ func TestPrefix(t *testing.T) {
 		require.Equal(t, []byte("prefix"), prefix.Bytes())
 	})
 }
+
+func TestCollectionRawAccess(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "m", Uint64Key, Uint64Value)
+	NewMap(schemaBuilder, NewPrefix(2), "other", Uint64Key, Uint64Value)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, m.Set(ctx, i, i*10))
+	}
+
+	coll := schema.ListCollections()[0]
+	require.Equal(t, "m", coll.GetName())
+	kc, vc := coll.KeyCodec(), coll.ValueCodec()
+
+	key, err := kc.Encode(uint64(3))
+	require.NoError(t, err)
+	raw, err := coll.GetRaw(ctx, key)
+	require.NoError(t, err)
+	value, err := vc.Decode(raw)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), value)
+
+	missing, err := kc.Encode(uint64(10))
+	require.NoError(t, err)
+	_, err = coll.GetRaw(ctx, missing)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	walk := func(start, end []byte, reverse bool) (keys []uint64) {
+		err := coll.WalkRaw(ctx, start, end, reverse, func(key, value []byte) (bool, error) {
+			k, err := kc.Decode(key)
+			if err != nil {
+				return true, err
+			}
+			keys = append(keys, k.(uint64))
+			return len(keys) == 3, nil
+		})
+		require.NoError(t, err)
+		return keys
+	}
+	require.Equal(t, []uint64{0, 1, 2}, walk(nil, nil, false))
+	require.Equal(t, []uint64{4, 3, 2}, walk(nil, nil, true))
+	require.Equal(t, []uint64{2, 1, 0}, walk(nil, key, true))
+
+	start, err := kc.Encode(uint64(1))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, walk(start, key, false))
+	require.Empty(t, walk(key, start, false))
+}
@@ -0,0 +1,92 @@
+package collections
+
+import (
+	"context"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// ItemKey is the primary key of the value of an IndexedItem in its indexes.
+type ItemKey = noKey
+
+// ItemKeyCodec is the KeyCodec of ItemKey, it is the primary key codec of the indexes of an
+// IndexedItem.
+var ItemKeyCodec codec.KeyCodec[ItemKey] = noKey{}
+
+// IndexedItem works like an Item but creates references between fields of its value and
+// ItemKey in the indexes, so that the value can be matched by those fields.
+type IndexedItem[Value, Idx any] struct {
+	Indexes         Idx
+	computedIndexes []Index[ItemKey, Value]
+	i               Item[Value]
+}
+
+// NewIndexedItemSafe behaves like NewIndexedItem but returns errors.
+func NewIndexedItemSafe[V, I any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+	indexes I,
+) (*IndexedItem[V, I], error) {
+	indexesList, err := indexesListOf[I, ItemKey, V](indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IndexedItem[V, I]{
+		Indexes:         indexes,
+		computedIndexes: indexesList,
+		i:               NewItem(schema, prefix, name, valueCodec),
+	}, nil
+}
+
+// NewIndexedItem instantiates a new IndexedItem. It accepts the same arguments as NewItem
+// followed by the initialized indexes, whose primary key codec is ItemKeyCodec. Like for an
+// IndexedMap, the indexes are inferred using reflection unless Idx implements Indexes.
+// Panics on failure to create indexes. If you want an erroring API use NewIndexedItemSafe.
+func NewIndexedItem[Value, Idx any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[Value],
+	indexes Idx,
+) *IndexedItem[Value, Idx] {
+	ii, err := NewIndexedItemSafe(schema, prefix, name, valueCodec, indexes)
+	if err != nil {
+		panic(err)
+	}
+	return ii
+}
+
+// Get gets the item, if it is not set it returns an ErrNotFound error.
+func (i *IndexedItem[Value, Idx]) Get(ctx context.Context) (Value, error) {
+	return i.i.Get(ctx)
+}
+
+// Has reports whether the item exists in the store or not.
+func (i *IndexedItem[Value, Idx]) Has(ctx context.Context) (bool, error) {
+	return i.i.Has(ctx)
+}
+
+// Set sets the item and updates its references in the indexes.
+func (i *IndexedItem[Value, Idx]) Set(ctx context.Context, value Value) error {
+	for _, index := range i.computedIndexes {
+		err := index.Reference(ctx, ItemKey{}, value, cachedGet[ItemKey, Value](ctx, (Map[noKey, Value])(i.i), ItemKey{}))
+		if err != nil {
+			return err
+		}
+	}
+	return i.i.Set(ctx, value)
+}
+
+// Remove removes the item and its references in the indexes.
+func (i *IndexedItem[Value, Idx]) Remove(ctx context.Context) error {
+	for _, index := range i.computedIndexes {
+		err := index.Unreference(ctx, ItemKey{}, cachedGet[ItemKey, Value](ctx, (Map[noKey, Value])(i.i), ItemKey{}))
+		if err != nil {
+			return err
+		}
+	}
+	return i.i.Remove(ctx)
+}
@@ -50,16 +50,9 @@ func NewIndexedMapSafe[K, V, I any](
 	valueCodec codec.ValueCodec[V],
 	indexes I,
 ) (im *IndexedMap[K, V, I], err error) {
-	var indexesList []Index[K, V]
-	indexesImpl, ok := any(indexes).(Indexes[K, V])
-	if ok {
-		indexesList = indexesImpl.IndexesList()
-	} else {
-		// if does not implement Indexes, then we try to infer using reflection
-		indexesList, err = tryInferIndexes[I, K, V](indexes)
-		if err != nil {
-			return nil, fmt.Errorf("unable to infer indexes using reflection, consider implementing Indexes interface: %w", err)
-		}
+	indexesList, err := indexesListOf[I, K, V](indexes)
+	if err != nil {
+		return nil, err
 	}
 
 	return &IndexedMap[K, V, I]{
@@ -69,6 +62,20 @@ func NewIndexedMapSafe[K, V, I any](
 	}, nil
 }
 
+// indexesListOf returns the list of indexes of an Indexes implementation, or else of the fields of
+// a struct holding the indexes.
+func indexesListOf[I, K, V any](indexes I) ([]Index[K, V], error) {
+	if indexesImpl, ok := any(indexes).(Indexes[K, V]); ok {
+		return indexesImpl.IndexesList(), nil
+	}
+	// if does not implement Indexes, then we try to infer using reflection
+	indexesList, err := tryInferIndexes[I, K, V](indexes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to infer indexes using reflection, consider implementing Indexes interface: %w", err)
+	}
+	return indexesList, nil
+}
+
 var (
 	// testing sentinel errors
 	errNotStruct = errors.New("wanted struct or pointer to a struct")
@@ -0,0 +1,131 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// IndexedVec works like a Vec but creates references between fields of the elements and their
+// index in the Vec, which is the primary key of the Indexes. It can be used for queues whose
+// elements need to be found by some of their fields, such as a time.
+type IndexedVec[Value, Idx any] struct {
+	Indexes         Idx
+	computedIndexes []Index[uint64, Value]
+	v               Vec[Value]
+}
+
+// NewIndexedVecSafe behaves like NewIndexedVec but returns errors.
+func NewIndexedVecSafe[V, I any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+	indexes I,
+) (*IndexedVec[V, I], error) {
+	indexesList, err := indexesListOf[I, uint64, V](indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IndexedVec[V, I]{
+		Indexes:         indexes,
+		computedIndexes: indexesList,
+		v:               NewVec(schema, prefix, name, valueCodec),
+	}, nil
+}
+
+// NewIndexedVec instantiates a new IndexedVec. It accepts the same arguments as NewVec followed
+// by the initialized indexes, whose primary key is the index of the elements. Like for an
+// IndexedMap, the indexes are inferred using reflection unless Idx implements Indexes.
+// Panics on failure to create indexes. If you want an erroring API use NewIndexedVecSafe.
+func NewIndexedVec[Value, Idx any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[Value],
+	indexes Idx,
+) *IndexedVec[Value, Idx] {
+	iv, err := NewIndexedVecSafe(schema, prefix, name, valueCodec, indexes)
+	if err != nil {
+		panic(err)
+	}
+	return iv
+}
+
+// Push adds an element to the end of the IndexedVec and references it in the indexes.
+func (v *IndexedVec[Value, Idx]) Push(ctx context.Context, elem Value) error {
+	length, err := v.v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	for _, index := range v.computedIndexes {
+		err := index.Reference(ctx, length, elem, func() (Value, error) {
+			var zero Value
+			return zero, ErrNotFound
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return v.v.Push(ctx, elem)
+}
+
+// Pop removes the element at the end of the IndexedVec and its references in the indexes,
+// and returns it. Fails with ErrEmptyVec if the IndexedVec is empty.
+func (v *IndexedVec[Value, Idx]) Pop(ctx context.Context) (elem Value, err error) {
+	length, err := v.v.Len(ctx)
+	if err != nil {
+		return elem, err
+	}
+	if length == 0 {
+		return elem, ErrEmptyVec
+	}
+	for _, index := range v.computedIndexes {
+		err := index.Unreference(ctx, length-1, cachedGet[uint64, Value](ctx, v.v.elements, length-1))
+		if err != nil {
+			return elem, err
+		}
+	}
+	return v.v.Pop(ctx)
+}
+
+// Replace replaces the element at a given index and updates its references in the indexes.
+// Fails if the index is out of bounds.
+func (v *IndexedVec[Value, Idx]) Replace(ctx context.Context, index uint64, elem Value) error {
+	length, err := v.v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	if index >= length {
+		return fmt.Errorf("%w: length %d", ErrOutOfBounds, length)
+	}
+	for _, idx := range v.computedIndexes {
+		err := idx.Reference(ctx, index, elem, cachedGet[uint64, Value](ctx, v.v.elements, index))
+		if err != nil {
+			return err
+		}
+	}
+	return v.v.Replace(ctx, index, elem)
+}
+
+// Get returns the element at a given index. Returns ErrOutOfBounds if the index is out of bounds.
+func (v *IndexedVec[Value, Idx]) Get(ctx context.Context, index uint64) (Value, error) {
+	return v.v.Get(ctx, index)
+}
+
+// Len returns the length of the IndexedVec.
+func (v *IndexedVec[Value, Idx]) Len(ctx context.Context) (uint64, error) {
+	return v.v.Len(ctx)
+}
+
+// Iterate applies the same semantics as Vec.Iterate.
+func (v *IndexedVec[Value, Idx]) Iterate(ctx context.Context, rng Ranger[uint64]) (Iterator[uint64, Value], error) {
+	return v.v.Iterate(ctx, rng)
+}
+
+// Walk applies the same semantics as Vec.Walk.
+func (v *IndexedVec[Value, Idx]) Walk(ctx context.Context, rng Ranger[uint64], walkFn func(index uint64, elem Value) (stop bool, err error)) error {
+	return v.v.Walk(ctx, rng, walkFn)
+}
@@ -0,0 +1,131 @@
+package collections_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/collections/internal/testutil"
+)
+
+type unbonding struct {
+	Delegator      string
+	CompletionTime time.Time
+}
+
+type unbondingIndexes struct {
+	Delegator      *indexes.Multi[string, uint64, unbonding]
+	CompletionTime *indexes.TimeOrdered[uint64, unbonding]
+}
+
+func TestIndexedVec(t *testing.T) {
+	ctx := testutil.Context()
+	sk := testutil.KVStoreService(ctx, "test")
+	schema := collections.NewSchemaBuilder(sk)
+
+	queue := collections.NewIndexedVec(schema, collections.NewPrefix(0), "unbondings", colltest.MockValueCodec[unbonding](),
+		unbondingIndexes{
+			Delegator: indexes.NewMulti(schema, collections.NewPrefix(1), "unbondings_by_delegator", collections.StringKey, collections.Uint64Key, func(_ uint64, value unbonding) (string, error) {
+				return value.Delegator, nil
+			}),
+			CompletionTime: indexes.NewTimeOrdered(schema, collections.NewPrefix(2), "unbondings_by_completion_time", collections.Uint64Key, func(_ uint64, value unbonding) (time.Time, bool, error) {
+				return value.CompletionTime, true, nil
+			}),
+		},
+	)
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	require.NoError(t, queue.Push(ctx, unbonding{Delegator: "alice", CompletionTime: t2}))
+	require.NoError(t, queue.Push(ctx, unbonding{Delegator: "bob", CompletionTime: t1}))
+	require.NoError(t, queue.Push(ctx, unbonding{Delegator: "alice", CompletionTime: t1}))
+
+	iter, err := queue.Indexes.CompletionTime.IterateUntil(ctx, t1)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, pks)
+
+	// replace updates the indexes
+	require.NoError(t, queue.Replace(ctx, 1, unbonding{Delegator: "carol", CompletionTime: t2}))
+	delegatorIter, err := queue.Indexes.Delegator.MatchExact(ctx, "bob")
+	require.NoError(t, err)
+	pks, err = delegatorIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, pks)
+	require.ErrorIs(t, queue.Replace(ctx, 3, unbonding{}), collections.ErrOutOfBounds)
+
+	// pop removes the references of the last element
+	elem, err := queue.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, unbonding{Delegator: "alice", CompletionTime: t1}, elem)
+
+	delegatorIter, err = queue.Indexes.Delegator.MatchExact(ctx, "alice")
+	require.NoError(t, err)
+	pks, err = delegatorIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, pks)
+
+	iter, err = queue.Indexes.CompletionTime.IterateUntil(ctx, t2)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1}, pks)
+
+	length, err := queue.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), length)
+
+	_, err = queue.Pop(ctx)
+	require.NoError(t, err)
+	_, err = queue.Pop(ctx)
+	require.NoError(t, err)
+	_, err = queue.Pop(ctx)
+	require.ErrorIs(t, err, collections.ErrEmptyVec)
+}
+
+type denomParams struct {
+	Denom string
+}
+
+type denomParamsIndexes struct {
+	Denom *indexes.Unique[string, collections.ItemKey, denomParams]
+}
+
+func TestIndexedItem(t *testing.T) {
+	ctx := testutil.Context()
+	sk := testutil.KVStoreService(ctx, "test")
+	schema := collections.NewSchemaBuilder(sk)
+
+	params := collections.NewIndexedItem(schema, collections.NewPrefix(0), "params", colltest.MockValueCodec[denomParams](),
+		denomParamsIndexes{
+			Denom: indexes.NewUnique(schema, collections.NewPrefix(1), "params_by_denom", collections.StringKey, collections.ItemKeyCodec, func(_ collections.ItemKey, value denomParams) (string, error) {
+				return value.Denom, nil
+			}),
+		},
+	)
+
+	require.NoError(t, params.Set(ctx, denomParams{Denom: "stake"}))
+	_, err := params.Indexes.Denom.MatchExact(ctx, "stake")
+	require.NoError(t, err)
+
+	require.NoError(t, params.Set(ctx, denomParams{Denom: "atom"}))
+	_, err = params.Indexes.Denom.MatchExact(ctx, "stake")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = params.Indexes.Denom.MatchExact(ctx, "atom")
+	require.NoError(t, err)
+
+	require.NoError(t, params.Remove(ctx))
+	_, err = params.Indexes.Denom.MatchExact(ctx, "atom")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err := params.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
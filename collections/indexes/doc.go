@@ -1,3 +1,4 @@
-// Package indexes contains the most common indexes types to be used with a collections.IndexedMap.
+// Package indexes contains the most common indexes types to be used with a collections.IndexedMap,
+// collections.IndexedVec or collections.IndexedItem.
 // It also contains specialised helper functions to collect and query efficiently an index.
 package indexes
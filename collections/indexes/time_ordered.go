@@ -0,0 +1,114 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// TimeOrdered is an index ordering the primary keys by a time of their value, for instance an
+// expiration time, so that the values due at a given time can be iterated in time order.
+// Values without a time are not indexed.
+type TimeOrdered[PrimaryKey, Value any] struct {
+	getTime func(pk PrimaryKey, value Value) (t time.Time, ok bool, err error)
+	refKeys collections.KeySet[collections.Pair[time.Time, PrimaryKey]]
+}
+
+// NewTimeOrdered instantiates a new TimeOrdered index given a schema, a Prefix, the humanized
+// name for the index and the primary key codec. The getTimeFunc is a function that given the
+// primary key and value returns the time of the value, or false if it has no time.
+func NewTimeOrdered[PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getTimeFunc func(pk PrimaryKey, value Value) (t time.Time, ok bool, err error),
+) *TimeOrdered[PrimaryKey, Value] {
+	return &TimeOrdered[PrimaryKey, Value]{
+		getTime: getTimeFunc,
+		refKeys: collections.NewKeySet(
+			schema,
+			prefix,
+			name,
+			collections.PairKeyCodec(collections.TimeKey, pkCodec),
+			collections.WithKeySetSecondaryIndex(),
+		),
+	}
+}
+
+func (i *TimeOrdered[PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove the old indexes
+	case err == nil:
+		err = i.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're creating indexes for the first time.
+	// we do nothing.
+	case errors.Is(err, collections.ErrNotFound):
+	// default case means that there was some other error
+	default:
+		return err
+	}
+	t, ok, err := i.getTime(pk, newValue)
+	if err != nil || !ok {
+		return err
+	}
+	return i.refKeys.Set(ctx, collections.Join(t, pk))
+}
+
+func (i *TimeOrdered[PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *TimeOrdered[PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	t, ok, err := i.getTime(pk, value)
+	if err != nil || !ok {
+		return err
+	}
+	return i.refKeys.Remove(ctx, collections.Join(t, pk))
+}
+
+// Iterate iterates over the index given a Ranger of the pairs of time and primary key.
+func (i *TimeOrdered[PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[time.Time, PrimaryKey]]) (MultiIterator[time.Time, PrimaryKey], error) {
+	iter, err := i.refKeys.Iterate(ctx, ranger)
+	return (MultiIterator[time.Time, PrimaryKey])(iter), err
+}
+
+// MatchExact returns a MultiIterator containing all the primary keys of the values with the
+// provided time.
+func (i *TimeOrdered[PrimaryKey, Value]) MatchExact(ctx context.Context, t time.Time) (MultiIterator[time.Time, PrimaryKey], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[time.Time, PrimaryKey](t))
+}
+
+// IterateUntil returns a MultiIterator containing in time order all the primary keys of the
+// values with a time before or equal to the provided time, for instance the expired values.
+func (i *TimeOrdered[PrimaryKey, Value]) IterateUntil(ctx context.Context, t time.Time) (MultiIterator[time.Time, PrimaryKey], error) {
+	return i.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, PrimaryKey](t))
+}
+
+// WalkUntil calls walkFunc in time order with the time and primary key of the values with a
+// time before or equal to the provided time. The values must not be updated while walking,
+// collect the primary keys with IterateUntil to do so.
+func (i *TimeOrdered[PrimaryKey, Value]) WalkUntil(
+	ctx context.Context,
+	t time.Time,
+	walkFunc func(t time.Time, pk PrimaryKey) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, PrimaryKey](t), func(key collections.Pair[time.Time, PrimaryKey]) (bool, error) {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+func (i *TimeOrdered[PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Pair[time.Time, PrimaryKey]] {
+	return i.refKeys.KeyCodec()
+}
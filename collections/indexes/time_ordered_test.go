@@ -0,0 +1,71 @@
+package indexes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+)
+
+type grant struct {
+	Grantee    string
+	Expiration *time.Time
+}
+
+func TestTimeOrderedIndex(t *testing.T) {
+	sk, ctx := deps()
+	schema := collections.NewSchemaBuilder(sk)
+
+	ti := NewTimeOrdered(schema, collections.NewPrefix(1), "time_ordered_index", collections.StringKey, func(_ string, value grant) (time.Time, bool, error) {
+		if value.Expiration == nil {
+			return time.Time{}, false, nil
+		}
+		return *value.Expiration, true, nil
+	})
+
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	t3 := t2.Add(time.Hour)
+	notFound := func() (grant, error) { return grant{}, collections.ErrNotFound }
+
+	require.NoError(t, ti.Reference(ctx, "bob", grant{Expiration: &t2}, notFound))
+	require.NoError(t, ti.Reference(ctx, "alice", grant{Expiration: &t1}, notFound))
+	require.NoError(t, ti.Reference(ctx, "carol", grant{Expiration: &t3}, notFound))
+	require.NoError(t, ti.Reference(ctx, "dave", grant{}, notFound))
+
+	iter, err := ti.IterateUntil(ctx, t2)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, pks)
+
+	// the expiration of alice is extended after carol's
+	require.NoError(t, ti.Reference(ctx, "alice", grant{Expiration: &t3}, func() (grant, error) { return grant{Expiration: &t1}, nil }))
+
+	iter, err = ti.MatchExact(ctx, t3)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "carol"}, pks)
+
+	// bob's grant is removed
+	require.NoError(t, ti.Unreference(ctx, "bob", func() (grant, error) { return grant{Expiration: &t2}, nil }))
+	require.NoError(t, ti.Unreference(ctx, "dave", func() (grant, error) { return grant{}, nil }))
+
+	var walked []string
+	err = ti.WalkUntil(ctx, t3.Add(time.Second), func(expiration time.Time, pk string) (bool, error) {
+		require.Equal(t, t3, expiration)
+		walked = append(walked, pk)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "carol"}, walked)
+
+	iter, err = ti.IterateUntil(ctx, t2)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, pks)
+}
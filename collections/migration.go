@@ -0,0 +1,201 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultMigrationBatchSize is the default number of entries a Migration
+// processes per batch.
+const DefaultMigrationBatchSize = 1000
+
+// migration cursor states, stored as the first byte of the cursor.
+const (
+	migrationInProgress byte = iota
+	migrationDone
+)
+
+// WithMigrationBatchSize sets the maximum number of entries a Migration
+// processes per batch.
+func WithMigrationBatchSize(size int) func(opt *migrationOptions) {
+	return func(opt *migrationOptions) {
+		opt.batchSize = size
+	}
+}
+
+type migrationOptions struct {
+	batchSize int
+}
+
+// MigrationTransform transforms an entry of the collection being migrated
+// into an entry of the target collection.
+type MigrationTransform[OldK, OldV, NewK, NewV any] func(ctx context.Context, key OldK, value OldV) (NewK, NewV, error)
+
+// Migration moves the entries of a collection into another collection,
+// transforming every entry along the way. Entries are processed in bounded
+// batches and the progress is stored in state, so a large migration can be
+// spread across multiple blocks by calling MigrateBatch once per block.
+//
+// The old collection is usually declared with the legacy prefix and codecs
+// on its own SchemaBuilder, which is never built, so that it does not
+// become part of the module schema:
+//
+//	legacy := collections.NewSchemaBuilder(storeService)
+//	oldParams := collections.NewMap(legacy, collections.NewPrefix(1), "params", collections.StringKey, legacyCodec)
+//
+// Migrated entries are removed from the old collection. If the old and the new
+// collection share the same prefix space, the migration happens in place and
+// the transform must preserve the binary encoding of the key.
+type Migration[OldK, OldV, NewK, NewV any] struct {
+	from      Map[OldK, OldV]
+	to        Map[NewK, NewV]
+	transform MigrationTransform[OldK, OldV, NewK, NewV]
+	cursor    Item[[]byte]
+	batchSize int
+	inPlace   bool
+}
+
+// NewMigration instantiates a new Migration from the from collection to the to
+// collection. The progress of the migration is stored under the provided prefix
+// and name, which must be unique within the schema.
+func NewMigration[OldK, OldV, NewK, NewV any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	from Map[OldK, OldV],
+	to Map[NewK, NewV],
+	transform MigrationTransform[OldK, OldV, NewK, NewV],
+	options ...func(opt *migrationOptions),
+) Migration[OldK, OldV, NewK, NewV] {
+	o := &migrationOptions{batchSize: DefaultMigrationBatchSize}
+	for _, opt := range options {
+		opt(o)
+	}
+	if o.batchSize <= 0 {
+		panic(fmt.Sprintf("invalid migration batch size: %d", o.batchSize))
+	}
+	return Migration[OldK, OldV, NewK, NewV]{
+		from:      from,
+		to:        to,
+		transform: transform,
+		cursor:    NewItem(schema, prefix, name, BytesValue),
+		batchSize: o.batchSize,
+		inPlace:   bytes.HasPrefix(from.prefix, to.prefix) || bytes.HasPrefix(to.prefix, from.prefix),
+	}
+}
+
+// Done reports whether the migration has completed.
+func (m Migration[OldK, OldV, NewK, NewV]) Done(ctx context.Context) (bool, error) {
+	cursor, err := m.cursor.Get(ctx)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return len(cursor) > 0 && cursor[0] == migrationDone, nil
+}
+
+// Migrate runs the migration to completion, one batch after the other.
+func (m Migration[OldK, OldV, NewK, NewV]) Migrate(ctx context.Context) error {
+	for {
+		done, err := m.MigrateBatch(ctx)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// MigrateBatch migrates the next batch of entries and records the progress.
+// It returns true once every entry of the old collection was migrated, after
+// which further calls are no-ops.
+func (m Migration[OldK, OldV, NewK, NewV]) MigrateBatch(ctx context.Context) (done bool, err error) {
+	cursor, err := m.cursor.Get(ctx)
+	switch {
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return false, err
+	case len(cursor) == 0:
+		return false, fmt.Errorf("%w: empty migration cursor", ErrEncoding)
+	case cursor[0] == migrationDone:
+		return true, nil
+	}
+
+	// resume right after the last migrated key.
+	var start []byte
+	if len(cursor) > 0 {
+		start = append(bytes.Clone(cursor[1:]), 0)
+	}
+
+	// collect the batch before writing, so that the iterator is not
+	// invalidated by the writes.
+	keys, values, more, err := m.readBatch(ctx, start)
+	if err != nil {
+		return false, err
+	}
+
+	var lastKey []byte
+	for i := range keys {
+		lastKey, err = m.migrateEntry(ctx, keys[i], values[i])
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if !more {
+		return true, m.cursor.Set(ctx, []byte{migrationDone})
+	}
+	return false, m.cursor.Set(ctx, append([]byte{migrationInProgress}, lastKey...))
+}
+
+func (m Migration[OldK, OldV, NewK, NewV]) readBatch(ctx context.Context, start []byte) (keys []OldK, values []OldV, more bool, err error) {
+	iter, err := m.from.IterateRaw(ctx, start, nil, OrderAscending)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if len(keys) == m.batchSize {
+			return keys, values, true, nil
+		}
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		keys = append(keys, kv.Key)
+		values = append(values, kv.Value)
+	}
+	return keys, values, false, nil
+}
+
+// migrateEntry migrates a single entry and returns the encoded old key.
+func (m Migration[OldK, OldV, NewK, NewV]) migrateEntry(ctx context.Context, key OldK, value OldV) ([]byte, error) {
+	oldKey, err := EncodeKeyWithPrefix(m.from.prefix, m.from.kc, key)
+	if err != nil {
+		return nil, err
+	}
+	newKey, newValue, err := m.transform(ctx, key, value)
+	if err != nil {
+		return nil, fmt.Errorf("migration of key %s: %w", m.from.kc.Stringify(key), err)
+	}
+
+	if m.inPlace {
+		encodedNewKey, err := EncodeKeyWithPrefix(m.to.prefix, m.to.kc, newKey)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(oldKey, encodedNewKey) {
+			return nil, fmt.Errorf("%w: in place migration of key %s changed its encoding", ErrConflict, m.from.kc.Stringify(key))
+		}
+	} else if err := m.from.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	if err := m.to.Set(ctx, newKey, newValue); err != nil {
+		return nil, err
+	}
+	return oldKey[len(m.from.prefix):], nil
+}
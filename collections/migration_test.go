@@ -0,0 +1,119 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigration(t *testing.T) {
+	sk, ctx := deps()
+
+	legacy := NewSchemaBuilder(sk)
+	from := NewMap(legacy, NewPrefix(0), "legacy_balances", StringKey, StringValue)
+
+	schemaBuilder := NewSchemaBuilder(sk)
+	to := NewMap(schemaBuilder, NewPrefix(1), "balances", Uint64Key, Uint64Value)
+	migration := NewMigration(schemaBuilder, NewPrefix(2), "balances_migration", from, to,
+		func(_ context.Context, key, value string) (uint64, uint64, error) {
+			k, err := strconv.ParseUint(key, 10, 64)
+			if err != nil {
+				return 0, 0, err
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			return k, v * 2, err
+		},
+		WithMigrationBatchSize(3),
+	)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := 0; i < 7; i++ {
+		require.NoError(t, from.Set(ctx, strconv.Itoa(i), strconv.Itoa(i*10)))
+	}
+
+	// the migration takes three batches, one per simulated block.
+	for i := 0; i < 2; i++ {
+		done, err := migration.MigrateBatch(ctx)
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+	done, err := migration.Done(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+
+	done, err = migration.MigrateBatch(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	done, err = migration.Done(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	for i := uint64(0); i < 7; i++ {
+		v, err := to.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i*20, v)
+	}
+	iter, err := from.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// entries written after completion are not migrated.
+	require.NoError(t, from.Set(ctx, "10", "10"))
+	done, err = migration.MigrateBatch(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	has, err := to.Has(ctx, 10)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrationInPlace(t *testing.T) {
+	sk, ctx := deps()
+
+	legacy := NewSchemaBuilder(sk)
+	from := NewMap(legacy, NewPrefix(0), "legacy_counters", Uint64Key, StringValue)
+
+	schemaBuilder := NewSchemaBuilder(sk)
+	to := NewMap(schemaBuilder, NewPrefix(0), "counters", Uint64Key, Uint64Value)
+	migration := NewMigration(schemaBuilder, NewPrefix(1), "counters_migration", from, to,
+		func(_ context.Context, key uint64, value string) (uint64, uint64, error) {
+			v, err := strconv.ParseUint(value, 10, 64)
+			return key, v, err
+		},
+		WithMigrationBatchSize(2),
+	)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, from.Set(ctx, i, strconv.FormatUint(i, 10)))
+	}
+	require.NoError(t, migration.Migrate(ctx))
+
+	for i := uint64(0); i < 5; i++ {
+		v, err := to.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i, v)
+	}
+
+	t.Run("key encoding changes are rejected", func(t *testing.T) {
+		sk, ctx := deps()
+		legacy := NewSchemaBuilder(sk)
+		from := NewMap(legacy, NewPrefix(0), "legacy", Uint64Key, StringValue)
+		schemaBuilder := NewSchemaBuilder(sk)
+		to := NewMap(schemaBuilder, NewPrefix(0), "new", StringKey, StringValue)
+		migration := NewMigration(schemaBuilder, NewPrefix(1), "migration", from, to,
+			func(_ context.Context, key uint64, value string) (string, string, error) {
+				return fmt.Sprint(key), value, nil
+			},
+		)
+		require.NoError(t, from.Set(ctx, 1, "one"))
+		_, err := migration.MigrateBatch(ctx)
+		require.ErrorIs(t, err, ErrConflict)
+	})
+}
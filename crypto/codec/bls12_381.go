@@ -0,0 +1,15 @@
+//go:build bls12381
+
+package codec
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// registerBLSPubKey registers bls12_381 public keys, accepted as account keys
+// for SIGN_MODE_BLS_AGGREGATE.
+func registerBLSPubKey(registry codectypes.InterfaceRegistry, pk *cryptotypes.PubKey) {
+	registry.RegisterImplementations(pk, &bls12_381.PubKey{})
+}
@@ -0,0 +1,12 @@
+//go:build !bls12381
+
+package codec
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// registerBLSPubKey is a no-op, bls12_381 public keys are only accepted as
+// account keys by binaries built with the bls12381 build tag.
+func registerBLSPubKey(codectypes.InterfaceRegistry, *cryptotypes.PubKey) {}
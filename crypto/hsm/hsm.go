@@ -0,0 +1,124 @@
+// Package hsm signs with the secp256r1 key pairs of PKCS#11 tokens, e.g. HSMs,
+// cloud KMS or SoftHSM. The private keys never leave the token.
+//
+// Access to PKCS#11 tokens requires cgo and the pkcs11 build tag, executables
+// built without it fail to open tokens.
+package hsm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+type (
+	// Token is a logged in session with a PKCS#11 token.
+	Token interface {
+		// PublicKey returns the public key of the keyLabel key pair.
+		PublicKey(keyLabel string) (*ecdsa.PublicKey, error)
+		// SignDigest signs digest with the private key of the keyLabel key pair
+		// (CKM_ECDSA) and returns the raw r || s signature.
+		SignDigest(keyLabel string, digest []byte) ([]byte, error)
+		// Close logs out and closes the session.
+		Close() error
+	}
+
+	// OpenTokenFn defines a function opening a session with the token labeled
+	// tokenLabel of the PKCS#11 library module, logged in with pin.
+	OpenTokenFn func(module, tokenLabel, pin string) (Token, error)
+)
+
+var openToken OpenTokenFn
+
+// SetOpenToken sets the function opening the sessions with the tokens, e.g. to
+// use a mock token in tests.
+func SetOpenToken(fn OpenTokenFn) {
+	openToken = fn
+}
+
+// Key references a secp256r1 key pair of a PKCS#11 token.
+type Key struct {
+	// Module is the path of the PKCS#11 library of the token.
+	Module string
+	// TokenLabel is the label of the token.
+	TokenLabel string
+	// KeyLabel is the label of the key pair in the token.
+	KeyLabel string
+}
+
+// String implements the Stringer interface.
+func (k Key) String() string {
+	return fmt.Sprintf("%s/%s", k.TokenLabel, k.KeyLabel)
+}
+
+// PubKey returns the public key of the key pair, logging in the token with pin.
+func (k Key) PubKey(pin string) (*secp256r1.PubKey, error) {
+	token, err := k.open(pin)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Close()
+
+	return k.pubKey(token)
+}
+
+// Sign signs the SHA-256 digest of msg with the private key of the key pair,
+// logging in the token with pin. The signature is the low-s normalized r || s
+// encoding verified by secp256r1.PubKey.
+func (k Key) Sign(pin string, msg []byte) ([]byte, error) {
+	token, err := k.open(pin)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Close()
+
+	digest := sha256.Sum256(msg)
+	sig, err := token.SignDigest(k.KeyLabel, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", k, err)
+	}
+	if len(sig) != 64 {
+		return nil, fmt.Errorf("%s returned a %d bytes signature, expected 64", k, len(sig))
+	}
+
+	// tokens do not normalize s but secp256r1 signatures are only valid in the
+	// lower half of the curve order
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(p256HalfOrder) > 0 {
+		s.Sub(p256Order, s)
+		s.FillBytes(sig[32:])
+	}
+	return sig, nil
+}
+
+var (
+	p256Order     = elliptic.P256().Params().N
+	p256HalfOrder = new(big.Int).Rsh(p256Order, 1)
+)
+
+func (k Key) open(pin string) (Token, error) {
+	if openToken == nil {
+		return nil, errors.New("no PKCS#11 token opener configured")
+	}
+	token, err := openToken(k.Module, k.TokenLabel, pin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 token %s: %w", k.TokenLabel, err)
+	}
+	return token, nil
+}
+
+func (k Key) pubKey(token Token) (*secp256r1.PubKey, error) {
+	pub, err := token.PublicKey(k.KeyLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the public key of %s: %w", k, err)
+	}
+	if pub.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("%s is not a secp256r1 key", k)
+	}
+	return secp256r1.NewPubKeyFromBytes(elliptic.MarshalCompressed(pub.Curve, pub.X, pub.Y))
+}
@@ -0,0 +1,16 @@
+//go:build !cgo || !pkcs11
+
+package hsm
+
+import (
+	"errors"
+)
+
+// If PKCS#11 support (build tag) has been enabled, which implies a CGO dependency,
+// set the openToken function which is responsible for loading the PKCS#11 library
+// at runtime or returning an error.
+func init() {
+	openToken = func(string, string, string) (Token, error) {
+		return nil, errors.New("support for PKCS#11 tokens is not available in this executable")
+	}
+}
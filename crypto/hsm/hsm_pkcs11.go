@@ -0,0 +1,163 @@
+//go:build cgo && pkcs11
+
+package hsm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+// oidP256 is the object identifier of the secp256r1 (prime256v1) curve.
+var oidP256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+
+// If PKCS#11 support (build tag) has been enabled, which implies a CGO dependency,
+// set the openToken function which is responsible for loading the PKCS#11 library
+// at runtime or returning an error.
+func init() {
+	openToken = openPKCS11Token
+}
+
+type token struct {
+	ctx     *pkcs11.Ctx
+	slot    uint
+	session pkcs11.SessionHandle
+	// finalize is whether the library was initialized by the token, and is
+	// finalized on close.
+	finalize bool
+}
+
+func openPKCS11Token(module, tokenLabel, pin string) (Token, error) {
+	ctx := pkcs11.New(module)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load the PKCS#11 library %s", module)
+	}
+	t := &token{ctx: ctx, finalize: true}
+	if err := ctx.Initialize(); errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		t.finalize = false
+	} else if err != nil {
+		ctx.Destroy()
+		return nil, err
+	}
+
+	if err := t.login(tokenLabel, pin); err != nil {
+		t.destroy()
+		return nil, err
+	}
+	return t, nil
+}
+
+// login opens a session with the token labeled tokenLabel and logs in as user.
+func (t *token) login(tokenLabel, pin string) error {
+	slots, err := t.ctx.GetSlotList(true)
+	if err != nil {
+		return err
+	}
+	for _, slot := range slots {
+		info, err := t.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return err
+		}
+		if info.Label != tokenLabel {
+			continue
+		}
+
+		t.slot = slot
+		if t.session, err = t.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION); err != nil {
+			return err
+		}
+		if err := t.ctx.Login(t.session, pkcs11.CKU_USER, pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+			_ = t.ctx.CloseSession(t.session)
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("no token labeled %q", tokenLabel)
+}
+
+// findObject returns the single object of class labeled label.
+func (t *token) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := t.ctx.FindObjectsInit(t.session, template); err != nil {
+		return 0, err
+	}
+	objects, _, err := t.ctx.FindObjects(t.session, 2)
+	if finalErr := t.ctx.FindObjectsFinal(t.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("no key labeled %q", label)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("several keys labeled %q", label)
+	}
+}
+
+// PublicKey implements Token.
+func (t *token) PublicKey(keyLabel string) (*ecdsa.PublicKey, error) {
+	object, err := t.findObject(pkcs11.CKO_PUBLIC_KEY, keyLabel)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := t.ctx.GetAttributeValue(t.session, object, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(attrs[0].Value, &curve); err != nil || !curve.Equal(oidP256) {
+		return nil, errors.New("not a secp256r1 key")
+	}
+	// the point is a DER octet string, some tokens omit the encoding though
+	point := attrs[1].Value
+	var octets []byte
+	if rest, err := asn1.Unmarshal(point, &octets); err == nil && len(rest) == 0 {
+		point = octets
+	}
+	return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+}
+
+// SignDigest implements Token.
+func (t *token) SignDigest(keyLabel string, digest []byte) ([]byte, error) {
+	object, err := t.findObject(pkcs11.CKO_PRIVATE_KEY, keyLabel)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.ctx.SignInit(t.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, object); err != nil {
+		return nil, err
+	}
+	return t.ctx.Sign(t.session, digest)
+}
+
+// Close implements Token.
+func (t *token) Close() error {
+	err := errors.Join(
+		t.ctx.Logout(t.session),
+		t.ctx.CloseSession(t.session),
+	)
+	t.destroy()
+	return err
+}
+
+func (t *token) destroy() {
+	if t.finalize {
+		_ = t.ctx.Finalize()
+	}
+	t.ctx.Destroy()
+}
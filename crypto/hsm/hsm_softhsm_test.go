@@ -0,0 +1,98 @@
+//go:build cgo && pkcs11
+
+package hsm
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSoftHSM signs with a key pair generated in the token set by the PKCS11_MODULE,
+// PKCS11_TOKEN and PKCS11_PIN environment variables, e.g. an initialized SoftHSM token:
+//
+//	softhsm2-util --init-token --free --label test --so-pin 0000 --pin 1234
+//	PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so PKCS11_TOKEN=test PKCS11_PIN=1234 make test-pkcs11
+func TestSoftHSM(t *testing.T) {
+	module := os.Getenv("PKCS11_MODULE")
+	if module == "" {
+		t.Skip("PKCS11_MODULE is not set")
+	}
+	SetOpenToken(openPKCS11Token)
+
+	pin := os.Getenv("PKCS11_PIN")
+	key := Key{Module: module, TokenLabel: os.Getenv("PKCS11_TOKEN"), KeyLabel: fmt.Sprintf("test-%d", time.Now().UnixNano())}
+	generateKeyPair(t, key, pin)
+
+	pub, err := key.PubKey(pin)
+	require.NoError(t, err)
+	msg := []byte("sign bytes")
+	for range 8 {
+		sig, err := key.Sign(pin, msg)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+	}
+
+	_, err = key.Sign(pin+"0", msg)
+	require.Error(t, err)
+	missing := key
+	missing.KeyLabel += "-missing"
+	_, err = missing.PubKey(pin)
+	require.ErrorContains(t, err, "no key labeled")
+}
+
+// generateKeyPair generates a secp256r1 key pair labeled key.KeyLabel in the token,
+// which is destroyed at the end of the test.
+func generateKeyPair(t *testing.T, key Key, pin string) {
+	t.Helper()
+	ecParams, err := asn1.Marshal(oidP256)
+	require.NoError(t, err)
+
+	var pub, priv pkcs11.ObjectHandle
+	withSession(t, key, pin, func(tok *token) {
+		pub, priv, err = tok.ctx.GenerateKeyPair(tok.session,
+			[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, key.KeyLabel),
+				pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+				pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, key.KeyLabel),
+				pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+				pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+				pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			})
+		require.NoError(t, err)
+	})
+
+	t.Cleanup(func() {
+		withSession(t, key, pin, func(tok *token) {
+			require.NoError(t, tok.ctx.DestroyObject(tok.session, pub))
+			require.NoError(t, tok.ctx.DestroyObject(tok.session, priv))
+		})
+	})
+}
+
+// withSession calls fn with a read/write session logged in the token.
+func withSession(t *testing.T, key Key, pin string, fn func(*token)) {
+	t.Helper()
+	opened, err := openPKCS11Token(key.Module, key.TokenLabel, pin)
+	require.NoError(t, err)
+	tok := opened.(*token)
+	defer func() { require.NoError(t, tok.Close()) }()
+
+	// objects are created in read/write sessions
+	rwSession, err := tok.ctx.OpenSession(tok.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tok.ctx.CloseSession(rwSession)) }()
+
+	fn(&token{ctx: tok.ctx, session: rwSession})
+}
@@ -0,0 +1,88 @@
+package hsm_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hsm"
+)
+
+// mockToken holds key pairs in memory, its signatures are not normalized.
+type mockToken map[string]*ecdsa.PrivateKey
+
+func (m mockToken) PublicKey(keyLabel string) (*ecdsa.PublicKey, error) {
+	key, ok := m[keyLabel]
+	if !ok {
+		return nil, fmt.Errorf("no key labeled %q", keyLabel)
+	}
+	return &key.PublicKey, nil
+}
+
+func (m mockToken) SignDigest(keyLabel string, digest []byte) ([]byte, error) {
+	key, ok := m[keyLabel]
+	if !ok {
+		return nil, fmt.Errorf("no key labeled %q", keyLabel)
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig, nil
+}
+
+func (m mockToken) Close() error { return nil }
+
+func setMockToken(t *testing.T, tokenLabel, pin string, token hsm.Token) {
+	t.Helper()
+	hsm.SetOpenToken(func(_, label, userPin string) (hsm.Token, error) {
+		if label != tokenLabel {
+			return nil, fmt.Errorf("no token labeled %q", label)
+		}
+		if userPin != pin {
+			return nil, errors.New("incorrect PIN")
+		}
+		return token, nil
+	})
+}
+
+func TestKey(t *testing.T) {
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	setMockToken(t, "token", "1234", mockToken{"validator": p256, "p384": p384})
+
+	key := hsm.Key{Module: "libmock.so", TokenLabel: "token", KeyLabel: "validator"}
+	pub, err := key.PubKey("1234")
+	require.NoError(t, err)
+	require.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), p256.X, p256.Y), pub.Bytes())
+
+	// the token signatures are normalized, about half of them have a high s
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	msg := []byte("sign bytes")
+	for range 32 {
+		sig, err := key.Sign("1234", msg)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+		require.True(t, new(big.Int).SetBytes(sig[32:]).Cmp(halfOrder) <= 0)
+	}
+
+	_, err = key.Sign("0000", msg)
+	require.ErrorContains(t, err, "incorrect PIN")
+	_, err = hsm.Key{Module: "libmock.so", TokenLabel: "other", KeyLabel: "validator"}.PubKey("1234")
+	require.ErrorContains(t, err, `no token labeled "other"`)
+	_, err = hsm.Key{Module: "libmock.so", TokenLabel: "token", KeyLabel: "missing"}.Sign("1234", msg)
+	require.ErrorContains(t, err, `no key labeled "missing"`)
+	_, err = hsm.Key{Module: "libmock.so", TokenLabel: "token", KeyLabel: "p384"}.PubKey("1234")
+	require.ErrorContains(t, err, "not a secp256r1 key")
+}
@@ -39,4 +39,14 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend delegates listing the keys and signing to an external signer process
+//		over the RemoteSigner gRPC protocol, through a unix socket or mutual TLS. It never
+//		handles private keys, which are managed by the signer.
+//
+// # PKCS#11 records
+//
+// All backends but remote can store references to the secp256r1 key pairs of PKCS#11
+// tokens, e.g. HSMs, with Keyring.SavePKCS11Key. Sign routes the signatures of such records
+// to the token through the crypto/hsm package, prompting for the PIN of the token unless
+// set with WithPKCS11PIN or the PKCS11_PIN environment variable.
 package keyring
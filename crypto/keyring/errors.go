@@ -35,4 +35,12 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrRemoteUnsupported is raised when trying to manage the keys of a remote signer.
+	ErrRemoteUnsupported = errors.New("the keys of the remote backend are managed by its signer")
+	// ErrRemoteInvalidSignature is raised when the remote signer returns an invalid signature.
+	ErrRemoteInvalidSignature = errors.New("remote signer returned an invalid signature")
+	// ErrNotPKCS11Obj is raised when record.GetPkcs11() returns nil.
+	ErrNotPKCS11Obj = errors.New("not a PKCS#11 object")
+	// ErrPKCS11InvalidSignature is raised when a PKCS#11 token generates an invalid signature.
+	ErrPKCS11InvalidSignature = errors.New("PKCS#11 token generated an invalid signature")
 )
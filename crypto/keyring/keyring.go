@@ -36,6 +36,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -57,7 +58,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 	// List all keys.
 	List() ([]*Record, error)
@@ -91,6 +92,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SavePKCS11Key retrieves the public key of a secp256r1 key pair of a PKCS#11 token
+	// and persists a reference to it.
+	SavePKCS11Key(uid, module, tokenLabel, keyLabel string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -166,7 +171,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -175,9 +180,14 @@ func newKeyringGeneric(
 		err error
 	)
 
+	// the PIN of PKCS#11 tokens is prompted for on the user input by default
+	opts = append([]Option{WithPKCS11PIN(newPKCS11PINPrompt(userInput))}, opts...)
+
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return newRemoteKeystore(rootDir, cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -212,6 +222,7 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 	options := Options{
 		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.MlDsa65},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+		PKCS11PIN:            newPKCS11PINPrompt(nil),
 	}
 
 	for _, optionFn := range opts {
@@ -253,12 +264,17 @@ func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
 		return "", err
 	}
 
+	return armorPubKey(ks.cdc, k)
+}
+
+// armorPubKey returns the public key of a record in ASCII armored format.
+func armorPubKey(cdc codec.Codec, k *Record) (string, error) {
 	key, err := k.GetPubKey()
 	if err != nil {
 		return "", err
 	}
 
-	bz, err := ks.cdc.MarshalInterface(key)
+	bz, err := cdc.MarshalInterface(key)
 	if err != nil {
 		return "", err
 	}
@@ -418,6 +434,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetPkcs11() != nil:
+		return ks.signWithPKCS11(k, msg)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
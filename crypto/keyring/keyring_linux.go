@@ -40,6 +40,12 @@ type Options struct {
 	LedgerSigSkipDERConv bool
 	// KeyctlScope defines the scope of the keyctl's keyring.
 	KeyctlScope string
+	// RemoteSigner configures the connection of the "remote" backend to its signer,
+	// it defaults to the config.toml file of the keyring-remote directory.
+	RemoteSigner *RemoteSignerConfig
+	// PKCS11PIN returns the PIN of the PKCS#11 token labeled tokenLabel,
+	// it defaults to the PKCS11_PIN environment variable or a prompt.
+	PKCS11PIN func(tokenLabel string) (string, error)
 }
 
 func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyring.Config {
@@ -60,7 +66,7 @@ func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyr
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "keyctl".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote", "keyctl".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
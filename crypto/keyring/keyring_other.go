@@ -25,6 +25,12 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// configure the connection of the "remote" backend to its signer,
+	// it defaults to the config.toml file of the keyring-remote directory
+	RemoteSigner *RemoteSignerConfig
+	// return the PIN of the PKCS#11 token labeled tokenLabel, it defaults to the
+	// PKCS11_PIN environment variable or a prompt
+	PKCS11PIN func(tokenLabel string) (string, error)
 }
 
 func New(
@@ -0,0 +1,90 @@
+package keyring
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hsm"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// PKCS11PINEnv is the environment variable holding the PIN of the PKCS#11 tokens,
+// the PIN is prompted for if it is not set.
+const PKCS11PINEnv = "PKCS11_PIN"
+
+// WithPKCS11PIN sets the function returning the PIN of the PKCS#11 token labeled
+// tokenLabel, used to sign with PKCS#11 records.
+func WithPKCS11PIN(fn func(tokenLabel string) (string, error)) Option {
+	return func(options *Options) {
+		options.PKCS11PIN = fn
+	}
+}
+
+// newPKCS11PINPrompt returns a function reading the PIN of a token from the
+// PKCS11_PIN environment variable, or prompting for it on buf.
+func newPKCS11PINPrompt(buf io.Reader) func(string) (string, error) {
+	return func(tokenLabel string) (string, error) {
+		if pin, ok := os.LookupEnv(PKCS11PINEnv); ok {
+			return pin, nil
+		}
+		if buf == nil {
+			return "", fmt.Errorf("the PIN of the PKCS#11 token %s is required, set %s", tokenLabel, PKCS11PINEnv)
+		}
+
+		pin, err := input.GetPassword(fmt.Sprintf("Enter PIN of PKCS#11 token %s:", tokenLabel), bufio.NewReader(buf))
+		// unlike passphrases, PINs may be shorter than input.MinPassLength
+		if pin == "" {
+			return "", err
+		}
+		return pin, nil
+	}
+}
+
+func (ks keystore) SavePKCS11Key(uid, module, tokenLabel, keyLabel string) (*Record, error) {
+	pin, err := ks.options.PKCS11PIN(tokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := hsm.Key{Module: module, TokenLabel: tokenLabel, KeyLabel: keyLabel}.PubKey(pin)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewPKCS11Record(uid, pub, module, tokenLabel, keyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
+// signWithPKCS11 signs msg with the token key referenced by a PKCS#11 record. The
+// signature is verified against the public key of the record, in case another
+// token or key pair was given the same labels.
+func (ks keystore) signWithPKCS11(k *Record, msg []byte) ([]byte, types.PubKey, error) {
+	item := k.GetPkcs11()
+	if item == nil {
+		return nil, nil, ErrNotPKCS11Obj
+	}
+	key := hsm.Key{Module: item.Module, TokenLabel: item.TokenLabel, KeyLabel: item.KeyLabel}
+	pin, err := ks.options.PKCS11PIN(key.TokenLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := key.Sign(pin, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !pub.VerifySignature(msg, sig) {
+		return nil, nil, fmt.Errorf("%w: %s does not hold the key of %s", ErrPKCS11InvalidSignature, key, k.Name)
+	}
+	return sig, pub, nil
+}
@@ -0,0 +1,115 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hsm"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// mockToken holds key pairs in memory.
+type mockToken map[string]*ecdsa.PrivateKey
+
+func (m mockToken) PublicKey(keyLabel string) (*ecdsa.PublicKey, error) {
+	key, ok := m[keyLabel]
+	if !ok {
+		return nil, fmt.Errorf("no key labeled %q", keyLabel)
+	}
+	return &key.PublicKey, nil
+}
+
+func (m mockToken) SignDigest(keyLabel string, digest []byte) ([]byte, error) {
+	key, ok := m[keyLabel]
+	if !ok {
+		return nil, fmt.Errorf("no key labeled %q", keyLabel)
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig, nil
+}
+
+func (m mockToken) Close() error { return nil }
+
+func TestPKCS11Key(t *testing.T) {
+	validatorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	token := mockToken{"validator": validatorKey}
+	hsm.SetOpenToken(func(module, tokenLabel, pin string) (hsm.Token, error) {
+		require.Equal(t, "libmock.so", module)
+		require.Equal(t, "token", tokenLabel)
+		require.Equal(t, "1234", pin)
+		return token, nil
+	})
+
+	kr := NewInMemory(getCodec(), WithPKCS11PIN(func(tokenLabel string) (string, error) {
+		return "1234", nil
+	}))
+	record, err := kr.SavePKCS11Key("hsm", "libmock.so", "token", "validator")
+	require.NoError(t, err)
+	require.Equal(t, TypePKCS11, record.GetType())
+	require.Equal(t, "pkcs11", record.GetType().String())
+
+	// the record only references the token key
+	record, err = kr.Key("hsm")
+	require.NoError(t, err)
+	require.Equal(t, &Record_Pkcs11{Module: "libmock.so", TokenLabel: "token", KeyLabel: "validator"}, record.GetPkcs11())
+	pub, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), validatorKey.X, validatorKey.Y), pub.Bytes())
+
+	msg := []byte("sign bytes")
+	sig, signPub, err := kr.Sign("hsm", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = kr.ExportPrivKeyArmor("hsm", "passphrase")
+	require.ErrorIs(t, err, ErrPrivKeyExtr)
+
+	// another key pair under the same label does not sign for the record
+	token["validator"], err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("hsm", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrPKCS11InvalidSignature)
+
+	_, err = kr.SavePKCS11Key("missing", "libmock.so", "token", "missing")
+	require.ErrorContains(t, err, `no key labeled "missing"`)
+
+	require.NoError(t, kr.Delete("hsm"))
+	_, err = kr.Key("hsm")
+	require.Error(t, err)
+}
+
+func TestPKCS11PINPrompt(t *testing.T) {
+	t.Setenv(PKCS11PINEnv, "1234")
+	pin, err := newPKCS11PINPrompt(nil)("token")
+	require.NoError(t, err)
+	require.Equal(t, "1234", pin)
+
+	// PINs shorter than passphrases are prompted for
+	require.NoError(t, os.Unsetenv(PKCS11PINEnv))
+	pin, err = newPKCS11PINPrompt(strings.NewReader("5678\n"))("token")
+	require.NoError(t, err)
+	require.Equal(t, "5678", pin)
+	_, err = newPKCS11PINPrompt(nil)("token")
+	require.ErrorContains(t, err, PKCS11PINEnv)
+}
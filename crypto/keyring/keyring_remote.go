@@ -0,0 +1,351 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/pelletier/go-toml/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	keyringRemoteDirName = "keyring-remote"
+	remoteConfigFileName = "config.toml"
+	remoteSocketFileName = "signer.sock"
+
+	// remoteSignerTimeout bounds each call to the remote signer, which may wait
+	// for an operator to approve a signature.
+	remoteSignerTimeout = 5 * time.Minute
+)
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteSignerConfig configures the connection of the "remote" backend to its signer.
+type RemoteSignerConfig struct {
+	// Endpoint is either a unix socket, e.g. "unix:///path/to/signer.sock", or the
+	// "host:port" address of a signer requiring mutual TLS.
+	Endpoint string `toml:"endpoint"`
+	// CACert is the PEM file of the CA certifying the signer.
+	CACert string `toml:"ca-cert"`
+	// ClientCert and ClientKey are the PEM files of the certificate the keyring
+	// authenticates with.
+	ClientCert string `toml:"client-cert"`
+	ClientKey  string `toml:"client-key"`
+}
+
+// WithRemoteSigner sets the connection of the "remote" backend to its signer.
+func WithRemoteSigner(config RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = &config
+	}
+}
+
+// loadRemoteSignerConfig reads the config.toml file of the keyring-remote directory
+// of rootDir, if any. The signer defaults to the signer.sock socket of the directory.
+func loadRemoteSignerConfig(rootDir string) (*RemoteSignerConfig, error) {
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+	config := &RemoteSignerConfig{Endpoint: "unix://" + filepath.Join(dir, remoteSocketFileName)}
+
+	bz, err := os.ReadFile(filepath.Join(dir, remoteConfigFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := toml.Unmarshal(bz, config); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to read %s", remoteConfigFileName)
+	}
+
+	// the certificates are relative to the keyring-remote directory
+	for _, file := range []*string{&config.CACert, &config.ClientCert, &config.ClientKey} {
+		if *file != "" && !filepath.IsAbs(*file) {
+			*file = filepath.Join(dir, *file)
+		}
+	}
+	return config, nil
+}
+
+// transportCredentials returns the credentials of the connection to the signer. A
+// unix socket is protected by its file permissions, a TCP connection requires mutual TLS.
+func (c RemoteSignerConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if strings.HasPrefix(c.Endpoint, "unix:") {
+		return insecure.NewCredentials(), nil
+	}
+	if c.CACert == "" || c.ClientCert == "" || c.ClientKey == "" {
+		return nil, fmt.Errorf("remote signer %s requires mutual TLS, set its CA certificate and the client certificate and key", c.Endpoint)
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := os.ReadFile(c.CACert)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in %s", c.CACert)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// remoteKeystore is a keyring delegating the listing of its keys and signing to a
+// remote signer, it never handles private keys.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+func newRemoteKeystore(rootDir string, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.MlDsa65},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	config := options.RemoteSigner
+	if config == nil {
+		var err error
+		config, err = loadRemoteSignerConfig(rootDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	creds, err := config.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	// the connection is established on the first call to the signer
+	conn, err := grpc.NewClient(config.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: options,
+	}, nil
+}
+
+// remoteError converts the NOT_FOUND errors of the signer to ErrKeyNotFound.
+func remoteError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, status.Convert(err).Message())
+	}
+	return errorsmod.Wrap(err, "remote signer")
+}
+
+// remoteRecord converts a key of the signer to a Record.
+func (ks remoteKeystore) remoteRecord(key *RemoteSignerKey) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned no key")
+	}
+	pk, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewRemoteRecord(key.Name, pk)
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pk types.PubKey
+	if err := ks.cdc.UnpackAny(any, &pk); err != nil {
+		return nil, errorsmod.Wrap(err, "remote signer returned an invalid public key")
+	}
+	return pk, nil
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Keys(ctx, &RemoteSignerKeysRequest{})
+	if err != nil {
+		return nil, remoteError(err)
+	}
+
+	records := make([]*Record, len(res.Keys))
+	for i, key := range res.Keys {
+		if records[i], err = ks.remoteRecord(key); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+	return records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, &RemoteSignerKeyRequest{Name: uid})
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	return ks.remoteRecord(res.Key)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.KeyByAddress(ctx, &RemoteSignerKeyByAddressRequest{Address: address.Bytes()})
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	return ks.remoteRecord(res.Key)
+}
+
+// Sign signs msg with the key uid of the remote signer. The signature must be
+// made by the pubkey of the key, so that the signer can't substitute another key.
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, &RemoteSignerSignRequest{Name: uid, Msg: msg, SignMode: signMode})
+	if err != nil {
+		return nil, nil, remoteError(err)
+	}
+	signerPub, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !pub.Equals(signerPub) {
+		return nil, nil, errorsmod.Wrapf(ErrRemoteInvalidSignature, "signed by %s instead of the key %s", signerPub, uid)
+	}
+	if !pub.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrRemoteInvalidSignature
+	}
+	return res.Signature, pub, nil
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(k.Name, msg, signMode)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return armorPubKey(ks.cdc, k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return armorPubKey(ks.cdc, k)
+}
+
+// MigrateAll has nothing to migrate, the keys are not stored in the keyring.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return nil, nil
+}
+
+// The keys are managed by the remote signer, the methods below are not supported.
+
+func (ks remoteKeystore) Delete(string) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) Rename(string, string) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SavePKCS11Key(string, string, string, string) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) ImportPubKey(string, string) error { return ErrRemoteUnsupported }
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
@@ -67,6 +67,20 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewRemoteRecord creates a new Record with remote item
+func NewRemoteRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
+	recordRemote := &Record_Remote{}
+	recordRemoteItem := &Record_Remote_{recordRemote}
+	return newRecord(name, pk, recordRemoteItem)
+}
+
+// NewPKCS11Record creates a new Record with PKCS#11 item
+func NewPKCS11Record(name string, pk cryptotypes.PubKey, module, tokenLabel, keyLabel string) (*Record, error) {
+	recordPKCS11 := &Record_Pkcs11{module, tokenLabel, keyLabel}
+	recordPKCS11Item := &Record_Pkcs11_{recordPKCS11}
+	return newRecord(name, pk, recordPKCS11Item)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -97,6 +111,10 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetRemote() != nil:
+		return TypeRemote
+	case k.GetPkcs11() != nil:
+		return TypePKCS11
 	default:
 		panic("unrecognized record type")
 	}
@@ -0,0 +1,80 @@
+// remote-signer is a stand-in signer for the "remote" keyring backend, for testing.
+// It signs with the keys of a local keyring, which are managed with the usual
+// `keys` commands and the same --keyring-dir and --keyring-backend, e.g.:
+//
+//	simd keys add alice --keyring-dir ~/.signer --keyring-backend test
+//	remote-signer -home ~/.signer -listen unix://$HOME/.simapp/keyring-remote/signer.sock
+//	simd tx bank send alice ... --keyring-backend remote
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func main() {
+	home := flag.String("home", "", "The directory of the keyring of the signer")
+	backend := flag.String("keyring-backend", keyring.BackendTest, "The backend of the keyring of the signer (os|file|kwallet|pass|test)")
+	listen := flag.String("listen", "", "The endpoint to listen on, either unix:///path/to/signer.sock or host:port")
+	tlsCert := flag.String("tls-cert", "", "The PEM certificate of the signer, required by a host:port endpoint")
+	tlsKey := flag.String("tls-key", "", "The PEM private key of the certificate of the signer")
+	clientCA := flag.String("client-ca", "", "The PEM certificate of the CA certifying the clients")
+	flag.Parse()
+
+	if err := run(*home, *backend, *listen, *tlsCert, *tlsKey, *clientCA); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(home, backend, listen, tlsCert, tlsKey, clientCA string) error {
+	if home == "" || listen == "" {
+		return fmt.Errorf("-home and -listen are required")
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr, err := keyring.New(sdk.KeyringServiceName(), backend, home, os.Stdin, codec.NewProtoCodec(registry))
+	if err != nil {
+		return err
+	}
+
+	// a unix socket is protected by its file permissions, a TCP endpoint requires mutual TLS
+	var tlsConfig *tls.Config
+	if !strings.HasPrefix(listen, "unix://") {
+		if tlsCert == "" || tlsKey == "" || clientCA == "" {
+			return fmt.Errorf("%s requires -tls-cert, -tls-key and -client-ca", listen)
+		}
+		if tlsConfig, err = remotesigner.NewTLSConfig(tlsCert, tlsKey, clientCA); err != nil {
+			return err
+		}
+	}
+
+	lis, err := remotesigner.Listen(listen)
+	if err != nil {
+		return err
+	}
+	srv := remotesigner.NewGRPCServer(kr, tlsConfig)
+
+	go func() {
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		<-sigs
+		srv.GracefulStop()
+	}()
+
+	fmt.Fprintf(os.Stderr, "remote signer listening on %s\n", listen)
+	return srv.Serve(lis)
+}
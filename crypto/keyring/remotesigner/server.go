@@ -0,0 +1,158 @@
+// Package remotesigner implements a stand-in signer for the "remote" keyring
+// backend, serving the RemoteSigner protocol from a local keyring. It is meant
+// for testing, production signers keep their keys in an HSM or a custody service.
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ keyring.RemoteSignerServer = server{}
+
+type server struct {
+	keyring.UnimplementedRemoteSignerServer
+
+	kr keyring.Keyring
+}
+
+// NewServer returns a RemoteSigner server signing with the local, ledger and PKCS#11 keys of kr.
+func NewServer(kr keyring.Keyring) keyring.RemoteSignerServer {
+	return server{kr: kr}
+}
+
+// NewGRPCServer returns a gRPC server serving NewServer(kr). If tlsConfig is not nil,
+// the connections are authenticated with it.
+func NewGRPCServer(kr keyring.Keyring, tlsConfig *tls.Config) *grpc.Server {
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcSrv := grpc.NewServer(opts...)
+	keyring.RegisterRemoteSignerServer(grpcSrv, NewServer(kr))
+	return grpcSrv
+}
+
+// Listen listens on endpoint, either a unix socket, e.g. "unix:///path/to/signer.sock",
+// or a "host:port" TCP address.
+func Listen(endpoint string) (net.Listener, error) {
+	if path, ok := strings.CutPrefix(endpoint, "unix://"); ok {
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", endpoint)
+}
+
+// NewTLSConfig returns a mutual TLS configuration, the server authenticates with
+// certFile and keyFile and requires client certificates certified by clientCAFile.
+func NewTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in %s", clientCAFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// Keys implements the RemoteSigner/Keys RPC.
+func (s server) Keys(context.Context, *keyring.RemoteSignerKeysRequest) (*keyring.RemoteSignerKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*keyring.RemoteSignerKey, 0, len(records))
+	for _, record := range records {
+		if canSign(record) {
+			keys = append(keys, remoteSignerKey(record))
+		}
+	}
+	return &keyring.RemoteSignerKeysResponse{Keys: keys}, nil
+}
+
+// Key implements the RemoteSigner/Key RPC.
+func (s server) Key(_ context.Context, req *keyring.RemoteSignerKeyRequest) (*keyring.RemoteSignerKeyResponse, error) {
+	record, err := s.signingKey(s.kr.Key(req.Name))
+	if err != nil {
+		return nil, err
+	}
+	return &keyring.RemoteSignerKeyResponse{Key: remoteSignerKey(record)}, nil
+}
+
+// KeyByAddress implements the RemoteSigner/KeyByAddress RPC.
+func (s server) KeyByAddress(_ context.Context, req *keyring.RemoteSignerKeyByAddressRequest) (*keyring.RemoteSignerKeyByAddressResponse, error) {
+	record, err := s.signingKey(s.kr.KeyByAddress(sdk.AccAddress(req.Address)))
+	if err != nil {
+		return nil, err
+	}
+	return &keyring.RemoteSignerKeyByAddressResponse{Key: remoteSignerKey(record)}, nil
+}
+
+// Sign implements the RemoteSigner/Sign RPC.
+func (s server) Sign(_ context.Context, req *keyring.RemoteSignerSignRequest) (*keyring.RemoteSignerSignResponse, error) {
+	record, err := s.signingKey(s.kr.Key(req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pub, err := s.kr.Sign(record.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, err
+	}
+	anyPub, err := codectypes.NewAnyWithValue(pub)
+	if err != nil {
+		return nil, err
+	}
+	return &keyring.RemoteSignerSignResponse{Signature: sig, PubKey: anyPub}, nil
+}
+
+// signingKey returns the record if it can sign, and the NOT_FOUND code otherwise.
+func (s server) signingKey(record *keyring.Record, err error) (*keyring.Record, error) {
+	if errorsmod.IsOf(err, sdkerrors.ErrKeyNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+	if !canSign(record) {
+		return nil, status.Errorf(codes.NotFound, "%s: key cannot sign", record.Name)
+	}
+	return record, nil
+}
+
+// canSign returns whether the keyring holds the private key of the record, or its device.
+func canSign(record *keyring.Record) bool {
+	return record.GetLocal() != nil || record.GetLedger() != nil || record.GetPkcs11() != nil
+}
+
+func remoteSignerKey(record *keyring.Record) *keyring.RemoteSignerKey {
+	return &keyring.RemoteSignerKey{Name: record.Name, PubKey: record.PubKey}
+}
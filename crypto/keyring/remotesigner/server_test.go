@@ -0,0 +1,248 @@
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func getCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// startSigner serves the keys of a new in-memory keyring on endpoint.
+func startSigner(t *testing.T, endpoint string, tlsConfig *tls.Config) (keyring.Keyring, net.Addr) {
+	t.Helper()
+	kr := keyring.NewInMemory(getCodec())
+
+	lis, err := remotesigner.Listen(endpoint)
+	require.NoError(t, err)
+	srv := remotesigner.NewGRPCServer(kr, tlsConfig)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return kr, lis.Addr()
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+	signerKr, _ := startSigner(t, "unix://"+filepath.Join(dir, "signer.sock"), nil)
+
+	local, _, err := signerKr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	kr, err := keyring.New(t.Name(), keyring.BackendRemote, dir, nil, cdc,
+		keyring.WithRemoteSigner(keyring.RemoteSignerConfig{Endpoint: "unix://" + filepath.Join(dir, "signer.sock")}))
+	require.NoError(t, err)
+	require.Equal(t, keyring.BackendRemote, kr.Backend())
+
+	// only the keys the signer can sign with are listed
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "alice", records[0].Name)
+	require.Equal(t, keyring.TypeRemote, records[0].GetType())
+	localPub, err := local.GetPubKey()
+	require.NoError(t, err)
+	remotePub, err := records[0].GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(remotePub))
+
+	addr, err := local.GetAddress()
+	require.NoError(t, err)
+	record, err := kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "alice", record.Name)
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = kr.Key("bob")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, _, err = kr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// the keys are managed by the signer
+	_, _, err = kr.NewMnemonic("bob", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, keyring.ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("alice", "passphrase")
+	require.ErrorIs(t, err, keyring.ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("alice"), keyring.ErrRemoteUnsupported)
+
+	armor, err := kr.ExportPubKeyArmor("alice")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+}
+
+func TestRemoteKeyringDefaultSocket(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "keyring-remote"), 0o700))
+	signerKr, _ := startSigner(t, "unix://"+filepath.Join(dir, "keyring-remote", "signer.sock"), nil)
+	_, _, err := signerKr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, err := keyring.New(t.Name(), keyring.BackendRemote, dir, nil, getCodec())
+	require.NoError(t, err)
+	_, err = kr.Key("alice")
+	require.NoError(t, err)
+}
+
+// otherKeyKeyring signs with the "mallory" key instead of the requested one.
+type otherKeyKeyring struct {
+	keyring.Keyring
+}
+
+func (kr otherKeyKeyring) Sign(_ string, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	return kr.Keyring.Sign("mallory", msg, signMode)
+}
+
+func TestRemoteKeyringSignerKey(t *testing.T) {
+	dir := t.TempDir()
+	endpoint := "unix://" + filepath.Join(dir, "signer.sock")
+	signerKr := keyring.NewInMemory(getCodec())
+	for _, name := range []string{"alice", "mallory"} {
+		_, _, err := signerKr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+
+	lis, err := remotesigner.Listen(endpoint)
+	require.NoError(t, err)
+	srv := remotesigner.NewGRPCServer(otherKeyKeyring{signerKr}, nil)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	kr, err := keyring.New(t.Name(), keyring.BackendRemote, dir, nil, getCodec(),
+		keyring.WithRemoteSigner(keyring.RemoteSignerConfig{Endpoint: endpoint}))
+	require.NoError(t, err)
+
+	// the signature is valid, but not made by the key of alice
+	_, _, err = kr.Sign("alice", []byte("sign bytes"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, keyring.ErrRemoteInvalidSignature)
+
+	_, _, err = kr.Sign("mallory", []byte("sign bytes"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	remoteDir := filepath.Join(dir, "keyring-remote")
+	require.NoError(t, os.Mkdir(remoteDir, 0o700))
+
+	ca, caKey := generateCert(t, nil, nil, "ca")
+	writeCert(t, remoteDir, "ca", ca, caKey)
+	serverCert, serverKey := generateCert(t, ca, caKey, "server")
+	writeCert(t, remoteDir, "server", serverCert, serverKey)
+	clientCert, clientKey := generateCert(t, ca, caKey, "client")
+	writeCert(t, remoteDir, "client", clientCert, clientKey)
+
+	tlsConfig, err := remotesigner.NewTLSConfig(
+		filepath.Join(remoteDir, "server.pem"), filepath.Join(remoteDir, "server-key.pem"), filepath.Join(remoteDir, "ca.pem"))
+	require.NoError(t, err)
+	signerKr, addr := startSigner(t, "127.0.0.1:0", tlsConfig)
+	_, _, err = signerKr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// a TCP endpoint requires mutual TLS
+	_, err = keyring.New(t.Name(), keyring.BackendRemote, dir, nil, getCodec(),
+		keyring.WithRemoteSigner(keyring.RemoteSignerConfig{Endpoint: addr.String()}))
+	require.ErrorContains(t, err, "requires mutual TLS")
+
+	// the certificates of the config file are relative to the keyring-remote directory
+	config := fmt.Sprintf("endpoint = %q\nca-cert = \"ca.pem\"\nclient-cert = \"client.pem\"\nclient-key = \"client-key.pem\"\n", addr.String())
+	require.NoError(t, os.WriteFile(filepath.Join(remoteDir, "config.toml"), []byte(config), 0o600))
+
+	kr, err := keyring.New(t.Name(), keyring.BackendRemote, dir, nil, getCodec())
+	require.NoError(t, err)
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// a client certificate not certified by the CA is rejected
+	otherCA, otherCAKey := generateCert(t, nil, nil, "other-ca")
+	otherCert, otherKey := generateCert(t, otherCA, otherCAKey, "client")
+	writeCert(t, remoteDir, "client", otherCert, otherKey)
+
+	kr, err = keyring.New(t.Name(), keyring.BackendRemote, dir, nil, getCodec())
+	require.NoError(t, err)
+	_, _, err = kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Error(t, err)
+}
+
+// generateCert returns a certificate signed by parent, or a self-signed CA if parent is nil.
+func generateCert(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// writeCert writes the <name>.pem certificate and <name>-key.pem key files in dir.
+func writeCert(t *testing.T, dir, name string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".pem"), certPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+"-key.pem"), keyPEM, 0o600))
+}
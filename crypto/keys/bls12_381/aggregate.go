@@ -0,0 +1,15 @@
+//go:build !bls12381
+
+package bls12_381
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+// It returns an error without the bls12381 build tag.
+func AggregateSignatures(_ [][]byte) ([]byte, error) {
+	return nil, errDisabled
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of msg by all the given public keys.
+// No aggregated signature is valid without the bls12381 build tag.
+func VerifyAggregateSignature(_ []*PubKey, _, _ []byte) bool {
+	return false
+}
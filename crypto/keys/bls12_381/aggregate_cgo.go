@@ -0,0 +1,59 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// dstMinPk is the domain separation tag of the signatures, it must match the one of the
+// CometBFT keys so that the signatures of PrivKey.Sign can be aggregated.
+var dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	for _, sig := range sigs {
+		if len(sig) != bls12381.SignatureLength {
+			return nil, fmt.Errorf("invalid signature size %d", len(sig))
+		}
+	}
+
+	var agg blst.P2Aggregate
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of msg by all the given public keys.
+//
+// All the public keys must have proven the possession of their private key, otherwise a rogue
+// key can forge an aggregated signature.
+func VerifyAggregateSignature(pubKeys []*PubKey, msg, sig []byte) bool {
+	if len(pubKeys) == 0 || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk := new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pk == nil || !pk.KeyValidate() {
+			return false
+		}
+		pks[i] = pk
+	}
+
+	return signature.FastAggregateVerify(true, pks, msg, dstMinPk)
+}
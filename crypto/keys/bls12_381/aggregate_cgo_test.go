@@ -0,0 +1,42 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestAggregateSignatures(t *testing.T) {
+	msg := []byte("batch settlement")
+
+	pubKeys := make([]*bls12_381.PubKey, 3)
+	sigs := make([][]byte, 3)
+	for i := range pubKeys {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys[i] = priv.PubKey().(*bls12_381.PubKey)
+		sigs[i], err = priv.Sign(msg)
+		require.NoError(t, err)
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, len(sigs[0]))
+
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msg, aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, []byte("other"), aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[1:], msg, aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(nil, msg, aggSig))
+
+	// a single signature is its own aggregate
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys[:1], msg, sigs[0]))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0][1:]})
+	require.Error(t, err)
+}
@@ -9,11 +9,15 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	bls "github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// errDisabled is returned by the operations requiring the bls12381 build tag.
+var errDisabled = fmt.Errorf("%w: build flags are required to use bls12_381 keys", bls.ErrDisabled)
+
 // ===============================================================================================
 // Private Key
 // ===============================================================================================
@@ -27,30 +31,32 @@ var (
 	_ codec.AminoMarshaler = &PrivKey{}
 )
 
-// NewPrivateKeyFromBytes build a new key from the given bytes.
+// NewPrivateKeyFromBytes build a new key from the given bytes. It returns an
+// error without the bls12381 build tag.
 func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return PrivKey{}, errDisabled
 }
 
-// GenPrivKey generates a new key.
+// GenPrivKey generates a new key. It returns an error without the bls12381
+// build tag.
 func GenPrivKey() (PrivKey, error) {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return PrivKey{}, errDisabled
 }
 
 // Bytes returns the byte representation of the Key.
 func (privKey PrivKey) Bytes() []byte {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return privKey.Key
 }
 
-// PubKey returns the private key's public key. If the privkey is not valid
-// it returns a nil value.
+// PubKey returns the private key's public key. It returns a nil value without
+// the bls12381 build tag.
 func (privKey PrivKey) PubKey() cryptotypes.PubKey {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return nil
 }
 
 // Equals returns true if two keys are equal and false otherwise.
 func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return privKey.Type() == other.Type() && bytes.Equal(privKey.Bytes(), other.Bytes())
 }
 
 // Type returns the type.
@@ -59,9 +65,10 @@ func (PrivKey) Type() string {
 }
 
 // Sign signs the given byte array. If msg is larger than
-// MaxMsgLen, SHA256 sum will be signed instead of the raw bytes.
+// MaxMsgLen, SHA256 sum will be signed instead of the raw bytes. It returns an
+// error without the bls12381 build tag.
 func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return nil, errDisabled
 }
 
 // MarshalAmino overrides Amino binary marshaling.
@@ -105,12 +112,16 @@ var _ cryptotypes.PubKey = &PubKey{}
 //
 // The function will panic if the public key is invalid.
 func (pubKey PubKey) Address() crypto.Address {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	if len(pubKey.Key) != bls.PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
 }
 
-// VerifySignature verifies the given signature.
+// VerifySignature verifies the given signature. No signature is valid without
+// the bls12381 build tag.
 func (pubKey PubKey) VerifySignature(_, _ []byte) bool {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return false
 }
 
 // Bytes returns the byte format.
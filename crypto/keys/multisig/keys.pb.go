@@ -5,6 +5,7 @@ package multisig
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -66,34 +67,87 @@ func (m *LegacyAminoPubKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyAminoPubKey proto.InternalMessageInfo
 
+// WeightedThresholdPubKey specifies a public key type which nests multiple
+// weighted public keys and a weight threshold. A multisignature is valid when
+// the sum of the weights of its signers reaches the threshold. Its members can
+// be of any public key type, e.g. mixing secp256k1, secp256r1, ed25519 and
+// ml-dsa-65 keys, and its address is derived from its protobuf encoding.
+type WeightedThresholdPubKey struct {
+	// threshold is the minimum sum of the weights of the signers.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// public_keys are the members of the multisig.
+	PubKeys []*any.Any `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	// weights are the weights of the public_keys, in the same order.
+	Weights []uint32 `protobuf:"varint,3,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (m *WeightedThresholdPubKey) Reset()         { *m = WeightedThresholdPubKey{} }
+func (m *WeightedThresholdPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedThresholdPubKey) ProtoMessage()    {}
+func (*WeightedThresholdPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{1}
+}
+func (m *WeightedThresholdPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedThresholdPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedThresholdPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedThresholdPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedThresholdPubKey.Merge(m, src)
+}
+func (m *WeightedThresholdPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedThresholdPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedThresholdPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedThresholdPubKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LegacyAminoPubKey)(nil), "cosmos.crypto.multisig.LegacyAminoPubKey")
+	proto.RegisterType((*WeightedThresholdPubKey)(nil), "cosmos.crypto.multisig.WeightedThresholdPubKey")
 }
 
 func init() { proto.RegisterFile("cosmos/crypto/multisig/keys.proto", fileDescriptor_46b57537e097d47d) }
 
 var fileDescriptor_46b57537e097d47d = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0xcf, 0x2d, 0xcd, 0x29, 0xc9, 0x2c,
-	0xce, 0x4c, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83,
-	0x28, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x29, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1,
-	0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4,
-	0xd2, 0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0xa8, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98,
-	0x84, 0x08, 0x29, 0x1d, 0x66, 0xe4, 0x12, 0xf4, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x04, 0x89,
-	0x06, 0x94, 0x26, 0x79, 0xa7, 0x56, 0x0a, 0xc9, 0x70, 0x71, 0x96, 0x64, 0x14, 0xa5, 0x16, 0x67,
-	0xe4, 0xe7, 0xa4, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x06, 0x21, 0x04, 0x84, 0xfc, 0xb8, 0xb8,
-	0x0b, 0x4a, 0x93, 0x72, 0x32, 0x93, 0xe3, 0x41, 0x8e, 0x94, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36,
-	0x12, 0xd1, 0x83, 0xd8, 0xab, 0x07, 0xb3, 0x57, 0xcf, 0x31, 0xaf, 0xd2, 0x49, 0xfc, 0xd1, 0x3d,
-	0x79, 0x76, 0x88, 0xa1, 0xc5, 0x8b, 0x9e, 0x6f, 0xd0, 0x62, 0x2f, 0x28, 0x4d, 0x02, 0x69, 0x0a,
-	0xe2, 0x82, 0x98, 0x00, 0x12, 0xb7, 0x72, 0xe8, 0x58, 0x20, 0xcf, 0xd0, 0xf5, 0x7c, 0x83, 0x96,
-	0x52, 0x49, 0x6a, 0x5e, 0x4a, 0x6a, 0x51, 0x6e, 0x66, 0x5e, 0x89, 0x3e, 0x44, 0x93, 0x2f, 0xd4,
-	0xaf, 0x21, 0x30, 0xcb, 0x27, 0x3d, 0xdf, 0xa0, 0x25, 0x00, 0x77, 0x4a, 0x7c, 0x71, 0x49, 0x51,
-	0x66, 0x5e, 0xba, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x42, 0x1a, 0x4c, 0xe9,
-	0x16, 0xa7, 0x64, 0xc3, 0x02, 0x1d, 0xe4, 0x22, 0x78, 0xc8, 0x27, 0xb1, 0x81, 0x7d, 0x60, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x78, 0xf0, 0xbc, 0x9a, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6b, 0xe2, 0x40,
+	0x18, 0x4e, 0x56, 0x58, 0xd9, 0x88, 0xec, 0x1a, 0x64, 0xcd, 0xca, 0x12, 0xb3, 0x9e, 0x44, 0x70,
+	0x66, 0x3f, 0x2f, 0x1e, 0x96, 0xea, 0xd5, 0xb6, 0x14, 0x29, 0x14, 0x7a, 0x11, 0x93, 0x4c, 0x27,
+	0x83, 0x49, 0x26, 0x64, 0x26, 0x94, 0xfc, 0x83, 0xd2, 0x53, 0xe9, 0x2f, 0x28, 0xfd, 0x05, 0x1e,
+	0xfa, 0x0f, 0x7a, 0xe9, 0x51, 0x0a, 0x85, 0x9e, 0x4a, 0x89, 0x07, 0xff, 0x46, 0x49, 0x26, 0x51,
+	0x2f, 0x05, 0x2f, 0x21, 0xef, 0x3b, 0xcf, 0xfb, 0xbc, 0xcf, 0xf3, 0xf2, 0x28, 0x3f, 0x2c, 0xca,
+	0x3c, 0xca, 0xa0, 0x15, 0xc6, 0x01, 0xa7, 0xd0, 0x8b, 0x5c, 0x4e, 0x18, 0xc1, 0x70, 0x86, 0x62,
+	0x06, 0x82, 0x90, 0x72, 0xaa, 0x7e, 0x15, 0x10, 0x20, 0x20, 0xa0, 0x80, 0x34, 0xeb, 0x98, 0x62,
+	0x9a, 0x41, 0x60, 0xfa, 0x27, 0xd0, 0xcd, 0x6f, 0x98, 0x52, 0xec, 0x22, 0x98, 0x55, 0x66, 0x74,
+	0x06, 0xa7, 0x7e, 0x9c, 0x3f, 0xd5, 0xa6, 0x1e, 0xf1, 0x29, 0xcc, 0xbe, 0x05, 0x5a, 0x70, 0x4f,
+	0x04, 0x4d, 0xbe, 0x28, 0x2b, 0xda, 0xf7, 0xb2, 0x52, 0xdb, 0x47, 0x78, 0x6a, 0xc5, 0x83, 0x74,
+	0xe0, 0x28, 0x32, 0x47, 0x28, 0x56, 0xbf, 0x2b, 0x9f, 0xb8, 0x13, 0x22, 0xe6, 0x50, 0xd7, 0xd6,
+	0x64, 0x43, 0xee, 0x54, 0xc7, 0x9b, 0x86, 0x7a, 0xa8, 0x54, 0x82, 0xc8, 0x74, 0x89, 0x35, 0x49,
+	0xf5, 0x6b, 0x1f, 0x8c, 0x52, 0xa7, 0xf2, 0xbb, 0x0e, 0x84, 0x24, 0x50, 0x48, 0x02, 0x03, 0x3f,
+	0x1e, 0x36, 0x92, 0x97, 0x56, 0x59, 0x90, 0xb2, 0xdb, 0xd5, 0xbc, 0x5b, 0x0e, 0x22, 0x33, 0x1d,
+	0x1a, 0x2b, 0x82, 0x21, 0xed, 0xf7, 0xf7, 0x2e, 0x6e, 0x5a, 0xd2, 0xe5, 0x6a, 0xde, 0x6d, 0x73,
+	0xe4, 0xdb, 0x28, 0xf4, 0x88, 0xcf, 0xa1, 0x18, 0x3a, 0xc8, 0xcf, 0x70, 0x5c, 0x2c, 0xbf, 0x5e,
+	0xcd, 0xbb, 0x5f, 0xd6, 0x52, 0x26, 0x8c, 0x87, 0xc4, 0xc7, 0xed, 0x27, 0x59, 0x69, 0x9c, 0x20,
+	0x82, 0x1d, 0x8e, 0xec, 0x35, 0x74, 0x27, 0x2f, 0xc3, 0xdd, 0xbd, 0x54, 0xb6, 0xbc, 0x6c, 0xeb,
+	0x57, 0x35, 0xa5, 0x7c, 0x9e, 0x2d, 0x67, 0x5a, 0xc9, 0x28, 0x75, 0xaa, 0xe3, 0xa2, 0xec, 0xff,
+	0x4f, 0x9d, 0x3d, 0xde, 0xf5, 0x3e, 0x8b, 0x9b, 0xf7, 0x98, 0x3d, 0x33, 0x7e, 0x82, 0x7f, 0x7f,
+	0x33, 0xb3, 0x9b, 0x1e, 0x7c, 0x47, 0xfb, 0x70, 0xf4, 0x90, 0xe8, 0xf2, 0x22, 0xd1, 0xe5, 0xd7,
+	0x44, 0x97, 0xaf, 0x96, 0xba, 0xb4, 0x58, 0xea, 0xd2, 0xf3, 0x52, 0x97, 0x4e, 0x7f, 0x61, 0xc2,
+	0x9d, 0xc8, 0x04, 0x16, 0xf5, 0x60, 0x11, 0xae, 0x0d, 0x5f, 0x9e, 0xb3, 0xd4, 0xd2, 0x3a, 0x6c,
+	0xe6, 0xc7, 0xcc, 0xcd, 0x9f, 0xb7, 0x01, 0x00, 0x9e, 0x33, 0x78, 0x96, 0x8d, 0x02, 0x00, 0x00,
 }
 
 func (m *LegacyAminoPubKey) Marshal() (dAtA []byte, err error) {
@@ -138,6 +192,66 @@ func (m *LegacyAminoPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedThresholdPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedThresholdPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedThresholdPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA2 := make([]byte, len(m.Weights)*10)
+		var j1 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintKeys(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -167,6 +281,31 @@ func (m *LegacyAminoPubKey) Size() (n int) {
 	return n
 }
 
+func (m *WeightedThresholdPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovKeys(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovKeys(uint64(e))
+		}
+		n += 1 + sovKeys(uint64(l)) + l
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +415,185 @@ func (m *LegacyAminoPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedThresholdPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedThresholdPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedThresholdPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &any.Any{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKeys
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeys
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeys
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
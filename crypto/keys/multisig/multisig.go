@@ -66,9 +66,16 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 	if bitarray.NumTrueBitsBefore(size) < int(m.Threshold) {
 		return fmt.Errorf("not enough signatures set, have %d, expected %d", bitarray.NumTrueBitsBefore(size), int(m.Threshold))
 	}
+	return verifySignatures(getSignBytes, pubKeys, sig)
+}
+
+// verifySignatures verifies the signatures set in the bit array of sig against the
+// corresponding pubKeys, including nested multisignatures.
+func verifySignatures(getSignBytes multisigtypes.GetSignBytesFunc, pubKeys []cryptotypes.PubKey, sig *signing.MultiSignatureData) error {
+	bitarray := sig.BitArray
 	// index in the list of signatures which we are concerned with.
 	sigIndex := 0
-	for i := range size {
+	for i := range bitarray.Count() {
 		if bitarray.GetIndex(i) {
 			si := sig.Signatures[sigIndex]
 			switch si := si.(type) {
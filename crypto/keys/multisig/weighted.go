@@ -0,0 +1,179 @@
+package multisig
+
+import (
+	"errors"
+	fmt "fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	_ multisigtypes.PubKey          = &WeightedThresholdPubKey{}
+	_ types.UnpackInterfacesMessage = &WeightedThresholdPubKey{}
+)
+
+// NewWeightedThresholdPubKey returns a new WeightedThresholdPubKey, weights[i] being the
+// weight of pubKeys[i]. The members can be of any public key type.
+// Panics if the threshold is 0, a weight is 0, the lengths of pubKeys and weights differ
+// or the sum of the weights is lower than the threshold.
+func NewWeightedThresholdPubKey(threshold uint32, pubKeys []cryptotypes.PubKey, weights []uint32) *WeightedThresholdPubKey {
+	anyPubKeys, err := packPubKeys(pubKeys)
+	if err != nil {
+		panic(err)
+	}
+	pk := &WeightedThresholdPubKey{Threshold: threshold, PubKeys: anyPubKeys, Weights: weights}
+	if err := pk.Validate(); err != nil {
+		panic(err)
+	}
+	return pk
+}
+
+// Validate checks that the threshold can be reached by the weights of the members.
+func (m *WeightedThresholdPubKey) Validate() error {
+	if m.Threshold == 0 {
+		return errors.New("weighted threshold multisignature: threshold must be positive")
+	}
+	if len(m.PubKeys) != len(m.Weights) {
+		return fmt.Errorf("weighted threshold multisignature: %d pubkeys but %d weights", len(m.PubKeys), len(m.Weights))
+	}
+	var total uint64
+	for i, weight := range m.Weights {
+		if weight == 0 {
+			return fmt.Errorf("weighted threshold multisignature: weight of pubkey %d must be positive", i)
+		}
+		total += uint64(weight)
+	}
+	if total < uint64(m.Threshold) {
+		return fmt.Errorf("weighted threshold multisignature: total weight %d < threshold %d", total, m.Threshold)
+	}
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method. Following ADR-028, it is the
+// hash of the protobuf encoding of the key.
+func (m *WeightedThresholdPubKey) Address() cryptotypes.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the WeightedThresholdPubKey
+func (m *WeightedThresholdPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method.
+// The signatures must be added in an order corresponding to the public keys order in
+// WeightedThresholdPubKey, and the sum of the weights of the signers must reach the
+// threshold.
+func (m *WeightedThresholdPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	bitarray := sig.BitArray
+	size := bitarray.Count()
+	pubKeys := m.GetPubKeys()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+	// ensure there is exactly one signature per bit set
+	if len(sig.Signatures) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sig.Signatures))
+	}
+	// ensure the weight of the signers reaches the threshold
+	if weight := m.signersWeight(bitarray); weight < uint64(m.Threshold) {
+		return fmt.Errorf("not enough signatures set, have weight %d, expected %d", weight, m.Threshold)
+	}
+	return verifySignatures(getSignBytes, pubKeys, sig)
+}
+
+// signersWeight returns the sum of the weights of the pubkeys set in the bit array.
+func (m *WeightedThresholdPubKey) signersWeight(bitarray *cryptotypes.CompactBitArray) uint64 {
+	var weight uint64
+	for i, w := range m.Weights {
+		if bitarray.GetIndex(i) {
+			weight += uint64(w)
+		}
+	}
+	return weight
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData.
+func (m *WeightedThresholdPubKey) VerifySignature(msg, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *WeightedThresholdPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m != nil {
+		pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+		for i := range m.PubKeys {
+			pubKeys[i] = m.PubKeys[i].GetCachedValue().(cryptotypes.PubKey)
+		}
+		return pubKeys
+	}
+
+	return nil
+}
+
+// GetWeights returns the weights of the public keys, in the same order.
+func (m *WeightedThresholdPubKey) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+// Equals returns true if other is a WeightedThresholdPubKey with the same threshold,
+// and the same keys with the same weights in the same order.
+func (m *WeightedThresholdPubKey) Equals(key cryptotypes.PubKey) bool {
+	otherKey, ok := key.(*WeightedThresholdPubKey)
+	if !ok {
+		return false
+	}
+	pubKeys := m.GetPubKeys()
+	otherPubKeys := otherKey.GetPubKeys()
+	if m.Threshold != otherKey.Threshold || len(pubKeys) != len(otherPubKeys) || len(m.Weights) != len(otherKey.Weights) {
+		return false
+	}
+
+	for i := range pubKeys {
+		if m.Weights[i] != otherKey.Weights[i] || !pubKeys[i].Equals(otherPubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method, it returns the weight
+// the signers must reach.
+func (m *WeightedThresholdPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns multisig type
+func (m *WeightedThresholdPubKey) Type() string {
+	return "PubKeyWeightedMultisigThreshold"
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *WeightedThresholdPubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range m.PubKeys {
+		var pk cryptotypes.PubKey
+		err := unpacker.UnpackAny(any, &pk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// generateMixedPrivKeys returns a secp256k1, a secp256r1, an ed25519 and a ml-dsa-65 private key.
+func generateMixedPrivKeys(t *testing.T) []cryptotypes.PrivKey {
+	t.Helper()
+	r1, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	mldsa, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	return []cryptotypes.PrivKey{secp256k1.GenPrivKey(), r1, ed25519.GenPrivKey(), &mldsa}
+}
+
+func TestNewWeightedThresholdPubKey(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+
+	require.NotNil(t, kmultisig.NewWeightedThresholdPubKey(5, pubKeys, []uint32{3, 1, 1}))
+	require.Panics(t, func() { kmultisig.NewWeightedThresholdPubKey(0, pubKeys, []uint32{3, 1, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedThresholdPubKey(6, pubKeys, []uint32{3, 1, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{3, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{3, 0, 1}) })
+}
+
+func TestWeightedThresholdPubKeyAddress(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+	pk := kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{1, 1, 1})
+	require.Len(t, pk.Address().Bytes(), 32)
+
+	// the address depends on the weights and the threshold
+	require.NotEqual(t, pk.Address(), kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{2, 1, 1}).Address())
+	require.NotEqual(t, pk.Address(), kmultisig.NewWeightedThresholdPubKey(3, pubKeys, []uint32{1, 1, 1}).Address())
+	require.NotEqual(t, pk.Address(), kmultisig.NewLegacyAminoPubKey(2, pubKeys).Address())
+}
+
+func TestWeightedThresholdPubKeyEquals(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+	pk := kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{1, 1, 1})
+
+	require.True(t, pk.Equals(kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{1, 1, 1})))
+	require.False(t, pk.Equals(kmultisig.NewWeightedThresholdPubKey(2, pubKeys, []uint32{1, 2, 1})))
+	require.False(t, pk.Equals(kmultisig.NewWeightedThresholdPubKey(2, []cryptotypes.PubKey{pubKeys[1], pubKeys[0], pubKeys[2]}, []uint32{1, 1, 1})))
+	require.False(t, pk.Equals(kmultisig.NewLegacyAminoPubKey(2, pubKeys)))
+}
+
+func TestWeightedThresholdPubKeyVerifyMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	privKeys := generateMixedPrivKeys(t)
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	sigs := make([]signing.SignatureData, len(privKeys))
+	for i, priv := range privKeys {
+		pubKeys[i] = priv.PubKey()
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		sigs[i] = &signing.SingleSignatureData{Signature: sig}
+	}
+	// the secp256r1 HSM key alone, or the three others together
+	pk := kmultisig.NewWeightedThresholdPubKey(3, pubKeys, []uint32{1, 3, 1, 1})
+
+	nestedPk, nestedSig := generateNestedMultiSignature(3, msg)
+
+	testCases := []struct {
+		msg     string
+		signers []int
+		sig     func() *signing.MultiSignatureData
+		expErr  string
+	}{
+		{
+			msg:     "heavy signer reaches the threshold",
+			signers: []int{1},
+		},
+		{
+			msg:     "light signers reach the threshold",
+			signers: []int{0, 2, 3},
+		},
+		{
+			msg:     "all signers",
+			signers: []int{0, 1, 2, 3},
+		},
+		{
+			msg:     "light signers don't reach the threshold",
+			signers: []int{0, 3},
+			expErr:  "not enough signatures set, have weight 2, expected 3",
+		},
+		{
+			msg: "invalid signature",
+			sig: func() *signing.MultiSignatureData {
+				sig := multisig.NewMultisig(len(pubKeys))
+				multisig.AddSignature(sig, sigs[0], 1)
+				return sig
+			},
+			expErr: "unable to verify signature at index 1",
+		},
+		{
+			msg: "wrong size for sig bit array",
+			sig: func() *signing.MultiSignatureData {
+				sig := multisig.NewMultisig(len(pubKeys) - 1)
+				multisig.AddSignature(sig, sigs[1], 1)
+				return sig
+			},
+			expErr: "bit array size is incorrect",
+		},
+		{
+			msg: "more signatures than bits set",
+			sig: func() *signing.MultiSignatureData {
+				sig := multisig.NewMultisig(len(pubKeys))
+				multisig.AddSignature(sig, sigs[1], 1)
+				sig.Signatures = append(sig.Signatures, sigs[0])
+				return sig
+			},
+			expErr: "signature size is incorrect 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var sig *signing.MultiSignatureData
+			if tc.sig != nil {
+				sig = tc.sig()
+			} else {
+				sig = multisig.NewMultisig(len(pubKeys))
+				for _, i := range tc.signers {
+					require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[i], pubKeys[i], pubKeys))
+				}
+			}
+
+			err := pk.VerifyMultisignature(signBytesFn, sig)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("nested multisignature", func(t *testing.T) {
+		pk := kmultisig.NewWeightedThresholdPubKey(2, []cryptotypes.PubKey{pubKeys[0], nestedPk}, []uint32{1, 2})
+		sig := multisig.NewMultisig(2)
+		multisig.AddSignature(sig, nestedSig, 1)
+		require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+	})
+
+	t.Run("invalid weights", func(t *testing.T) {
+		invalid := *pk
+		invalid.Weights = invalid.Weights[:2]
+		sig := multisig.NewMultisig(len(pubKeys))
+		multisig.AddSignature(sig, sigs[1], 1)
+		require.ErrorContains(t, invalid.VerifyMultisignature(signBytesFn, sig), "4 pubkeys but 2 weights")
+	})
+}
+
+func TestWeightedThresholdPubKeyProtoCodec(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pubKeys := make([]cryptotypes.PubKey, 0, 4)
+	for _, priv := range generateMixedPrivKeys(t) {
+		pubKeys = append(pubKeys, priv.PubKey())
+	}
+	pk := kmultisig.NewWeightedThresholdPubKey(3, pubKeys, []uint32{1, 3, 1, 1})
+
+	bz, err := cdc.MarshalInterface(pk)
+	require.NoError(t, err)
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+	require.Equal(t, pk.Address(), decoded.Address())
+
+	bz, err = cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+}
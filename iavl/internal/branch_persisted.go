@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// BranchPersisted is a branch node loaded from a changeset.
+// The key is read from the key value data file on demand and the children are resolved
+// through the changeset, or through the tree if they are stored in another changeset.
+type BranchPersisted struct {
+	cs      *Changeset
+	layout  BranchLayout
+	fileIdx uint32
+}
+
+var _ Node = (*BranchPersisted)(nil)
+
+// ID implements the Node interface.
+func (node *BranchPersisted) ID() NodeID {
+	return node.layout.ID
+}
+
+// IsLeaf implements the Node interface.
+func (node *BranchPersisted) IsLeaf() bool {
+	return false
+}
+
+// Key implements the Node interface.
+func (node *BranchPersisted) Key() (UnsafeBytes, error) {
+	key, err := node.cs.ReadKey(node.layout.KeyOffset)
+	if err != nil {
+		return UnsafeBytes{}, err
+	}
+	return WrapSafeBytes(key), nil
+}
+
+// Value implements the Node interface.
+func (node *BranchPersisted) Value() (UnsafeBytes, error) {
+	return UnsafeBytes{}, fmt.Errorf("branch node %s has no value", node.layout.ID)
+}
+
+// Left implements the Node interface.
+func (node *BranchPersisted) Left() *NodePointer {
+	return &NodePointer{changeset: node.cs, fileIdx: node.layout.LeftOffset, id: node.layout.Left}
+}
+
+// Right implements the Node interface.
+func (node *BranchPersisted) Right() *NodePointer {
+	return &NodePointer{changeset: node.cs, fileIdx: node.layout.RightOffset, id: node.layout.Right}
+}
+
+// Hash implements the Node interface.
+func (node *BranchPersisted) Hash() UnsafeBytes {
+	return WrapSafeBytes(node.layout.Hash[:])
+}
+
+// Height implements the Node interface.
+func (node *BranchPersisted) Height() uint8 {
+	return node.layout.Height
+}
+
+// Size implements the Node interface.
+func (node *BranchPersisted) Size() int64 {
+	return int64(node.layout.Size.ToUint64())
+}
+
+// Version implements the Node interface.
+func (node *BranchPersisted) Version() uint32 {
+	return node.layout.ID.Version()
+}
+
+// Get implements the Node interface.
+func (node *BranchPersisted) Get(key []byte) (value UnsafeBytes, index int64, err error) {
+	nodeKey, err := node.cs.ReadKey(node.layout.KeyOffset)
+	if err != nil {
+		return UnsafeBytes{}, 0, err
+	}
+
+	if bytes.Compare(key, nodeKey) < 0 {
+		leftNode, pin, err := node.Left().Resolve()
+		defer pin.Unpin()
+		if err != nil {
+			return UnsafeBytes{}, 0, err
+		}
+
+		return leftNode.Get(key)
+	}
+
+	rightNode, pin, err := node.Right().Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return UnsafeBytes{}, 0, err
+	}
+
+	value, index, err = rightNode.Get(key)
+	if err != nil {
+		return UnsafeBytes{}, 0, err
+	}
+
+	index += node.Size() - rightNode.Size()
+	return value, index, nil
+}
+
+// MutateBranch implements the Node interface.
+func (node *BranchPersisted) MutateBranch(version uint32) (*MemNode, error) {
+	key, err := node.cs.ReadKey(node.layout.KeyOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemNode{
+		height:  node.layout.Height,
+		version: version,
+		size:    node.Size(),
+		key:     key,
+		left:    node.Left(),
+		right:   node.Right(),
+	}, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (node *BranchPersisted) String() string {
+	return fmt.Sprintf("BranchPersisted{id:%s, fileIdx:%d, height:%d, size:%d, left:%s, right:%s}",
+		node.layout.ID, node.fileIdx, node.layout.Height, node.Size(), node.layout.Left, node.layout.Right)
+}
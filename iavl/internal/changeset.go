@@ -1,11 +1,420 @@
 package internal
 
-import "fmt"
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
 
-// NOTE: This is a placeholder implementation. We will add the implementation in a future PR.
+// nodeResolver resolves nodes stored in any changeset of a tree by their NodeID.
+// It is used to follow references to nodes created in other changesets, and references to nodes
+// of a changeset that has since been replaced by a compacted changeset.
+type nodeResolver interface {
+	resolveID(id NodeID) (Node, Pin, error)
+}
+
+// Changeset provides read access to the nodes of a changeset directory.
+// A changeset stores the nodes created in a contiguous range of versions, its files are append-only
+// while the changeset is being written and immutable afterward except for orphans.dat and info.dat.
+//
+// A changeset is reference counted with pins, once it has been replaced by a compacted changeset it
+// is evicted and its files are closed, and optionally deleted, when the last pin is released.
+type Changeset struct {
+	files    *ChangesetFiles
+	resolver nodeResolver
+
+	mtx      sync.RWMutex
+	versions []VersionLayout
+
+	refs          atomic.Int64
+	evicted       atomic.Bool
+	deleteOnClose atomic.Bool
+	closeOnce     sync.Once
+	closeErr      error
+}
 
-type Changeset struct{}
+// OpenChangeset opens a changeset from its files and loads its version entries.
+// A trailing partial version entry, left by a crash in the middle of a write, is ignored.
+func OpenChangeset(files *ChangesetFiles, resolver nodeResolver) (*Changeset, error) {
+	cs := &Changeset{
+		files:    files,
+		resolver: resolver,
+	}
+
+	stat, err := files.VersionsFile().Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat versions file: %w", err)
+	}
+
+	count := stat.Size() / sizeVersion
+	cs.versions = make([]VersionLayout, count)
+	if count > 0 {
+		data := unsafe.Slice((*byte)(unsafe.Pointer(&cs.versions[0])), int(count)*sizeVersion)
+		if _, err := files.VersionsFile().ReadAt(data, 0); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to read versions file: %w", err)
+		}
+	}
+
+	for i, vl := range cs.versions {
+		if want := files.StartVersion() + uint32(i); vl.Version != want {
+			return nil, fmt.Errorf("changeset %s has version entry %d at index %d, expected %d", files.Dir(), vl.Version, i, want)
+		}
+	}
+
+	return cs, nil
+}
 
+// Files returns the files of the changeset.
+func (cs *Changeset) Files() *ChangesetFiles {
+	return cs.files
+}
+
+// StartVersion returns the first version of the changeset.
+func (cs *Changeset) StartVersion() uint32 {
+	return cs.files.StartVersion()
+}
+
+// EndVersion returns the last version written to the changeset, or StartVersion - 1 if the changeset
+// doesn't contain any version yet.
+func (cs *Changeset) EndVersion() uint32 {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.files.StartVersion() + uint32(len(cs.versions)) - 1
+}
+
+// Contains returns true if the version has been written to this changeset.
+func (cs *Changeset) Contains(version uint32) bool {
+	_, ok := cs.VersionLayout(version)
+	return ok
+}
+
+// VersionLayout returns the version entry of the given version.
+func (cs *Changeset) VersionLayout(version uint32) (VersionLayout, bool) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	start := cs.files.StartVersion()
+	if version < start || version-start >= uint32(len(cs.versions)) {
+		return VersionLayout{}, false
+	}
+	return cs.versions[version-start], true
+}
+
+// Versions returns a copy of all the version entries of the changeset.
+func (cs *Changeset) Versions() []VersionLayout {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	versions := make([]VersionLayout, len(cs.versions))
+	copy(versions, cs.versions)
+	return versions
+}
+
+// appendVersion makes a version entry visible to readers once it has been durably written.
+func (cs *Changeset) appendVersion(vl VersionLayout) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	cs.versions = append(cs.versions, vl)
+}
+
+// truncateVersions removes the version entries after the given version, both on disk and in memory.
+// The nodes of the removed versions are left in the data files but are no longer reachable.
+func (cs *Changeset) truncateVersions(version uint32) error {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	count := int64(version) - int64(cs.files.StartVersion()) + 1
+	if count < 0 || count > int64(len(cs.versions)) {
+		return fmt.Errorf("cannot truncate changeset %s to version %d", cs.files.Dir(), version)
+	}
+	if err := os.Truncate(cs.files.VersionsFile().Name(), count*sizeVersion); err != nil {
+		return fmt.Errorf("failed to truncate versions file: %w", err)
+	}
+	cs.versions = cs.versions[:count]
+	return nil
+}
+
+// Resolve loads the node with the given ID from this changeset.
+// If fileIdx is non-zero, it is the 1-based offset of the node in the leaves or branches file and
+// is used to avoid looking the node up by its ID.
+// The caller must hold a pin on the changeset for as long as it uses the returned node.
 func (cs *Changeset) Resolve(id NodeID, fileIdx uint32) (Node, error) {
-	return nil, fmt.Errorf("not implemented")
+	if id.IsLeaf() {
+		layout, idx, err := cs.findLeaf(id, fileIdx)
+		if err != nil {
+			return nil, err
+		}
+		return &LeafPersisted{cs: cs, layout: layout, fileIdx: idx}, nil
+	}
+
+	layout, idx, err := cs.findBranch(id, fileIdx)
+	if err != nil {
+		return nil, err
+	}
+	return &BranchPersisted{cs: cs, layout: layout, fileIdx: idx}, nil
+}
+
+// findLeaf returns the layout and the 1-based file offset of the leaf with the given ID.
+func (cs *Changeset) findLeaf(id NodeID, fileIdx uint32) (LeafLayout, uint32, error) {
+	if fileIdx != 0 {
+		layout, err := cs.ReadLeaf(fileIdx)
+		if err != nil {
+			return LeafLayout{}, 0, err
+		}
+		if layout.ID.Equal(id) {
+			return layout, fileIdx, nil
+		}
+	}
+
+	vl, ok := cs.VersionLayout(id.Version())
+	if !ok {
+		return LeafLayout{}, 0, fmt.Errorf("version %d of %s not found in changeset %s", id.Version(), id, cs.files.Dir())
+	}
+
+	var (
+		layout  LeafLayout
+		readErr error
+	)
+	// leaves are stored in index order within a version, compaction may remove some of them
+	pos := sort.Search(int(vl.LeafCount), func(i int) bool {
+		if readErr != nil {
+			return true
+		}
+		layout, readErr = cs.ReadLeaf(vl.FirstLeaf + uint32(i) + 1)
+		return layout.ID.Index() >= id.Index()
+	})
+	if readErr != nil {
+		return LeafLayout{}, 0, readErr
+	}
+	if pos < int(vl.LeafCount) {
+		idx := vl.FirstLeaf + uint32(pos) + 1
+		layout, err := cs.ReadLeaf(idx)
+		if err != nil {
+			return LeafLayout{}, 0, err
+		}
+		if layout.ID.Equal(id) {
+			return layout, idx, nil
+		}
+	}
+	return LeafLayout{}, 0, fmt.Errorf("%s not found in changeset %s, it may have been pruned", id, cs.files.Dir())
+}
+
+// findBranch returns the layout and the 1-based file offset of the branch with the given ID.
+func (cs *Changeset) findBranch(id NodeID, fileIdx uint32) (BranchLayout, uint32, error) {
+	if fileIdx != 0 {
+		layout, err := cs.ReadBranch(fileIdx)
+		if err != nil {
+			return BranchLayout{}, 0, err
+		}
+		if layout.ID.Equal(id) {
+			return layout, fileIdx, nil
+		}
+	}
+
+	vl, ok := cs.VersionLayout(id.Version())
+	if !ok {
+		return BranchLayout{}, 0, fmt.Errorf("version %d of %s not found in changeset %s", id.Version(), id, cs.files.Dir())
+	}
+
+	var (
+		layout  BranchLayout
+		readErr error
+	)
+	pos := sort.Search(int(vl.BranchCount), func(i int) bool {
+		if readErr != nil {
+			return true
+		}
+		layout, readErr = cs.ReadBranch(vl.FirstBranch + uint32(i) + 1)
+		return layout.ID.Index() >= id.Index()
+	})
+	if readErr != nil {
+		return BranchLayout{}, 0, readErr
+	}
+	if pos < int(vl.BranchCount) {
+		idx := vl.FirstBranch + uint32(pos) + 1
+		layout, err := cs.ReadBranch(idx)
+		if err != nil {
+			return BranchLayout{}, 0, err
+		}
+		if layout.ID.Equal(id) {
+			return layout, idx, nil
+		}
+	}
+	return BranchLayout{}, 0, fmt.Errorf("%s not found in changeset %s, it may have been pruned", id, cs.files.Dir())
+}
+
+// ReadLeaf reads the leaf at the given 1-based offset of the leaves file.
+func (cs *Changeset) ReadLeaf(fileIdx uint32) (LeafLayout, error) {
+	var layout LeafLayout
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&layout)), sizeLeaf)
+	if _, err := cs.files.LeavesFile().ReadAt(data, int64(fileIdx-1)*sizeLeaf); err != nil {
+		return LeafLayout{}, fmt.Errorf("failed to read leaf %d of changeset %s: %w", fileIdx, cs.files.Dir(), err)
+	}
+	return layout, nil
+}
+
+// ReadBranch reads the branch at the given 1-based offset of the branches file.
+func (cs *Changeset) ReadBranch(fileIdx uint32) (BranchLayout, error) {
+	var layout BranchLayout
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&layout)), sizeBranch)
+	if _, err := cs.files.BranchesFile().ReadAt(data, int64(fileIdx-1)*sizeBranch); err != nil {
+		return BranchLayout{}, fmt.Errorf("failed to read branch %d of changeset %s: %w", fileIdx, cs.files.Dir(), err)
+	}
+	return layout, nil
+}
+
+// ReadKey reads the key stored at the given offset of the key value data file.
+func (cs *Changeset) ReadKey(offset uint32) ([]byte, error) {
+	key, _, err := cs.readBlob(int64(offset))
+	return key, err
+}
+
+// ReadKeyValue reads the key and value of the leaf stored at the given offset of the key value data file.
+func (cs *Changeset) ReadKeyValue(offset uint32) (key, value []byte, err error) {
+	key, next, err := cs.readBlob(int64(offset))
+	if err != nil {
+		return nil, nil, err
+	}
+	value, _, err = cs.readBlob(next)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+// readBlob reads a uvarint length prefixed byte slice and returns it with the offset following it.
+func (cs *Changeset) readBlob(offset int64) ([]byte, int64, error) {
+	var header [binary.MaxVarintLen64]byte
+	n, err := cs.files.KVDataFile().ReadAt(header[:], offset)
+	if n == 0 {
+		return nil, 0, fmt.Errorf("failed to read kv data at offset %d of changeset %s: %w", offset, cs.files.Dir(), err)
+	}
+
+	size, k := binary.Uvarint(header[:n])
+	if k <= 0 {
+		return nil, 0, fmt.Errorf("invalid kv data length at offset %d of changeset %s", offset, cs.files.Dir())
+	}
+
+	bz := make([]byte, size)
+	if size > 0 {
+		if _, err := cs.files.KVDataFile().ReadAt(bz, offset+int64(k)); err != nil {
+			return nil, 0, fmt.Errorf("failed to read kv data at offset %d of changeset %s: %w", offset, cs.files.Dir(), err)
+		}
+	}
+	return bz, offset + int64(k) + int64(size), nil
+}
+
+// ReadOrphans reads the orphan entries of the changeset starting at the given entry index.
+func (cs *Changeset) ReadOrphans(from int) ([]OrphanLayout, error) {
+	stat, err := cs.files.OrphansFile().Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat orphans file: %w", err)
+	}
+
+	count := int(stat.Size()/sizeOrphan) - from
+	if count <= 0 {
+		return nil, nil
+	}
+
+	orphans := make([]OrphanLayout, count)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&orphans[0])), count*sizeOrphan)
+	if _, err := cs.files.OrphansFile().ReadAt(data, int64(from)*sizeOrphan); err != nil {
+		return nil, fmt.Errorf("failed to read orphans file: %w", err)
+	}
+	return orphans, nil
+}
+
+// appendOrphans appends orphan entries to the changeset and updates its orphan statistics.
+func (cs *Changeset) appendOrphans(orphans []OrphanLayout) error {
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&orphans[0])), len(orphans)*sizeOrphan)
+	if _, err := cs.files.OrphansFile().Write(data); err != nil {
+		return fmt.Errorf("failed to write orphans: %w", err)
+	}
+
+	info := cs.files.Info()
+	for _, orphan := range orphans {
+		if orphan.ID.IsLeaf() {
+			info.LeafOrphans++
+			info.LeafOrphanVersionTotal += uint64(orphan.OrphanedAt)
+		} else {
+			info.BranchOrphans++
+			info.BranchOrphanVersionTotal += uint64(orphan.OrphanedAt)
+		}
+	}
+	return cs.files.RewriteInfo()
+}
+
+// rewriteOrphans replaces the orphan entries of the changeset and recomputes its orphan statistics.
+func (cs *Changeset) rewriteOrphans(orphans []OrphanLayout) error {
+	if err := cs.files.OrphansFile().Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate orphans file: %w", err)
+	}
+
+	info := cs.files.Info()
+	info.LeafOrphans, info.LeafOrphanVersionTotal = 0, 0
+	info.BranchOrphans, info.BranchOrphanVersionTotal = 0, 0
+	return cs.appendOrphans(orphans)
+}
+
+// pin pins the changeset, it returns false if the changeset has been evicted, in which case the
+// node must be resolved from the changeset that replaced it.
+func (cs *Changeset) pin() (Pin, bool) {
+	cs.refs.Add(1)
+	if cs.evicted.Load() {
+		cs.unpin()
+		return NoopPin{}, false
+	}
+	return &changesetPin{cs: cs}, true
+}
+
+func (cs *Changeset) unpin() {
+	if cs.refs.Add(-1) == 0 && cs.evicted.Load() {
+		cs.close()
+	}
+}
+
+// evict marks the changeset as replaced, its files are closed, and deleted if deleteFiles is true,
+// as soon as no pin is held on it anymore.
+func (cs *Changeset) evict(deleteFiles bool) {
+	cs.deleteOnClose.Store(deleteFiles)
+	cs.evicted.Store(true)
+	if cs.refs.Load() == 0 {
+		cs.close()
+	}
+}
+
+func (cs *Changeset) close() {
+	cs.closeOnce.Do(func() {
+		if cs.deleteOnClose.Load() {
+			cs.closeErr = cs.files.DeleteFiles()
+		} else {
+			cs.closeErr = cs.files.Close()
+		}
+	})
+}
+
+// Close closes the changeset files regardless of the pins held on it.
+func (cs *Changeset) Close() error {
+	cs.evicted.Store(true)
+	cs.close()
+	return cs.closeErr
+}
+
+// changesetPin releases a reference on a changeset exactly once.
+type changesetPin struct {
+	cs   *Changeset
+	once sync.Once
+}
+
+// Unpin implements the Pin interface.
+func (p *changesetPin) Unpin() {
+	p.once.Do(p.cs.unpin)
 }
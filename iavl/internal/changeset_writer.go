@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// changesetAppender buffers appends to the data files of a changeset that is being written.
+// It is shared by the ChangesetWriter, which writes new versions, and by compaction, which copies
+// the retained nodes of an existing changeset.
+type changesetAppender struct {
+	files *ChangesetFiles
+
+	kv       *bufio.Writer
+	leaves   *bufio.Writer
+	branches *bufio.Writer
+	versions *bufio.Writer
+
+	kvSize      uint64
+	leafCount   uint32
+	branchCount uint32
+}
+
+func newChangesetAppender(files *ChangesetFiles) *changesetAppender {
+	return &changesetAppender{
+		files:    files,
+		kv:       bufio.NewWriter(files.KVDataFile()),
+		leaves:   bufio.NewWriter(files.LeavesFile()),
+		branches: bufio.NewWriter(files.BranchesFile()),
+		versions: bufio.NewWriter(files.VersionsFile()),
+	}
+}
+
+// appendKV appends the key, and the value if withValue is true, to the key value data file and
+// returns the offset of the record.
+func (a *changesetAppender) appendKV(key, value []byte, withValue bool) (uint32, error) {
+	size := uint64(len(key)) + 2*binary.MaxVarintLen64
+	if withValue {
+		size += uint64(len(value))
+	}
+	if a.kvSize+size > math.MaxUint32 {
+		return 0, fmt.Errorf("key value data file of changeset %s would exceed 4GB", a.files.Dir())
+	}
+
+	offset := uint32(a.kvSize)
+	var header [binary.MaxVarintLen64]byte
+
+	n := binary.PutUvarint(header[:], uint64(len(key)))
+	_, _ = a.kv.Write(header[:n])
+	_, _ = a.kv.Write(key)
+	a.kvSize += uint64(n + len(key))
+
+	if withValue {
+		n = binary.PutUvarint(header[:], uint64(len(value)))
+		_, _ = a.kv.Write(header[:n])
+		_, _ = a.kv.Write(value)
+		a.kvSize += uint64(n + len(value))
+	}
+	return offset, nil
+}
+
+// appendLeaf appends a leaf and returns its 1-based file offset.
+func (a *changesetAppender) appendLeaf(layout *LeafLayout) uint32 {
+	_, _ = a.leaves.Write(unsafe.Slice((*byte)(unsafe.Pointer(layout)), sizeLeaf))
+	a.leafCount++
+	return a.leafCount
+}
+
+// appendBranch appends a branch and returns its 1-based file offset.
+func (a *changesetAppender) appendBranch(layout *BranchLayout) uint32 {
+	_, _ = a.branches.Write(unsafe.Slice((*byte)(unsafe.Pointer(layout)), sizeBranch))
+	a.branchCount++
+	return a.branchCount
+}
+
+// appendVersion appends a version entry.
+func (a *changesetAppender) appendVersion(layout *VersionLayout) {
+	_, _ = a.versions.Write(unsafe.Slice((*byte)(unsafe.Pointer(layout)), sizeVersion))
+}
+
+// size returns the total size of the data files.
+func (a *changesetAppender) size() uint64 {
+	return a.kvSize + uint64(a.leafCount)*sizeLeaf + uint64(a.branchCount)*sizeBranch
+}
+
+// flush writes the buffered data and syncs the files to disk.
+// The node data is synced before the version entries so that a version entry never refers to
+// data that didn't make it to disk.
+func (a *changesetAppender) flush() error {
+	err := errors.Join(
+		a.kv.Flush(),
+		a.leaves.Flush(),
+		a.branches.Flush(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write changeset data: %w", err)
+	}
+
+	err = errors.Join(
+		a.files.KVDataFile().Sync(),
+		a.files.LeavesFile().Sync(),
+		a.files.BranchesFile().Sync(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to sync changeset data: %w", err)
+	}
+
+	if err := a.versions.Flush(); err != nil {
+		return fmt.Errorf("failed to write changeset versions: %w", err)
+	}
+	if err := a.files.VersionsFile().Sync(); err != nil {
+		return fmt.Errorf("failed to sync changeset versions: %w", err)
+	}
+	return nil
+}
+
+// ChangesetWriter writes new versions of a tree to a changeset.
+// Every version appends the nodes it created, in post-order, followed by its version entry, which
+// is what makes the version visible when the changeset is reopened.
+type ChangesetWriter struct {
+	cs  *Changeset
+	app *changesetAppender
+}
+
+// NewChangesetWriter creates a new changeset in the tree directory starting at the given version.
+func NewChangesetWriter(treeDir string, startVersion uint32, resolver nodeResolver) (*ChangesetWriter, error) {
+	files, err := CreateChangesetFiles(treeDir, startVersion, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := OpenChangeset(files, resolver)
+	if err != nil {
+		return nil, errors.Join(err, files.DeleteFiles())
+	}
+
+	return &ChangesetWriter{
+		cs:  cs,
+		app: newChangesetAppender(files),
+	}, nil
+}
+
+// Changeset returns the changeset being written.
+func (w *ChangesetWriter) Changeset() *Changeset {
+	return w.cs
+}
+
+// Size returns the total size of the data files of the changeset.
+func (w *ChangesetWriter) Size() uint64 {
+	return w.app.size()
+}
+
+// WriteVersion writes the nodes created at the given version, which must directly follow the last
+// version of the changeset, and makes the version visible to readers.
+// The hashes of the new nodes must have been computed before calling this method.
+// Once written, the new nodes are assigned their NodeIDs and their pointers refer to this changeset.
+func (w *ChangesetWriter) WriteVersion(version uint32, root *NodePointer) error {
+	if want := w.cs.EndVersion() + 1; version != want {
+		return fmt.Errorf("cannot write version %d to changeset %s, expected version %d", version, w.cs.Files().Dir(), want)
+	}
+
+	vl := VersionLayout{
+		Version:     version,
+		FirstLeaf:   w.app.leafCount,
+		FirstBranch: w.app.branchCount,
+	}
+
+	// branch keys are the keys of leaves, so they refer to the leaf records of this version when possible
+	keyOffsets := make(map[string]uint32)
+
+	var write func(ptr *NodePointer) error
+	write = func(ptr *NodePointer) error {
+		mem := ptr.mem.Load()
+		if mem == nil || !mem.nodeId.IsEmpty() {
+			// already persisted
+			return nil
+		}
+		if mem.version != version {
+			return fmt.Errorf("unsaved node %s has version %d, expected %d", mem, mem.version, version)
+		}
+		if len(mem.hash) != 32 {
+			return fmt.Errorf("hash of node %s has not been computed", mem)
+		}
+
+		if mem.IsLeaf() {
+			offset, err := w.app.appendKV(mem.key, mem.value, true)
+			if err != nil {
+				return err
+			}
+			keyOffsets[string(mem.key)] = offset
+
+			vl.LeafCount++
+			layout := LeafLayout{
+				ID:        NewNodeID(true, version, vl.LeafCount),
+				KeyOffset: offset,
+			}
+			copy(layout.Hash[:], mem.hash)
+
+			mem.nodeId, mem.keyOffset = layout.ID, offset
+			ptr.id, ptr.fileIdx, ptr.changeset = layout.ID, w.app.appendLeaf(&layout), w.cs
+			return nil
+		}
+
+		if err := write(mem.left); err != nil {
+			return err
+		}
+		if err := write(mem.right); err != nil {
+			return err
+		}
+
+		offset, ok := keyOffsets[string(mem.key)]
+		if !ok {
+			var err error
+			offset, err = w.app.appendKV(mem.key, nil, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		vl.BranchCount++
+		layout := BranchLayout{
+			ID:        NewNodeID(false, version, vl.BranchCount),
+			Left:      mem.left.ID(),
+			Right:     mem.right.ID(),
+			KeyOffset: offset,
+			Height:    mem.height,
+			Size:      NewUint40(uint64(mem.size)),
+		}
+		if mem.left.changeset == w.cs {
+			layout.LeftOffset = mem.left.fileIdx
+		}
+		if mem.right.changeset == w.cs {
+			layout.RightOffset = mem.right.fileIdx
+		}
+		copy(layout.Hash[:], mem.hash)
+
+		mem.nodeId, mem.keyOffset = layout.ID, offset
+		ptr.id, ptr.fileIdx, ptr.changeset = layout.ID, w.app.appendBranch(&layout), w.cs
+		return nil
+	}
+
+	if root != nil {
+		if err := write(root); err != nil {
+			return err
+		}
+		vl.Root = root.ID()
+	}
+
+	w.app.appendVersion(&vl)
+	if err := w.app.flush(); err != nil {
+		return err
+	}
+
+	info := w.cs.Files().Info()
+	if info.StartVersion == 0 {
+		info.StartVersion = version
+	}
+	info.EndVersion = version
+	if err := w.cs.Files().RewriteInfo(); err != nil {
+		return err
+	}
+
+	w.cs.appendVersion(vl)
+	return nil
+}
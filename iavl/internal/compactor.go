@@ -0,0 +1,240 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// compactAsync starts a background compaction unless one is already running, in which case the
+// pruned versions will be reclaimed by the next compaction.
+func (ts *TreeStore) compactAsync() {
+	if !ts.compactMtx.TryLock() {
+		return
+	}
+
+	go func() {
+		defer ts.compactMtx.Unlock()
+
+		if err := ts.compact(); err != nil {
+			ts.opts.Logger.Error("failed to compact changesets", "dir", ts.dir, "err", err)
+		}
+	}()
+}
+
+// Compact synchronously compacts the changesets with enough prunable orphans.
+func (ts *TreeStore) Compact() error {
+	ts.compactMtx.Lock()
+	defer ts.compactMtx.Unlock()
+	return ts.compact()
+}
+
+// compact rewrites every sealed changeset whose ratio of prunable orphans is above the configured
+// threshold, the caller must hold ts.compactMtx.
+// A node is prunable when it was orphaned at or before the first retained version, since it is
+// then only reachable from pruned versions.
+func (ts *TreeStore) compact() error {
+	ts.mtx.RLock()
+	retain := ts.firstVersion
+	var candidates []*Changeset
+	for _, cs := range ts.changesets {
+		if ts.writer != nil && cs == ts.writer.Changeset() {
+			continue
+		}
+		candidates = append(candidates, cs)
+	}
+	ts.mtx.RUnlock()
+
+	if retain == 0 {
+		return nil
+	}
+
+	for _, cs := range candidates {
+		if err := ts.compactChangeset(cs, retain); err != nil {
+			return fmt.Errorf("failed to compact changeset %s: %w", cs.Files().Dir(), err)
+		}
+	}
+	return nil
+}
+
+// compactChangeset rewrites the changeset without its prunable nodes, then swaps the compacted
+// changeset in. The rewrite runs concurrently with reads and new versions, only the swap blocks them.
+func (ts *TreeStore) compactChangeset(cs *Changeset, retain uint32) error {
+	ts.mtx.RLock()
+	orphans, err := cs.ReadOrphans(0)
+	compactedAt := ts.latest
+	ts.mtx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	pruned := make(map[NodeID]struct{})
+	var retained []OrphanLayout
+	for _, orphan := range orphans {
+		if orphan.OrphanedAt <= retain {
+			pruned[orphan.ID] = struct{}{}
+		} else {
+			retained = append(retained, orphan)
+		}
+	}
+	if len(pruned) == 0 || compactedAt <= cs.Files().CompactedAtVersion() {
+		return nil
+	}
+
+	versions := cs.Versions()
+	var nodes uint64
+	for _, vl := range versions {
+		nodes += uint64(vl.LeafCount) + uint64(vl.BranchCount)
+	}
+	fullyPruned := versions[len(versions)-1].Version < retain
+	if !fullyPruned && float64(len(pruned)) < ts.opts.CompactionOrphanRatio*float64(nodes) {
+		return nil
+	}
+
+	ts.opts.Logger.Info("compacting changeset", "dir", cs.Files().Dir(), "nodes", nodes, "pruned", len(pruned))
+
+	files, err := CreateChangesetFiles(ts.dir, cs.StartVersion(), compactedAt)
+	if err != nil {
+		return err
+	}
+	newCs, err := ts.rewriteChangeset(cs, files, versions, pruned)
+	if err != nil {
+		return errors.Join(err, files.DeleteFiles())
+	}
+
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	// orphans recorded since the snapshot all belong to retained nodes
+	newOrphans, err := cs.ReadOrphans(len(orphans))
+	if err == nil {
+		err = newCs.rewriteOrphans(append(retained, newOrphans...))
+	}
+	if err == nil {
+		info := files.Info()
+		info.StartVersion, info.EndVersion = cs.Files().Info().StartVersion, cs.Files().Info().EndVersion
+		err = files.RewriteInfo()
+	}
+	if err == nil {
+		err = errors.Join(files.OrphansFile().Sync(), files.MarkReady())
+	}
+	if err != nil {
+		return errors.Join(err, newCs.Close(), files.DeleteFiles())
+	}
+
+	for i, existing := range ts.changesets {
+		if existing == cs {
+			ts.changesets[i] = newCs
+			break
+		}
+	}
+	cs.evict(true)
+	return nil
+}
+
+// rewriteChangeset copies the nodes of cs that are not pruned to files and opens the result.
+// File offsets of retained nodes change, so the local child offsets of branches are remapped, while
+// NodeIDs are preserved so that references from other changesets remain valid.
+func (ts *TreeStore) rewriteChangeset(cs *Changeset, files *ChangesetFiles, versions []VersionLayout, pruned map[NodeID]struct{}) (*Changeset, error) {
+	pin, ok := cs.pin()
+	defer pin.Unpin()
+	if !ok {
+		return nil, fmt.Errorf("changeset %s was evicted", cs.Files().Dir())
+	}
+
+	app := newChangesetAppender(files)
+	leafOffsets := make(map[uint32]uint32)
+	branchOffsets := make(map[uint32]uint32)
+	kvOffsets := make(map[uint32]uint32)
+
+	remap := func(id NodeID, offset uint32) (uint32, error) {
+		if offset == 0 {
+			return 0, nil
+		}
+		offsets := branchOffsets
+		if id.IsLeaf() {
+			offsets = leafOffsets
+		}
+		newOffset, ok := offsets[offset]
+		if !ok {
+			return 0, fmt.Errorf("retained branch references pruned node %s", id)
+		}
+		return newOffset, nil
+	}
+
+	for _, vl := range versions {
+		newVl := VersionLayout{
+			Version:     vl.Version,
+			FirstLeaf:   app.leafCount,
+			FirstBranch: app.branchCount,
+			Root:        vl.Root,
+		}
+
+		for i := uint32(1); i <= vl.LeafCount; i++ {
+			idx := vl.FirstLeaf + i
+			leaf, err := cs.ReadLeaf(idx)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := pruned[leaf.ID]; ok {
+				continue
+			}
+
+			key, value, err := cs.ReadKeyValue(leaf.KeyOffset)
+			if err != nil {
+				return nil, err
+			}
+			offset, err := app.appendKV(key, value, true)
+			if err != nil {
+				return nil, err
+			}
+			kvOffsets[leaf.KeyOffset] = offset
+			leaf.KeyOffset = offset
+
+			leafOffsets[idx] = app.appendLeaf(&leaf)
+			newVl.LeafCount++
+		}
+
+		for i := uint32(1); i <= vl.BranchCount; i++ {
+			idx := vl.FirstBranch + i
+			branch, err := cs.ReadBranch(idx)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := pruned[branch.ID]; ok {
+				continue
+			}
+
+			if branch.LeftOffset, err = remap(branch.Left, branch.LeftOffset); err != nil {
+				return nil, err
+			}
+			if branch.RightOffset, err = remap(branch.Right, branch.RightOffset); err != nil {
+				return nil, err
+			}
+
+			// the leaf holding the branch key may have been pruned while the branch is retained
+			offset, ok := kvOffsets[branch.KeyOffset]
+			if !ok {
+				key, err := cs.ReadKey(branch.KeyOffset)
+				if err != nil {
+					return nil, err
+				}
+				if offset, err = app.appendKV(key, nil, false); err != nil {
+					return nil, err
+				}
+				kvOffsets[branch.KeyOffset] = offset
+			}
+			branch.KeyOffset = offset
+
+			branchOffsets[idx] = app.appendBranch(&branch)
+			newVl.BranchCount++
+		}
+
+		app.appendVersion(&newVl)
+	}
+
+	if err := app.flush(); err != nil {
+		return nil, err
+	}
+
+	return OpenChangeset(files, ts)
+}
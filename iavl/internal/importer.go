@@ -0,0 +1,541 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"unsafe"
+)
+
+const importTmpFilename = "import.tmp"
+
+// ImportNode is a node of a tree exported in post-order by github.com/cosmos/iavl.
+// A leaf has a height of 0 and a value, a branch has the key of the leftmost leaf of its right subtree.
+type ImportNode struct {
+	Key     []byte
+	Value   []byte
+	Version uint32
+	Height  int8
+}
+
+// Importer imports a tree exported by github.com/cosmos/iavl into an empty tree directory.
+// Nodes keep the version they were created at, so the imported tree has the same root hash as the
+// exported one and the later versions can be replayed on top of it.
+//
+// Changesets store the nodes grouped by version while an export yields them in post-order, so Add
+// assigns each node its NodeID and writes it to a temporary file, and Commit lays the nodes out in
+// changesets once the nodes of every version have been counted. Memory usage is proportional to the
+// height of the tree and to the number of versions the nodes were created at.
+type Importer struct {
+	dir     string
+	version uint32
+	opts    TreeStoreOptions
+
+	tmp *os.File
+	buf *bufio.Writer
+
+	stack    []importEntry
+	versions map[uint32]*importVersion
+	done     bool
+}
+
+// importVersion tracks the nodes created at a version, and their place in the changesets once
+// Commit has planned the layout.
+type importVersion struct {
+	leafCount   uint32
+	branchCount uint32
+	kvSize      uint64
+
+	changeset   int
+	firstLeaf   uint32
+	firstBranch uint32
+	kvBase      uint32
+}
+
+// importEntry is a node whose parent hasn't been imported yet.
+type importEntry struct {
+	id     NodeID
+	hash   []byte
+	height uint8
+	size   int64
+
+	// the leftmost leaf of the subtree holds the key of the parent branch when this is a right child
+	leftmostKey       []byte
+	leftmostVersion   uint32
+	leftmostKeyOffset uint32
+}
+
+// importRecord is the kind of a node record in the temporary file.
+const (
+	importRecordLeaf byte = iota
+	importRecordBranch
+)
+
+// NewImporter creates an importer of the tree at the given version into dir, which must be empty.
+func NewImporter(dir string, version uint32, opts TreeStoreOptions) (*Importer, error) {
+	if version == 0 {
+		return nil, errors.New("cannot import version 0")
+	}
+	if opts.ChangesetMaxSize == 0 {
+		opts.ChangesetMaxSize = DefaultChangesetMaxSize
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create tree dir: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree dir: %w", err)
+	}
+	if len(entries) > 0 {
+		return nil, fmt.Errorf("cannot import into non-empty tree dir %s", dir)
+	}
+
+	tmp, err := os.Create(filepath.Join(dir, importTmpFilename))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import file: %w", err)
+	}
+
+	return &Importer{
+		dir:      dir,
+		version:  version,
+		opts:     opts,
+		tmp:      tmp,
+		buf:      bufio.NewWriter(tmp),
+		versions: make(map[uint32]*importVersion),
+	}, nil
+}
+
+// Add imports the next node of the export.
+func (imp *Importer) Add(node *ImportNode) error {
+	if imp.done {
+		return errors.New("import is already committed or closed")
+	}
+	if node.Version == 0 || node.Version > imp.version {
+		return fmt.Errorf("node version %d is not in the imported range [1, %d]", node.Version, imp.version)
+	}
+	if node.Key == nil {
+		return errors.New("node key cannot be nil")
+	}
+
+	if node.Height == 0 {
+		return imp.addLeaf(node)
+	}
+	return imp.addBranch(node)
+}
+
+func (imp *Importer) addLeaf(node *ImportNode) error {
+	v := imp.versionInfo(node.Version)
+	blob := encodeKV(node.Key, node.Value, true)
+	offset, err := v.reserveKV(blob)
+	if err != nil {
+		return err
+	}
+
+	v.leafCount++
+	layout := LeafLayout{
+		ID:        NewNodeID(true, node.Version, v.leafCount),
+		KeyOffset: offset,
+	}
+	hash := leafHash(node.Key, node.Value, node.Version)
+	copy(layout.Hash[:], hash)
+
+	if err := imp.writeRecord(importRecordLeaf, unsafe.Slice((*byte)(unsafe.Pointer(&layout)), sizeLeaf), blob); err != nil {
+		return err
+	}
+
+	imp.stack = append(imp.stack, importEntry{
+		id:                layout.ID,
+		hash:              hash,
+		size:              1,
+		leftmostKey:       bytes.Clone(node.Key),
+		leftmostVersion:   node.Version,
+		leftmostKeyOffset: offset,
+	})
+	return nil
+}
+
+func (imp *Importer) addBranch(node *ImportNode) error {
+	n := len(imp.stack)
+	if n < 2 {
+		return fmt.Errorf("branch %X at height %d is missing its children", node.Key, node.Height)
+	}
+	left, right := imp.stack[n-2], imp.stack[n-1]
+	imp.stack = imp.stack[:n-2]
+
+	if want := max(left.height, right.height) + 1; node.Height < 0 || uint8(node.Height) != want {
+		return fmt.Errorf("branch %X has height %d, expected %d", node.Key, node.Height, want)
+	}
+	if left.id.Version() > node.Version || right.id.Version() > node.Version {
+		return fmt.Errorf("branch %X at version %d has a child of a later version", node.Key, node.Version)
+	}
+
+	v := imp.versionInfo(node.Version)
+
+	// the key of a branch refers to the leaf record when both were created at the same version,
+	// like the ChangesetWriter does
+	var blob []byte
+	offset := right.leftmostKeyOffset
+	if right.leftmostVersion != node.Version || !bytes.Equal(right.leftmostKey, node.Key) {
+		blob = encodeKV(node.Key, nil, false)
+		var err error
+		if offset, err = v.reserveKV(blob); err != nil {
+			return err
+		}
+	}
+
+	size := left.size + right.size
+	v.branchCount++
+	layout := BranchLayout{
+		ID:        NewNodeID(false, node.Version, v.branchCount),
+		Left:      left.id,
+		Right:     right.id,
+		KeyOffset: offset,
+		Height:    uint8(node.Height),
+		Size:      NewUint40(uint64(size)),
+	}
+	hash := branchHash(layout.Height, size, node.Version, left.hash, right.hash)
+	copy(layout.Hash[:], hash)
+
+	if err := imp.writeRecord(importRecordBranch, unsafe.Slice((*byte)(unsafe.Pointer(&layout)), sizeBranch), blob); err != nil {
+		return err
+	}
+
+	imp.stack = append(imp.stack, importEntry{
+		id:                layout.ID,
+		hash:              hash,
+		height:            layout.Height,
+		size:              size,
+		leftmostKey:       left.leftmostKey,
+		leftmostVersion:   left.leftmostVersion,
+		leftmostKeyOffset: left.leftmostKeyOffset,
+	})
+	return nil
+}
+
+func (imp *Importer) versionInfo(version uint32) *importVersion {
+	v, ok := imp.versions[version]
+	if !ok {
+		v = &importVersion{}
+		imp.versions[version] = v
+	}
+	return v
+}
+
+// reserveKV reserves room for the blob in the key value data of the version and returns its
+// offset relative to the start of the version data.
+func (v *importVersion) reserveKV(blob []byte) (uint32, error) {
+	if v.kvSize+uint64(len(blob)) > math.MaxUint32 {
+		return 0, errors.New("key value data of a single version exceeds 4GB")
+	}
+	offset := uint32(v.kvSize)
+	v.kvSize += uint64(len(blob))
+	return offset, nil
+}
+
+func (imp *Importer) writeRecord(kind byte, layout, blob []byte) error {
+	var header [1 + binary.MaxVarintLen64]byte
+	header[0] = kind
+	n := 1 + binary.PutUvarint(header[1:], uint64(len(blob)))
+	_, _ = imp.buf.Write(header[:n])
+	_, _ = imp.buf.Write(layout)
+	if _, err := imp.buf.Write(blob); err != nil {
+		return fmt.Errorf("failed to write import file: %w", err)
+	}
+	return nil
+}
+
+// importChangeset is a changeset being laid out by Commit.
+type importChangeset struct {
+	files    *ChangesetFiles
+	versions *bufio.Writer
+
+	// the data files are written at the offsets computed from the NodeIDs, through handles that
+	// are not opened in append mode
+	kv, leaves, branches *os.File
+
+	kvSize      uint64
+	leafCount   uint32
+	branchCount uint32
+}
+
+func (ic *importChangeset) size() uint64 {
+	return ic.kvSize + uint64(ic.leafCount)*sizeLeaf + uint64(ic.branchCount)*sizeBranch
+}
+
+// Commit writes the imported nodes to changesets and returns the root hash of the tree.
+// The versions before the imported version only hold the nodes still referenced by it, they are
+// recorded as pruned.
+func (imp *Importer) Commit() (hash []byte, err error) {
+	if imp.done {
+		return nil, errors.New("import is already committed or closed")
+	}
+	defer func() {
+		err = errors.Join(err, imp.Close())
+	}()
+
+	var root NodeID
+	switch len(imp.stack) {
+	case 0:
+		hash = emptyRootHash
+	case 1:
+		root, hash = imp.stack[0].id, imp.stack[0].hash
+	default:
+		return nil, fmt.Errorf("incomplete export: %d subtrees without a parent", len(imp.stack))
+	}
+
+	if err := imp.buf.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write import file: %w", err)
+	}
+
+	changesets, err := imp.layoutChangesets(root)
+	defer func() {
+		for _, ic := range changesets {
+			err = errors.Join(err, ic.close())
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := imp.copyRecords(changesets); err != nil {
+		return nil, err
+	}
+
+	for _, ic := range changesets {
+		if err := ic.finish(); err != nil {
+			return nil, err
+		}
+	}
+
+	if first := changesets[0].files.StartVersion(); first < imp.version {
+		if err := writePrunedFile(imp.dir, imp.version); err != nil {
+			return nil, err
+		}
+	}
+	return hash, nil
+}
+
+// layoutChangesets creates the changesets of the imported versions, starting a new one when the
+// previous one reaches the maximum changeset size, and writes their version entries.
+func (imp *Importer) layoutChangesets(root NodeID) ([]*importChangeset, error) {
+	firstVersion := imp.version
+	for version := range imp.versions {
+		firstVersion = min(firstVersion, version)
+	}
+
+	var (
+		changesets []*importChangeset
+		ic         *importChangeset
+	)
+	for version := firstVersion; version <= imp.version; version++ {
+		v := imp.versions[version]
+		if v == nil {
+			v = &importVersion{}
+		}
+
+		if ic == nil || ic.size() >= imp.opts.ChangesetMaxSize || ic.kvSize+v.kvSize > math.MaxUint32 {
+			var err error
+			if ic, err = newImportChangeset(imp.dir, version); err != nil {
+				return changesets, err
+			}
+			changesets = append(changesets, ic)
+		}
+
+		v.changeset = len(changesets) - 1
+		v.firstLeaf, v.firstBranch, v.kvBase = ic.leafCount, ic.branchCount, uint32(ic.kvSize)
+		ic.leafCount += v.leafCount
+		ic.branchCount += v.branchCount
+		ic.kvSize += v.kvSize
+
+		vl := VersionLayout{
+			Version:     version,
+			FirstLeaf:   v.firstLeaf,
+			LeafCount:   v.leafCount,
+			FirstBranch: v.firstBranch,
+			BranchCount: v.branchCount,
+		}
+		if version == imp.version {
+			vl.Root = root
+		}
+		_, _ = ic.versions.Write(unsafe.Slice((*byte)(unsafe.Pointer(&vl)), sizeVersion))
+
+		info := ic.files.Info()
+		if info.StartVersion == 0 {
+			info.StartVersion = version
+		}
+		info.EndVersion = version
+	}
+	return changesets, nil
+}
+
+func newImportChangeset(dir string, startVersion uint32) (*importChangeset, error) {
+	files, err := CreateChangesetFiles(dir, startVersion, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ic := &importChangeset{
+		files:    files,
+		versions: bufio.NewWriter(files.VersionsFile()),
+	}
+	for _, f := range []struct {
+		dst  **os.File
+		path string
+	}{
+		{&ic.kv, files.KVDataFile().Name()},
+		{&ic.leaves, files.LeavesFile().Name()},
+		{&ic.branches, files.BranchesFile().Name()},
+	} {
+		if *f.dst, err = os.OpenFile(f.path, os.O_WRONLY, 0o600); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open changeset file: %w", err), ic.close())
+		}
+	}
+	return ic, nil
+}
+
+// copyRecords copies the nodes from the temporary file to their place in the changesets.
+func (imp *Importer) copyRecords(changesets []*importChangeset) error {
+	if _, err := imp.tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+	r := bufio.NewReader(imp.tmp)
+
+	// localOffset returns the 1-based file offset of a node if it is stored in the given changeset
+	localOffset := func(id NodeID, changeset int) uint32 {
+		v := imp.versions[id.Version()]
+		if v.changeset != changeset {
+			return 0
+		}
+		if id.IsLeaf() {
+			return v.firstLeaf + id.Index()
+		}
+		return v.firstBranch + id.Index()
+	}
+
+	var (
+		leaf   LeafLayout
+		branch BranchLayout
+		blob   []byte
+	)
+	for {
+		kind, err := r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read import file: %w", err)
+		}
+		blobLen, err := binary.ReadUvarint(r)
+		if err != nil {
+			return fmt.Errorf("failed to read import file: %w", err)
+		}
+
+		var layout []byte
+		switch kind {
+		case importRecordLeaf:
+			layout = unsafe.Slice((*byte)(unsafe.Pointer(&leaf)), sizeLeaf)
+		case importRecordBranch:
+			layout = unsafe.Slice((*byte)(unsafe.Pointer(&branch)), sizeBranch)
+		default:
+			return fmt.Errorf("corrupted import file: unknown record kind %d", kind)
+		}
+		if uint64(cap(blob)) < blobLen {
+			blob = make([]byte, blobLen)
+		}
+		blob = blob[:blobLen]
+		if _, err := io.ReadFull(r, layout); err != nil {
+			return fmt.Errorf("failed to read import file: %w", err)
+		}
+		if _, err := io.ReadFull(r, blob); err != nil {
+			return fmt.Errorf("failed to read import file: %w", err)
+		}
+
+		var (
+			id        NodeID
+			keyOffset *uint32
+		)
+		if kind == importRecordLeaf {
+			id, keyOffset = leaf.ID, &leaf.KeyOffset
+		} else {
+			id, keyOffset = branch.ID, &branch.KeyOffset
+		}
+		v := imp.versions[id.Version()]
+		ic := changesets[v.changeset]
+
+		*keyOffset += v.kvBase
+		if len(blob) > 0 {
+			if _, err := ic.kv.WriteAt(blob, int64(*keyOffset)); err != nil {
+				return fmt.Errorf("failed to write changeset data: %w", err)
+			}
+		}
+
+		if kind == importRecordLeaf {
+			pos := int64(v.firstLeaf+id.Index()-1) * sizeLeaf
+			if _, err := ic.leaves.WriteAt(layout, pos); err != nil {
+				return fmt.Errorf("failed to write changeset data: %w", err)
+			}
+			continue
+		}
+
+		branch.LeftOffset = localOffset(branch.Left, v.changeset)
+		branch.RightOffset = localOffset(branch.Right, v.changeset)
+		pos := int64(v.firstBranch+id.Index()-1) * sizeBranch
+		if _, err := ic.branches.WriteAt(layout, pos); err != nil {
+			return fmt.Errorf("failed to write changeset data: %w", err)
+		}
+	}
+}
+
+// finish syncs the changeset and records its versions in its info file.
+func (ic *importChangeset) finish() error {
+	err := errors.Join(ic.kv.Sync(), ic.leaves.Sync(), ic.branches.Sync())
+	if err != nil {
+		return fmt.Errorf("failed to sync changeset data: %w", err)
+	}
+	if err := ic.versions.Flush(); err != nil {
+		return fmt.Errorf("failed to write changeset versions: %w", err)
+	}
+	if err := ic.files.VersionsFile().Sync(); err != nil {
+		return fmt.Errorf("failed to sync changeset versions: %w", err)
+	}
+	return ic.files.RewriteInfo()
+}
+
+func (ic *importChangeset) close() error {
+	var errs []error
+	for _, f := range []*os.File{ic.kv, ic.leaves, ic.branches} {
+		if f != nil {
+			errs = append(errs, f.Close())
+		}
+	}
+	errs = append(errs, ic.files.Close())
+	return errors.Join(errs...)
+}
+
+// Close releases the importer and removes its temporary file. Closing an importer that wasn't
+// committed leaves the tree directory in an undefined state, it must be removed.
+func (imp *Importer) Close() error {
+	if imp.done {
+		return nil
+	}
+	imp.done = true
+	return errors.Join(imp.tmp.Close(), os.Remove(imp.tmp.Name()))
+}
+
+// encodeKV encodes a record of the key value data file, see changesetAppender.appendKV.
+func encodeKV(key, value []byte, withValue bool) []byte {
+	blob := binary.AppendUvarint(nil, uint64(len(key)))
+	blob = append(blob, key...)
+	if withValue {
+		blob = binary.AppendUvarint(blob, uint64(len(value)))
+		blob = append(blob, value...)
+	}
+	return blob
+}
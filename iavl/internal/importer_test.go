@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/log/v2"
+	"github.com/cosmos/iavl"
+	iavldb "github.com/cosmos/iavl/db"
+	"github.com/stretchr/testify/require"
+)
+
+// importLegacy imports the given version of the legacy tree into dir.
+func importLegacy(t *testing.T, legacy *iavl.MutableTree, version int64, dir string, opts TreeStoreOptions) []byte {
+	t.Helper()
+
+	itree, err := legacy.GetImmutable(version)
+	require.NoError(t, err)
+	exporter, err := itree.Export()
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	imp, err := NewImporter(dir, uint32(version), opts)
+	require.NoError(t, err)
+	for {
+		node, err := exporter.Next()
+		if errors.Is(err, iavl.ErrorExportDone) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, imp.Add(&ImportNode{
+			Key:     node.Key,
+			Value:   node.Value,
+			Version: uint32(node.Version),
+			Height:  node.Height,
+		}))
+	}
+	hash, err := imp.Commit()
+	require.NoError(t, err)
+	require.Equal(t, itree.Hash(), hash)
+	return hash
+}
+
+// applySortedBatch applies random sets and removes to the legacy tree in key order, the way the
+// cachekv store of the SDK flushes the writes of a block.
+func applySortedBatch(t *testing.T, r *rand.Rand, legacy *iavl.MutableTree, expected map[string]string, n int) {
+	t.Helper()
+
+	batch := make(map[string]*string)
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("key%03d", r.Intn(300))
+		if r.Intn(4) == 0 {
+			batch[key] = nil
+			continue
+		}
+		value := fmt.Sprintf("value%d", r.Int())
+		batch[key] = &value
+	}
+
+	keys := make([]string, 0, len(batch))
+	for key := range batch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var err error
+		if value := batch[key]; value == nil {
+			_, _, err = legacy.Remove([]byte(key))
+			delete(expected, key)
+		} else {
+			_, err = legacy.Set([]byte(key), []byte(*value))
+			expected[key] = *value
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestImporter_LegacyTree(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	legacy := iavl.NewMutableTree(iavldb.NewMemDB(), 0, true, log.NewNopLogger())
+
+	expected := make(map[string]string)
+	states := make(map[uint32]map[string]string)
+	for v := 1; v <= 30; v++ {
+		applySortedBatch(t, r, legacy, expected, 40)
+		_, version, err := legacy.SaveVersion()
+		require.NoError(t, err)
+		states[uint32(version)] = copyState(expected)
+	}
+
+	dir := t.TempDir()
+	opts := DefaultTreeStoreOptions()
+	// small changesets so that the imported versions span several of them
+	opts.ChangesetMaxSize = 4096
+	importLegacy(t, legacy, 20, dir, opts)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Greater(t, len(entries), 2, "expected several changesets and the pruned file")
+
+	treeOpts := DefaultTreeOptions()
+	treeOpts.TreeStoreOptions = opts
+	tree, err := OpenTree(dir, treeOpts)
+	require.NoError(t, err)
+	defer tree.Close()
+
+	require.Equal(t, uint32(20), tree.Version())
+	first, last := tree.AvailableVersions()
+	require.Equal(t, uint32(20), first)
+	require.Equal(t, uint32(20), last)
+	require.False(t, tree.VersionExists(19))
+	itree, err := tree.GetImmutable(20)
+	require.NoError(t, err)
+	requireState(t, itree, states[20])
+
+	// replaying the later versions on top of the import yields the legacy hashes
+	latest, err := legacy.GetImmutable(30)
+	require.NoError(t, err)
+	err = latest.TraverseStateChanges(21, 30, func(version int64, cs *iavl.ChangeSet) error {
+		for _, pair := range cs.Pairs {
+			if pair.Delete {
+				_, err = tree.Remove(pair.Key)
+			} else {
+				_, err = tree.Set(pair.Key, pair.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		hash, saved, err := tree.SaveVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint32(version), saved)
+		itree, err := legacy.GetImmutable(version)
+		require.NoError(t, err)
+		require.Equal(t, itree.Hash(), hash, "version %d", version)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(30), tree.Version())
+
+	// the nodes imported at older versions are compacted once orphaned and pruned
+	require.NoError(t, tree.DeleteVersionsTo(29))
+	require.NoError(t, tree.Store().Compact())
+	itree, err = tree.GetImmutable(30)
+	require.NoError(t, err)
+	requireState(t, itree, states[30])
+}
+
+func TestImporter_EmptyTree(t *testing.T) {
+	dir := t.TempDir()
+	imp, err := NewImporter(dir, 5, DefaultTreeStoreOptions())
+	require.NoError(t, err)
+	hash, err := imp.Commit()
+	require.NoError(t, err)
+	require.Equal(t, EmptyRootHash(), hash)
+
+	tree, err := OpenTree(dir, DefaultTreeOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+	require.Equal(t, uint32(5), tree.Version())
+
+	_, err = NewImporter(dir, 5, DefaultTreeStoreOptions())
+	require.Error(t, err, "importing into a non-empty dir")
+}
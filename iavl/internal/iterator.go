@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+)
+
+// Iterator iterates over the leaves of a tree in the [start, end) key range.
+// It traverses the tree lazily with a stack of node pointers, so only the nodes on the path to the
+// current leaf and their siblings are resolved at any time.
+// The methods of Iterator match the Iterator interface of the store package.
+type Iterator struct {
+	start, end []byte
+	ascending  bool
+
+	stack []*NodePointer
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+// NewIterator creates an iterator over the tree rooted at root, which may be nil for an empty tree.
+// A nil start or end leaves the range unbounded on that side.
+func NewIterator(root *NodePointer, start, end []byte, ascending bool) *Iterator {
+	it := &Iterator{
+		start:     start,
+		end:       end,
+		ascending: ascending,
+	}
+	if root != nil {
+		it.stack = append(it.stack, root)
+	}
+	it.Next()
+	return it
+}
+
+// Domain returns the start and end of the iterator range.
+func (it *Iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid returns true if the iterator is positioned on a leaf.
+func (it *Iterator) Valid() bool {
+	return it.valid
+}
+
+// Key returns the key of the current leaf.
+func (it *Iterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the value of the current leaf.
+func (it *Iterator) Value() []byte {
+	return it.value
+}
+
+// Error returns the error that invalidated the iterator, if any.
+func (it *Iterator) Error() error {
+	return it.err
+}
+
+// Close releases the iterator.
+func (it *Iterator) Close() error {
+	it.stack = nil
+	it.valid = false
+	return nil
+}
+
+// Next moves the iterator to the next leaf in the range.
+func (it *Iterator) Next() {
+	it.valid = false
+	for len(it.stack) > 0 && it.err == nil {
+		ptr := it.stack[len(it.stack)-1]
+		it.stack = it.stack[:len(it.stack)-1]
+		if it.visit(ptr) {
+			return
+		}
+	}
+}
+
+// visit processes a node popped from the stack, it returns true if the node is a leaf in range.
+func (it *Iterator) visit(ptr *NodePointer) bool {
+	node, pin, err := ptr.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	key, err := node.Key()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if node.IsLeaf() {
+		if it.start != nil && bytes.Compare(key.UnsafeBytes(), it.start) < 0 {
+			return false
+		}
+		if it.end != nil && bytes.Compare(key.UnsafeBytes(), it.end) >= 0 {
+			return false
+		}
+
+		value, err := node.Value()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.key, it.value, it.valid = key.SafeCopy(), value.SafeCopy(), true
+		return true
+	}
+
+	// the left subtree holds the keys smaller than the branch key, the right one the others
+	visitLeft := it.start == nil || bytes.Compare(it.start, key.UnsafeBytes()) < 0
+	visitRight := it.end == nil || bytes.Compare(key.UnsafeBytes(), it.end) < 0
+
+	left, right := node.Left(), node.Right()
+	if left == nil || right == nil {
+		it.err = errors.New("branch node is missing a child")
+		return false
+	}
+
+	// the subtree visited first is pushed last
+	if it.ascending {
+		if visitRight {
+			it.stack = append(it.stack, right)
+		}
+		if visitLeft {
+			it.stack = append(it.stack, left)
+		}
+	} else {
+		if visitLeft {
+			it.stack = append(it.stack, left)
+		}
+		if visitRight {
+			it.stack = append(it.stack, right)
+		}
+	}
+	return false
+}
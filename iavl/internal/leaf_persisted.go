@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// LeafPersisted is a leaf node loaded from a changeset.
+// The key and value are read from the key value data file on demand.
+type LeafPersisted struct {
+	cs      *Changeset
+	layout  LeafLayout
+	fileIdx uint32
+}
+
+var _ Node = (*LeafPersisted)(nil)
+
+// ID implements the Node interface.
+func (node *LeafPersisted) ID() NodeID {
+	return node.layout.ID
+}
+
+// IsLeaf implements the Node interface.
+func (node *LeafPersisted) IsLeaf() bool {
+	return true
+}
+
+// Key implements the Node interface.
+func (node *LeafPersisted) Key() (UnsafeBytes, error) {
+	key, err := node.cs.ReadKey(node.layout.KeyOffset)
+	if err != nil {
+		return UnsafeBytes{}, err
+	}
+	return WrapSafeBytes(key), nil
+}
+
+// Value implements the Node interface.
+func (node *LeafPersisted) Value() (UnsafeBytes, error) {
+	_, value, err := node.cs.ReadKeyValue(node.layout.KeyOffset)
+	if err != nil {
+		return UnsafeBytes{}, err
+	}
+	return WrapSafeBytes(value), nil
+}
+
+// Left implements the Node interface.
+func (node *LeafPersisted) Left() *NodePointer {
+	return nil
+}
+
+// Right implements the Node interface.
+func (node *LeafPersisted) Right() *NodePointer {
+	return nil
+}
+
+// Hash implements the Node interface.
+func (node *LeafPersisted) Hash() UnsafeBytes {
+	return WrapSafeBytes(node.layout.Hash[:])
+}
+
+// Height implements the Node interface.
+func (node *LeafPersisted) Height() uint8 {
+	return 0
+}
+
+// Size implements the Node interface.
+func (node *LeafPersisted) Size() int64 {
+	return 1
+}
+
+// Version implements the Node interface.
+func (node *LeafPersisted) Version() uint32 {
+	return node.layout.ID.Version()
+}
+
+// Get implements the Node interface.
+func (node *LeafPersisted) Get(key []byte) (value UnsafeBytes, index int64, err error) {
+	nodeKey, nodeValue, err := node.cs.ReadKeyValue(node.layout.KeyOffset)
+	if err != nil {
+		return UnsafeBytes{}, 0, err
+	}
+
+	switch bytes.Compare(nodeKey, key) {
+	case -1:
+		return UnsafeBytes{}, 1, nil
+	case 1:
+		return UnsafeBytes{}, 0, nil
+	default:
+		return WrapSafeBytes(nodeValue), 0, nil
+	}
+}
+
+// MutateBranch implements the Node interface.
+func (node *LeafPersisted) MutateBranch(uint32) (*MemNode, error) {
+	return nil, fmt.Errorf("cannot mutate leaf node %s as a branch", node.layout.ID)
+}
+
+// String implements the fmt.Stringer interface.
+func (node *LeafPersisted) String() string {
+	return fmt.Sprintf("LeafPersisted{id:%s, fileIdx:%d, keyOffset:%d}", node.layout.ID, node.fileIdx, node.layout.KeyOffset)
+}
@@ -0,0 +1,159 @@
+package internal
+
+import "bytes"
+
+// setRecursive inserts or updates the key in the subtree pointed to by ptr and returns a pointer
+// to the new root of the subtree.
+// updated is true if the key already existed and its value was replaced, in which case the shape
+// of the tree is unchanged and no rebalancing is needed.
+func setRecursive(ptr *NodePointer, key, value []byte, ctx *mutationContext) (newPtr *NodePointer, updated bool, err error) {
+	if ptr == nil {
+		return NewNodePointer(newLeafNode(key, value, ctx.version)), false, nil
+	}
+
+	node, pin, err := ptr.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if node.IsLeaf() {
+		nodeKey, err := node.Key()
+		if err != nil {
+			return nil, false, err
+		}
+
+		leaf := NewNodePointer(newLeafNode(key, value, ctx.version))
+		switch bytes.Compare(key, nodeKey.UnsafeBytes()) {
+		case -1:
+			// the new leaf goes on the left, the branch key is the smallest key of the right subtree
+			return NewNodePointer(&MemNode{
+				height:  1,
+				size:    2,
+				version: ctx.version,
+				key:     nodeKey.SafeCopy(),
+				left:    leaf,
+				right:   ptr,
+			}), false, nil
+		case 1:
+			return NewNodePointer(&MemNode{
+				height:  1,
+				size:    2,
+				version: ctx.version,
+				key:     key,
+				left:    ptr,
+				right:   leaf,
+			}), false, nil
+		default:
+			ctx.addOrphan(node.ID())
+			return leaf, true, nil
+		}
+	}
+
+	newNode, err := ctx.mutateBranch(node)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if bytes.Compare(key, newNode.key) < 0 {
+		newNode.left, updated, err = setRecursive(newNode.left, key, value, ctx)
+	} else {
+		newNode.right, updated, err = setRecursive(newNode.right, key, value, ctx)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if updated {
+		return NewNodePointer(newNode), true, nil
+	}
+
+	if err := newNode.updateHeightSize(); err != nil {
+		return nil, false, err
+	}
+	newNode, err = newNode.reBalance(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return NewNodePointer(newNode), false, nil
+}
+
+// removeRecursive removes the key from the subtree pointed to by ptr.
+// It returns the pointer to the new root of the subtree, which is nil if the subtree was a single leaf
+// that got removed, and the new smallest key of the subtree if it changed so that the parent can
+// update its own key. removed is false if the key was not found, in which case the subtree is unchanged.
+func removeRecursive(ptr *NodePointer, key []byte, ctx *mutationContext) (newPtr *NodePointer, newKey []byte, removed bool, err error) {
+	node, pin, err := ptr.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	nodeKey, err := node.Key()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if node.IsLeaf() {
+		if !bytes.Equal(key, nodeKey.UnsafeBytes()) {
+			return ptr, nil, false, nil
+		}
+		ctx.addOrphan(node.ID())
+		return nil, nil, true, nil
+	}
+
+	if bytes.Compare(key, nodeKey.UnsafeBytes()) < 0 {
+		newLeft, newLeftKey, removed, err := removeRecursive(node.Left(), key, ctx)
+		if err != nil || !removed {
+			return ptr, nil, removed, err
+		}
+
+		if newLeft == nil {
+			// the left leaf was removed, the right subtree replaces this node and its smallest key is
+			// the key of this node
+			ctx.addOrphan(node.ID())
+			return node.Right(), nodeKey.SafeCopy(), true, nil
+		}
+
+		newNode, err := ctx.mutateBranch(node)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		newNode.left = newLeft
+		if err := newNode.updateHeightSize(); err != nil {
+			return nil, nil, false, err
+		}
+		newNode, err = newNode.reBalance(ctx)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return NewNodePointer(newNode), newLeftKey, true, nil
+	}
+
+	newRight, newRightKey, removed, err := removeRecursive(node.Right(), key, ctx)
+	if err != nil || !removed {
+		return ptr, nil, removed, err
+	}
+
+	if newRight == nil {
+		ctx.addOrphan(node.ID())
+		return node.Left(), nil, true, nil
+	}
+
+	newNode, err := ctx.mutateBranch(node)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	newNode.right = newRight
+	if newRightKey != nil {
+		newNode.key = newRightKey
+	}
+	if err := newNode.updateHeightSize(); err != nil {
+		return nil, nil, false, err
+	}
+	newNode, err = newNode.reBalance(ctx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return NewNodePointer(newNode), nil, true, nil
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+)
+
+// emptyRootHash is the hash of an empty tree, the hash of an empty input to conform with RFC-6962.
+var emptyRootHash = sha256.New().Sum(nil)
+
+// EmptyRootHash returns the root hash of an empty tree.
+func EmptyRootHash() []byte {
+	return emptyRootHash
+}
+
+// computeHash computes the hash of this node, recursively hashing any descendants whose hash
+// has not been computed yet, and caches the result in the node.
+//
+// The hash preimage is the same as the one of github.com/cosmos/iavl so that root hashes are
+// compatible across both implementations:
+//
+//	varint(height) | varint(size) | varint(version) | leaf: bytes(key) | bytes(sha256(value))
+//	                                                | branch: bytes(left.hash) | bytes(right.hash)
+//
+// where varint is the zig-zag encoded signed varint and bytes is the uvarint length prefixed byte slice.
+func (node *MemNode) computeHash() ([]byte, error) {
+	if node.hash != nil {
+		return node.hash, nil
+	}
+
+	if node.IsLeaf() {
+		node.hash = leafHash(node.key, node.value, node.version)
+		return node.hash, nil
+	}
+
+	leftHash, err := childHash(node.left)
+	if err != nil {
+		return nil, err
+	}
+	rightHash, err := childHash(node.right)
+	if err != nil {
+		return nil, err
+	}
+	node.hash = branchHash(node.height, node.size, node.version, leftHash, rightHash)
+	return node.hash, nil
+}
+
+// leafHash returns the hash of a leaf node, see computeHash.
+func leafHash(key, value []byte, version uint32) []byte {
+	h := sha256.New()
+	writeHashVarint(h, 0)
+	writeHashVarint(h, 1)
+	writeHashVarint(h, int64(version))
+	valueHash := sha256.Sum256(value)
+	writeHashBytes(h, key)
+	writeHashBytes(h, valueHash[:])
+	return h.Sum(nil)
+}
+
+// branchHash returns the hash of a branch node, see computeHash.
+func branchHash(height uint8, size int64, version uint32, leftHash, rightHash []byte) []byte {
+	h := sha256.New()
+	writeHashVarint(h, int64(height))
+	writeHashVarint(h, size)
+	writeHashVarint(h, int64(version))
+	writeHashBytes(h, leftHash)
+	writeHashBytes(h, rightHash)
+	return h.Sum(nil)
+}
+
+// childHash returns the hash of the node pointed to by ptr, computing it if the node is in memory and new.
+func childHash(ptr *NodePointer) ([]byte, error) {
+	if mem := ptr.mem.Load(); mem != nil {
+		return mem.computeHash()
+	}
+
+	node, pin, err := ptr.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return nil, err
+	}
+	// persisted nodes always have their hash computed, but the bytes may be memory-mapped
+	return node.Hash().SafeCopy(), nil
+}
+
+func writeHashVarint(h hash.Hash, v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	_, _ = h.Write(buf[:n])
+}
+
+func writeHashBytes(h hash.Hash, bz []byte) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(bz)))
+	_, _ = h.Write(buf[:n])
+	_, _ = h.Write(bz)
+}
@@ -7,10 +7,10 @@ import (
 
 // NodePointer is a pointer to a Node, which may be either in-memory, on-disk or both.
 type NodePointer struct {
-	mem atomic.Pointer[MemNode]
-	// changeset *Changeset // commented to satisfy linter, will uncomment in a future PR when we wire it up
-	fileIdx uint32 // absolute index in file, 1-based, zero means we don't have an offset
-	id      NodeID
+	mem       atomic.Pointer[MemNode]
+	changeset *Changeset // changeset the node was loaded from or written to, nil if the node was never persisted
+	fileIdx   uint32     // absolute index in file, 1-based, zero means we don't have an offset
+	id        NodeID
 }
 
 // NewNodePointer creates a new NodePointer pointing to the given in-memory node.
@@ -36,7 +36,45 @@ func (p *NodePointer) Resolve() (Node, Pin, error) {
 	if mem != nil {
 		return mem, NoopPin{}, nil
 	}
-	return nil, NoopPin{}, fmt.Errorf("node not in memory and on-disk loading will be implemented in a future PR")
+
+	cs := p.changeset
+	if cs == nil {
+		return nil, NoopPin{}, fmt.Errorf("node %s is neither in memory nor persisted", p.id)
+	}
+
+	// the node is stored in another changeset, or this changeset was replaced by a compacted one
+	if !cs.Contains(p.id.Version()) {
+		return cs.resolver.resolveID(p.id)
+	}
+	pin, ok := cs.pin()
+	if !ok {
+		return cs.resolver.resolveID(p.id)
+	}
+
+	node, err := cs.Resolve(p.id, p.fileIdx)
+	return node, pin, err
+}
+
+// ID returns the ID of the node, or the zero value if the node hasn't been persisted yet.
+func (p *NodePointer) ID() NodeID {
+	if mem := p.mem.Load(); mem != nil {
+		return mem.nodeId
+	}
+	return p.id
+}
+
+// evict drops the in-memory copy of this node and of its descendants once they have been persisted,
+// so that they are loaded from disk the next time they are resolved.
+func (p *NodePointer) evict() {
+	mem := p.mem.Load()
+	if mem == nil || p.changeset == nil {
+		return
+	}
+	if !mem.IsLeaf() {
+		mem.left.evict()
+		mem.right.evict()
+	}
+	p.mem.Store(nil)
 }
 
 // String implements the fmt.Stringer interface.
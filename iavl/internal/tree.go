@@ -0,0 +1,324 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ImmutableTree is a read-only view of a tree at a saved version.
+type ImmutableTree struct {
+	root    *NodePointer
+	version uint32
+}
+
+// NewImmutableTree creates a read-only view of the tree rooted at root, which may be nil for an empty tree.
+func NewImmutableTree(root *NodePointer, version uint32) *ImmutableTree {
+	return &ImmutableTree{root: root, version: version}
+}
+
+// Version returns the version of the tree.
+func (t *ImmutableTree) Version() uint32 {
+	return t.version
+}
+
+// Hash returns the root hash of the tree.
+func (t *ImmutableTree) Hash() ([]byte, error) {
+	return rootHash(t.root)
+}
+
+// Size returns the number of leaves of the tree.
+func (t *ImmutableTree) Size() (int64, error) {
+	if t.root == nil {
+		return 0, nil
+	}
+	node, pin, err := t.root.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return 0, err
+	}
+	return node.Size(), nil
+}
+
+// Get returns the value of the key, or nil if the key doesn't exist.
+func (t *ImmutableTree) Get(key []byte) ([]byte, error) {
+	return get(t.root, key)
+}
+
+// Has returns true if the key exists.
+func (t *ImmutableTree) Has(key []byte) (bool, error) {
+	value, err := get(t.root, key)
+	return value != nil, err
+}
+
+// Iterator returns an iterator over the [start, end) key range.
+func (t *ImmutableTree) Iterator(start, end []byte, ascending bool) *Iterator {
+	return NewIterator(t.root, start, end, ascending)
+}
+
+// Tree is a mutable IAVL tree persisted to changesets.
+// Changes are applied to an in-memory working tree and written to disk by SaveVersion, after which
+// the in-memory nodes are evicted and loaded back from disk when needed.
+// Tree is not safe for concurrent mutations, but the immutable views returned by GetImmutable can be
+// used concurrently with mutations of the tree.
+type Tree struct {
+	store *TreeStore
+
+	mtx            sync.RWMutex
+	root           *NodePointer // root of the working tree
+	lastRoot       *NodePointer // root of the last saved version
+	version        uint32       // last saved version
+	initialVersion uint32
+	ctx            *mutationContext
+}
+
+// TreeOptions configures a Tree.
+type TreeOptions struct {
+	TreeStoreOptions
+
+	// InitialVersion is the version of the first version saved in an empty tree, it defaults to 1.
+	InitialVersion uint32
+}
+
+// DefaultTreeOptions returns the default TreeOptions.
+func DefaultTreeOptions() TreeOptions {
+	return TreeOptions{
+		TreeStoreOptions: DefaultTreeStoreOptions(),
+		InitialVersion:   1,
+	}
+}
+
+// OpenTree opens the tree stored in dir at its latest version.
+func OpenTree(dir string, opts TreeOptions) (*Tree, error) {
+	store, err := OpenTreeStore(dir, opts.TreeStoreOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Tree{
+		store:          store,
+		initialVersion: max(opts.InitialVersion, 1),
+	}
+	if err := t.LoadVersion(0); err != nil {
+		return nil, errors.Join(err, store.Close())
+	}
+	return t, nil
+}
+
+// Store returns the changeset store of the tree.
+func (t *Tree) Store() *TreeStore {
+	return t.store
+}
+
+// SetInitialVersion sets the version of the first version saved in an empty tree.
+func (t *Tree) SetInitialVersion(version uint32) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.initialVersion = max(version, 1)
+	if t.version == 0 {
+		t.ctx = newMutationContext(t.initialVersion)
+	}
+}
+
+// LoadVersion loads the given version, or the latest version if version is 0, discarding the
+// uncommitted changes. Saving the next version fails if a later version already exists, use
+// LoadVersionForOverwriting to remove them.
+func (t *Tree) LoadVersion(version uint32) error {
+	if version == 0 {
+		version = t.store.LatestVersion()
+	}
+
+	var (
+		root *NodePointer
+		err  error
+	)
+	if version != 0 {
+		if root, err = t.store.RootAt(version); err != nil {
+			return err
+		}
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.root, t.lastRoot, t.version = root, root, version
+	t.resetWorkingVersion()
+	return nil
+}
+
+// LoadVersionForOverwriting loads the given version and removes all the later versions.
+func (t *Tree) LoadVersionForOverwriting(version uint32) error {
+	if err := t.store.Rollback(version); err != nil {
+		return err
+	}
+	return t.LoadVersion(version)
+}
+
+// resetWorkingVersion discards the uncommitted changes, the caller must hold t.mtx.
+func (t *Tree) resetWorkingVersion() {
+	t.root = t.lastRoot
+	if t.version == 0 {
+		t.ctx = newMutationContext(t.initialVersion)
+	} else {
+		t.ctx = newMutationContext(t.version + 1)
+	}
+}
+
+// Version returns the last saved version, 0 if no version has been saved yet.
+func (t *Tree) Version() uint32 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	return t.version
+}
+
+// Hash returns the root hash of the last saved version.
+func (t *Tree) Hash() ([]byte, error) {
+	t.mtx.RLock()
+	root := t.lastRoot
+	t.mtx.RUnlock()
+	return rootHash(root)
+}
+
+// WorkingHash returns the root hash of the working tree.
+func (t *Tree) WorkingHash() ([]byte, error) {
+	return rootHash(t.root)
+}
+
+// Get returns the value of the key in the working tree, or nil if the key doesn't exist.
+func (t *Tree) Get(key []byte) ([]byte, error) {
+	return get(t.root, key)
+}
+
+// Has returns true if the key exists in the working tree.
+func (t *Tree) Has(key []byte) (bool, error) {
+	value, err := get(t.root, key)
+	return value != nil, err
+}
+
+// Iterator returns an iterator over the [start, end) key range of the working tree.
+func (t *Tree) Iterator(start, end []byte, ascending bool) *Iterator {
+	return NewIterator(t.root, start, end, ascending)
+}
+
+// Set sets the value of the key in the working tree, it returns true if the key already existed.
+func (t *Tree) Set(key, value []byte) (updated bool, err error) {
+	if key == nil {
+		return false, errors.New("key cannot be nil")
+	}
+	if value == nil {
+		return false, errors.New("value cannot be nil")
+	}
+
+	root, updated, err := setRecursive(t.root, key, value, t.ctx)
+	if err != nil {
+		return false, err
+	}
+	t.root = root
+	return updated, nil
+}
+
+// Remove removes the key from the working tree, it returns true if the key existed.
+func (t *Tree) Remove(key []byte) (removed bool, err error) {
+	if t.root == nil {
+		return false, nil
+	}
+
+	root, _, removed, err := removeRecursive(t.root, key, t.ctx)
+	if err != nil {
+		return false, err
+	}
+	t.root = root
+	return removed, nil
+}
+
+// SaveVersion writes the working tree as the next version and returns its root hash and version.
+func (t *Tree) SaveVersion() ([]byte, uint32, error) {
+	version := t.ctx.version
+	if t.store.LatestVersion() >= version {
+		return nil, 0, fmt.Errorf("version %d already exists, use LoadVersionForOverwriting to overwrite it", version)
+	}
+
+	hash, err := rootHash(t.root)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := t.store.SaveVersion(version, t.root, t.ctx.orphans); err != nil {
+		return nil, 0, err
+	}
+
+	root := t.root
+	if root != nil {
+		// the saved nodes are loaded from disk from now on
+		root.evict()
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.lastRoot, t.version = root, version
+	t.resetWorkingVersion()
+	return hash, version, nil
+}
+
+// VersionExists returns true if the version was saved and hasn't been pruned.
+func (t *Tree) VersionExists(version uint32) bool {
+	return t.store.VersionExists(version)
+}
+
+// AvailableVersions returns the range of versions that can be loaded, first is 0 if the tree is empty.
+func (t *Tree) AvailableVersions() (first, last uint32) {
+	return t.store.FirstVersion(), t.store.LatestVersion()
+}
+
+// GetImmutable returns a read-only view of the tree at the given saved version.
+func (t *Tree) GetImmutable(version uint32) (*ImmutableTree, error) {
+	t.mtx.RLock()
+	if version == t.version {
+		defer t.mtx.RUnlock()
+		return NewImmutableTree(t.lastRoot, version), nil
+	}
+	t.mtx.RUnlock()
+
+	root, err := t.store.RootAt(version)
+	if err != nil {
+		return nil, err
+	}
+	return NewImmutableTree(root, version), nil
+}
+
+// DeleteVersionsTo prunes the versions up to and including the given version, the disk space is
+// reclaimed by a background compaction.
+func (t *Tree) DeleteVersionsTo(version uint32) error {
+	return t.store.Prune(version)
+}
+
+// Close closes the tree, waiting for a running compaction to complete.
+func (t *Tree) Close() error {
+	return t.store.Close()
+}
+
+// get returns the value of the key in the tree rooted at root.
+func get(root *NodePointer, key []byte) ([]byte, error) {
+	if root == nil {
+		return nil, nil
+	}
+
+	node, pin, err := root.Resolve()
+	defer pin.Unpin()
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := node.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return value.SafeCopy(), nil
+}
+
+// rootHash returns the hash of the tree rooted at root, computing the hashes of the new nodes.
+func rootHash(root *NodePointer) ([]byte, error) {
+	if root == nil {
+		return emptyRootHash, nil
+	}
+	return childHash(root)
+}
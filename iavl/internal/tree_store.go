@@ -0,0 +1,444 @@
+package internal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const (
+	// DefaultChangesetMaxSize is the default size of the data files of a changeset after which
+	// a new changeset is started.
+	DefaultChangesetMaxSize = 1 << 30
+
+	// DefaultCompactionOrphanRatio is the default ratio of prunable orphans to nodes of a changeset
+	// above which the changeset is compacted.
+	DefaultCompactionOrphanRatio = 0.5
+
+	prunedFilename = "pruned"
+)
+
+// Logger is the logger used by the tree store to report the progress of background compactions.
+// It is satisfied by cosmossdk.io/log.Logger.
+type Logger interface {
+	Info(msg string, keyVals ...any)
+	Error(msg string, keyVals ...any)
+}
+
+// nopLogger discards all log messages.
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// TreeStoreOptions configures a TreeStore.
+type TreeStoreOptions struct {
+	// ChangesetMaxSize is the size of the data files of a changeset after which a new changeset is
+	// started, the key value data file of a changeset can't exceed 4GB.
+	ChangesetMaxSize uint64
+
+	// CompactionOrphanRatio is the ratio of prunable orphans to nodes of a changeset above which it
+	// is compacted. A changeset whose versions have all been pruned is always compacted.
+	CompactionOrphanRatio float64
+
+	// Logger logs the background compactions, it defaults to a no-op logger.
+	Logger Logger
+}
+
+// DefaultTreeStoreOptions returns the default TreeStoreOptions.
+func DefaultTreeStoreOptions() TreeStoreOptions {
+	return TreeStoreOptions{
+		ChangesetMaxSize:      DefaultChangesetMaxSize,
+		CompactionOrphanRatio: DefaultCompactionOrphanRatio,
+	}
+}
+
+// TreeStore manages the changesets of a tree directory.
+// Versions are appended to the current changeset until it grows past ChangesetMaxSize, then a new
+// changeset is started. Orphaned nodes are recorded in the changeset that stores them, and pruned
+// versions are reclaimed by compacting the changesets in the background.
+type TreeStore struct {
+	dir  string
+	opts TreeStoreOptions
+
+	mtx          sync.RWMutex
+	changesets   []*Changeset // sorted by start version
+	writer       *ChangesetWriter
+	latest       uint32
+	firstVersion uint32 // first version that hasn't been pruned, 0 if no version was ever pruned
+
+	compactMtx sync.Mutex // held for the duration of a compaction
+}
+
+// OpenTreeStore opens the tree directory, creating it if needed.
+// Compactions that were interrupted before completion are discarded, as well as the changesets
+// that were replaced by a completed compaction but not deleted yet.
+func OpenTreeStore(dir string, opts TreeStoreOptions) (*TreeStore, error) {
+	if opts.ChangesetMaxSize == 0 {
+		opts.ChangesetMaxSize = DefaultChangesetMaxSize
+	}
+	if opts.Logger == nil {
+		opts.Logger = nopLogger{}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create tree dir: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree dir: %w", err)
+	}
+
+	// the changeset with the highest compactedAt version replaces the others with the same start version
+	dirs := make(map[uint32]string)
+	compacted := make(map[uint32]uint32)
+	var stale []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		startVersion, compactedAt, valid := ParseChangesetDirName(entry.Name())
+		if !valid {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		ready, err := IsChangesetReady(path)
+		if err != nil {
+			return nil, err
+		}
+		if !ready {
+			stale = append(stale, path)
+			continue
+		}
+
+		if existing, ok := dirs[startVersion]; ok {
+			if compacted[startVersion] > compactedAt {
+				stale = append(stale, path)
+				continue
+			}
+			stale = append(stale, existing)
+		}
+		dirs[startVersion] = path
+		compacted[startVersion] = compactedAt
+	}
+
+	for _, path := range stale {
+		if err := os.RemoveAll(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale changeset %s: %w", path, err)
+		}
+	}
+
+	ts := &TreeStore{
+		dir:  dir,
+		opts: opts,
+	}
+
+	startVersions := make([]uint32, 0, len(dirs))
+	for startVersion := range dirs {
+		startVersions = append(startVersions, startVersion)
+	}
+	sort.Slice(startVersions, func(i, j int) bool { return startVersions[i] < startVersions[j] })
+
+	for _, startVersion := range startVersions {
+		files, err := OpenChangesetFiles(dirs[startVersion])
+		if err != nil {
+			return nil, errors.Join(err, ts.Close())
+		}
+		cs, err := OpenChangeset(files, ts)
+		if err != nil {
+			return nil, errors.Join(err, files.Close(), ts.Close())
+		}
+
+		// a changeset without any version was created right before a crash
+		if len(cs.versions) == 0 {
+			if err := files.DeleteFiles(); err != nil {
+				return nil, errors.Join(err, ts.Close())
+			}
+			continue
+		}
+
+		if n := len(ts.changesets); n > 0 && ts.changesets[n-1].EndVersion()+1 != cs.StartVersion() {
+			err := fmt.Errorf("changeset %s doesn't follow version %d", files.Dir(), ts.changesets[n-1].EndVersion())
+			return nil, errors.Join(err, files.Close(), ts.Close())
+		}
+		ts.changesets = append(ts.changesets, cs)
+		ts.latest = cs.EndVersion()
+	}
+
+	ts.firstVersion, err = readPrunedFile(dir)
+	if err != nil {
+		return nil, errors.Join(err, ts.Close())
+	}
+
+	return ts, nil
+}
+
+// Dir returns the tree directory.
+func (ts *TreeStore) Dir() string {
+	return ts.dir
+}
+
+// LatestVersion returns the latest saved version, or 0 if no version was saved yet.
+func (ts *TreeStore) LatestVersion() uint32 {
+	ts.mtx.RLock()
+	defer ts.mtx.RUnlock()
+	return ts.latest
+}
+
+// FirstVersion returns the first version that is available, or 0 if no version was saved yet.
+func (ts *TreeStore) FirstVersion() uint32 {
+	ts.mtx.RLock()
+	defer ts.mtx.RUnlock()
+	return ts.firstVersionLocked()
+}
+
+func (ts *TreeStore) firstVersionLocked() uint32 {
+	if len(ts.changesets) == 0 {
+		return 0
+	}
+	return max(ts.firstVersion, ts.changesets[0].StartVersion())
+}
+
+// VersionExists returns true if the version was saved and hasn't been pruned.
+func (ts *TreeStore) VersionExists(version uint32) bool {
+	ts.mtx.RLock()
+	defer ts.mtx.RUnlock()
+	return version != 0 && version >= ts.firstVersionLocked() && version <= ts.latest
+}
+
+// RootAt returns a pointer to the root of the tree at the given version, nil if the tree is empty.
+func (ts *TreeStore) RootAt(version uint32) (*NodePointer, error) {
+	ts.mtx.RLock()
+	defer ts.mtx.RUnlock()
+
+	if version == 0 || version < ts.firstVersionLocked() || version > ts.latest {
+		return nil, fmt.Errorf("version %d does not exist, available versions are [%d, %d]", version, ts.firstVersionLocked(), ts.latest)
+	}
+
+	cs := ts.changesetForLocked(version)
+	if cs == nil {
+		return nil, fmt.Errorf("no changeset contains version %d", version)
+	}
+	vl, ok := cs.VersionLayout(version)
+	if !ok {
+		return nil, fmt.Errorf("version %d not found in changeset %s", version, cs.Files().Dir())
+	}
+	if vl.Root.IsEmpty() {
+		return nil, nil
+	}
+
+	rootCs := ts.changesetForLocked(vl.Root.Version())
+	if rootCs == nil {
+		return nil, fmt.Errorf("no changeset contains version %d of root %s", vl.Root.Version(), vl.Root)
+	}
+	return &NodePointer{changeset: rootCs, id: vl.Root}, nil
+}
+
+// changesetForLocked returns the changeset containing the version, the caller must hold ts.mtx.
+func (ts *TreeStore) changesetForLocked(version uint32) *Changeset {
+	i := sort.Search(len(ts.changesets), func(i int) bool {
+		return ts.changesets[i].EndVersion() >= version
+	})
+	if i == len(ts.changesets) || ts.changesets[i].StartVersion() > version {
+		return nil
+	}
+	return ts.changesets[i]
+}
+
+// resolveID implements nodeResolver.
+func (ts *TreeStore) resolveID(id NodeID) (Node, Pin, error) {
+	for {
+		ts.mtx.RLock()
+		cs := ts.changesetForLocked(id.Version())
+		ts.mtx.RUnlock()
+		if cs == nil {
+			return nil, NoopPin{}, fmt.Errorf("no changeset contains version %d of %s, it may have been pruned", id.Version(), id)
+		}
+
+		pin, ok := cs.pin()
+		if !ok {
+			// the changeset was replaced by a compaction in the meantime
+			continue
+		}
+		node, err := cs.Resolve(id, 0)
+		return node, pin, err
+	}
+}
+
+// SaveVersion writes the nodes created at the given version and records the nodes it orphaned.
+// The version must directly follow the latest version, unless the store is empty.
+func (ts *TreeStore) SaveVersion(version uint32, root *NodePointer, orphans []NodeID) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	if ts.latest != 0 && version != ts.latest+1 {
+		return fmt.Errorf("cannot save version %d, the latest version is %d", version, ts.latest)
+	}
+
+	if ts.writer != nil && ts.writer.Size() >= ts.opts.ChangesetMaxSize {
+		ts.writer = nil
+	}
+	if ts.writer == nil {
+		writer, err := NewChangesetWriter(ts.dir, version, ts)
+		if err != nil {
+			return err
+		}
+		ts.writer = writer
+		ts.changesets = append(ts.changesets, writer.Changeset())
+	}
+
+	if err := ts.writer.WriteVersion(version, root); err != nil {
+		return err
+	}
+	ts.latest = version
+
+	// orphans are recorded after the version is durable, if this fails the orphans are never pruned
+	// but the tree remains consistent
+	byChangeset := make(map[*Changeset][]OrphanLayout)
+	for _, id := range orphans {
+		cs := ts.changesetForLocked(id.Version())
+		if cs == nil {
+			return fmt.Errorf("no changeset contains orphan %s", id)
+		}
+		byChangeset[cs] = append(byChangeset[cs], OrphanLayout{ID: id, OrphanedAt: version})
+	}
+	for cs, csOrphans := range byChangeset {
+		if err := cs.appendOrphans(csOrphans); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Prune removes the versions up to and including the given version and schedules a background
+// compaction of the changesets to reclaim the space of the nodes that are no longer reachable.
+func (ts *TreeStore) Prune(version uint32) error {
+	ts.mtx.Lock()
+	if version >= ts.latest {
+		ts.mtx.Unlock()
+		return fmt.Errorf("cannot prune version %d, the latest version is %d", version, ts.latest)
+	}
+	if version < ts.firstVersion {
+		ts.mtx.Unlock()
+		return nil
+	}
+	if err := writePrunedFile(ts.dir, version+1); err != nil {
+		ts.mtx.Unlock()
+		return err
+	}
+	ts.firstVersion = version + 1
+	ts.mtx.Unlock()
+
+	ts.compactAsync()
+	return nil
+}
+
+// Rollback removes the versions after the given version, the next saved version will be version + 1.
+func (ts *TreeStore) Rollback(version uint32) error {
+	// a compaction must not swap changesets while they are being truncated
+	ts.compactMtx.Lock()
+	defer ts.compactMtx.Unlock()
+
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	if version == ts.latest {
+		return nil
+	}
+	if version > ts.latest || version < ts.firstVersionLocked() {
+		return fmt.Errorf("cannot rollback to version %d, available versions are [%d, %d]", version, ts.firstVersionLocked(), ts.latest)
+	}
+
+	// the current changeset is sealed so that the next version starts a new one after the truncation
+	ts.writer = nil
+
+	kept := ts.changesets[:0]
+	for _, cs := range ts.changesets {
+		switch {
+		case cs.StartVersion() > version:
+			cs.evict(true)
+			continue
+		case cs.EndVersion() > version:
+			if err := cs.truncateVersions(version); err != nil {
+				return err
+			}
+		}
+		kept = append(kept, cs)
+	}
+	ts.changesets = kept
+	ts.latest = version
+
+	// nodes orphaned by the removed versions are reachable again
+	for _, cs := range ts.changesets {
+		orphans, err := cs.ReadOrphans(0)
+		if err != nil {
+			return err
+		}
+		retained := orphans[:0]
+		for _, orphan := range orphans {
+			if orphan.OrphanedAt <= version {
+				retained = append(retained, orphan)
+			}
+		}
+		if len(retained) == len(orphans) {
+			continue
+		}
+		if err := cs.rewriteOrphans(retained); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close waits for the running compaction to complete and closes all changesets.
+func (ts *TreeStore) Close() error {
+	ts.compactMtx.Lock()
+	defer ts.compactMtx.Unlock()
+
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	var errs []error
+	for _, cs := range ts.changesets {
+		errs = append(errs, cs.Close())
+	}
+	ts.changesets = nil
+	ts.writer = nil
+	return errors.Join(errs...)
+}
+
+// readPrunedFile reads the first version that hasn't been pruned, 0 if no version was pruned.
+func readPrunedFile(dir string) (uint32, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, prunedFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read pruned file: %w", err)
+	}
+	if len(bz) != 4 {
+		return 0, fmt.Errorf("pruned file has unexpected size: %d", len(bz))
+	}
+	return binary.LittleEndian.Uint32(bz), nil
+}
+
+// writePrunedFile atomically records the first version that hasn't been pruned.
+func writePrunedFile(dir string, firstVersion uint32) error {
+	path := filepath.Join(dir, prunedFilename)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, binary.LittleEndian.AppendUint32(nil, firstVersion), 0o600); err != nil {
+		return fmt.Errorf("failed to write pruned file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write pruned file: %w", err)
+	}
+	return nil
+}
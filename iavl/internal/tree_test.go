@@ -0,0 +1,346 @@
+package internal
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/log/v2"
+	"github.com/cosmos/iavl"
+	iavldb "github.com/cosmos/iavl/db"
+	"github.com/stretchr/testify/require"
+)
+
+// applyRandomOps applies random sets and removes to the tree and to the expected state.
+func applyRandomOps(t *testing.T, r *rand.Rand, tree *Tree, legacy *iavl.MutableTree, expected map[string]string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("key%03d", r.Intn(300))
+		if r.Intn(4) == 0 {
+			removed, err := tree.Remove([]byte(key))
+			require.NoError(t, err)
+			_, exists := expected[key]
+			require.Equal(t, exists, removed)
+			delete(expected, key)
+			if legacy != nil {
+				_, _, err = legacy.Remove([]byte(key))
+				require.NoError(t, err)
+			}
+			continue
+		}
+
+		value := fmt.Sprintf("value%d", r.Int())
+		updated, err := tree.Set([]byte(key), []byte(value))
+		require.NoError(t, err)
+		_, exists := expected[key]
+		require.Equal(t, exists, updated)
+		expected[key] = value
+		if legacy != nil {
+			_, err = legacy.Set([]byte(key), []byte(value))
+			require.NoError(t, err)
+		}
+	}
+}
+
+// requireState checks the content of the tree against the expected state, using both point
+// lookups and iteration in both directions.
+func requireState(t *testing.T, tree *ImmutableTree, expected map[string]string) {
+	t.Helper()
+
+	keys := make([]string, 0, len(expected))
+	for key, value := range expected {
+		keys = append(keys, key)
+		got, err := tree.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, string(got))
+	}
+	sort.Strings(keys)
+
+	got, err := tree.Get([]byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	size, err := tree.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(keys)), size)
+
+	var iterated []string
+	it := tree.Iterator(nil, nil, true)
+	for ; it.Valid(); it.Next() {
+		iterated = append(iterated, string(it.Key()))
+		require.Equal(t, expected[string(it.Key())], string(it.Value()))
+	}
+	require.NoError(t, it.Error())
+	require.NoError(t, it.Close())
+	if len(keys) == 0 {
+		require.Empty(t, iterated)
+	} else {
+		require.Equal(t, keys, iterated)
+	}
+
+	if len(keys) < 3 {
+		return
+	}
+	start, end := keys[1], keys[len(keys)-1]
+	var reversed []string
+	it = tree.Iterator([]byte(start), []byte(end), false)
+	for ; it.Valid(); it.Next() {
+		reversed = append(reversed, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.Len(t, reversed, len(keys)-2)
+	require.Equal(t, keys[len(keys)-2], reversed[0])
+	require.Equal(t, start, reversed[len(reversed)-1])
+}
+
+func copyState(state map[string]string) map[string]string {
+	c := make(map[string]string, len(state))
+	for k, v := range state {
+		c[k] = v
+	}
+	return c
+}
+
+func TestTree_LegacyHashCompatibility(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree, err := OpenTree(t.TempDir(), DefaultTreeOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	legacy := iavl.NewMutableTree(iavldb.NewMemDB(), 0, true, log.NewNopLogger())
+
+	emptyHash, err := tree.WorkingHash()
+	require.NoError(t, err)
+	require.Equal(t, legacy.WorkingHash(), emptyHash)
+
+	expected := make(map[string]string)
+	for v := 1; v <= 20; v++ {
+		applyRandomOps(t, r, tree, legacy, expected, 50)
+
+		hash, version, err := tree.SaveVersion()
+		require.NoError(t, err)
+		legacyHash, legacyVersion, err := legacy.SaveVersion()
+		require.NoError(t, err)
+
+		require.Equal(t, uint32(legacyVersion), version)
+		require.Equal(t, legacyHash, hash, "version %d", version)
+	}
+}
+
+func TestTree_SaveLoadVersions(t *testing.T) {
+	dir := t.TempDir()
+	opts := DefaultTreeOptions()
+	// small changesets so that versions span several of them
+	opts.ChangesetMaxSize = 4096
+	opts.InitialVersion = 10
+
+	tree, err := OpenTree(dir, opts)
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(2))
+	expected := make(map[string]string)
+	states := make(map[uint32]map[string]string)
+	hashes := make(map[uint32][]byte)
+	for i := 0; i < 15; i++ {
+		applyRandomOps(t, r, tree, nil, expected, 40)
+		hash, version, err := tree.SaveVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint32(10+i), version)
+		states[version] = copyState(expected)
+		hashes[version] = hash
+	}
+	require.NoError(t, tree.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Greater(t, len(entries), 1, "expected several changesets")
+
+	tree, err = OpenTree(dir, opts)
+	require.NoError(t, err)
+	defer tree.Close()
+	require.Equal(t, uint32(24), tree.Version())
+
+	first, last := tree.AvailableVersions()
+	require.Equal(t, uint32(10), first)
+	require.Equal(t, uint32(24), last)
+
+	for version, state := range states {
+		itree, err := tree.GetImmutable(version)
+		require.NoError(t, err)
+		hash, err := itree.Hash()
+		require.NoError(t, err)
+		require.Equal(t, hashes[version], hash)
+		requireState(t, itree, state)
+	}
+
+	// saving an existing version fails until the later versions are removed
+	require.NoError(t, tree.LoadVersion(20))
+	_, _, err = tree.SaveVersion()
+	require.Error(t, err)
+
+	require.NoError(t, tree.LoadVersionForOverwriting(20))
+	require.False(t, tree.VersionExists(21))
+	expected = copyState(states[20])
+	applyRandomOps(t, r, tree, nil, expected, 40)
+	_, version, err := tree.SaveVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint32(21), version)
+
+	itree, err := tree.GetImmutable(21)
+	require.NoError(t, err)
+	requireState(t, itree, expected)
+	itree, err = tree.GetImmutable(20)
+	require.NoError(t, err)
+	requireState(t, itree, states[20])
+}
+
+func TestTree_PruneAndCompact(t *testing.T) {
+	dir := t.TempDir()
+	opts := DefaultTreeOptions()
+	opts.ChangesetMaxSize = 4096
+	opts.CompactionOrphanRatio = 0.1
+
+	tree, err := OpenTree(dir, opts)
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(3))
+	expected := make(map[string]string)
+	states := make(map[uint32]map[string]string)
+	for i := 0; i < 30; i++ {
+		applyRandomOps(t, r, tree, nil, expected, 40)
+		_, version, err := tree.SaveVersion()
+		require.NoError(t, err)
+		states[version] = copyState(expected)
+	}
+
+	sizeBefore := dirSize(t, dir)
+
+	require.NoError(t, tree.DeleteVersionsTo(20))
+	// wait for the background compaction and run another one synchronously
+	require.NoError(t, tree.Store().Compact())
+
+	require.False(t, tree.VersionExists(20))
+	_, err = tree.GetImmutable(20)
+	require.Error(t, err)
+	require.Less(t, dirSize(t, dir), sizeBefore)
+
+	for version := uint32(21); version <= 30; version++ {
+		itree, err := tree.GetImmutable(version)
+		require.NoError(t, err)
+		requireState(t, itree, states[version])
+	}
+
+	// new versions keep working on top of compacted changesets, and survive a restart
+	applyRandomOps(t, r, tree, nil, expected, 40)
+	_, version, err := tree.SaveVersion()
+	require.NoError(t, err)
+	states[version] = copyState(expected)
+	require.NoError(t, tree.Close())
+
+	tree, err = OpenTree(dir, opts)
+	require.NoError(t, err)
+	defer tree.Close()
+	first, last := tree.AvailableVersions()
+	require.Equal(t, uint32(21), first)
+	require.Equal(t, version, last)
+	for version := uint32(21); version <= last; version++ {
+		itree, err := tree.GetImmutable(version)
+		require.NoError(t, err)
+		requireState(t, itree, states[version])
+	}
+}
+
+func TestTreeStore_DiscardsInterruptedCompaction(t *testing.T) {
+	dir := t.TempDir()
+	tree, err := OpenTree(dir, DefaultTreeOptions())
+	require.NoError(t, err)
+	_, err = tree.Set([]byte("a"), []byte("1"))
+	require.NoError(t, err)
+	_, _, err = tree.SaveVersion()
+	require.NoError(t, err)
+	require.NoError(t, tree.Close())
+
+	// a compaction that didn't complete leaves a pending changeset behind
+	pending, err := CreateChangesetFiles(dir, 1, 5)
+	require.NoError(t, err)
+	require.NoError(t, pending.Close())
+
+	tree, err = OpenTree(dir, DefaultTreeOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	_, err = os.Stat(pending.Dir())
+	require.True(t, os.IsNotExist(err))
+	value, err := tree.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+}
+
+func dirSize(t *testing.T, dir string) int64 {
+	t.Helper()
+	var size int64
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		info, err := entry.Info()
+		require.NoError(t, err)
+		if entry.IsDir() {
+			size += dirSize(t, dir+"/"+entry.Name())
+			continue
+		}
+		size += info.Size()
+	}
+	return size
+}
+
+func TestTree_RestartAfterPartialCommit(t *testing.T) {
+	dir := t.TempDir()
+	opts := DefaultTreeOptions()
+
+	tree, err := OpenTree(dir, opts)
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(4))
+	expected := make(map[string]string)
+	var committed map[string]string
+	for i := 0; i < 3; i++ {
+		applyRandomOps(t, r, tree, nil, expected, 40)
+		_, _, err := tree.SaveVersion()
+		require.NoError(t, err)
+		if i == 1 {
+			committed = copyState(expected)
+		}
+	}
+	// the node stops after saving version 3 but before the commit info of version 3 is written
+	require.NoError(t, tree.Close())
+
+	tree, err = OpenTree(dir, opts)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), tree.Version())
+	require.NoError(t, tree.LoadVersionForOverwriting(2))
+
+	expected = copyState(committed)
+	applyRandomOps(t, r, tree, nil, expected, 40)
+	hash, version, err := tree.SaveVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), version)
+	require.NoError(t, tree.Close())
+
+	// the replayed version survives a second restart
+	tree, err = OpenTree(dir, opts)
+	require.NoError(t, err)
+	defer tree.Close()
+	require.Equal(t, uint32(3), tree.Version())
+	got, err := tree.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, got)
+
+	itree, err := tree.GetImmutable(3)
+	require.NoError(t, err)
+	requireState(t, itree, expected)
+	itree, err = tree.GetImmutable(2)
+	require.NoError(t, err)
+	requireState(t, itree, committed)
+}
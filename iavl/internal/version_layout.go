@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+const (
+	sizeVersion = 28
+	sizeOrphan  = 12
+)
+
+func init() {
+	// Verify the size of VersionLayout and OrphanLayout is what we expect it to be at runtime.
+	if unsafe.Sizeof(VersionLayout{}) != sizeVersion {
+		panic(fmt.Sprintf("invalid VersionLayout size: got %d, want %d", unsafe.Sizeof(VersionLayout{}), sizeVersion))
+	}
+	if unsafe.Sizeof(OrphanLayout{}) != sizeOrphan {
+		panic(fmt.Sprintf("invalid OrphanLayout size: got %d, want %d", unsafe.Sizeof(OrphanLayout{}), sizeOrphan))
+	}
+}
+
+// VersionLayout is the on-disk layout of a version entry in versions.dat.
+// A changeset contains one entry for every version from its start version to its end version,
+// so the entry of a version is found at index version - StartVersion.
+// NOTE: changes to this struct will affect on-disk compatibility.
+type VersionLayout struct {
+	// Version is the version of the tree this entry describes.
+	Version uint32
+
+	// FirstLeaf is the 0-based offset in leaves.dat of the first leaf created at this version.
+	FirstLeaf uint32
+
+	// LeafCount is the number of leaves created at this version that are stored in this changeset.
+	// In a compacted changeset this excludes the leaves that were pruned, so it may be smaller than
+	// the highest leaf index of the version.
+	LeafCount uint32
+
+	// FirstBranch is the 0-based offset in branches.dat of the first branch created at this version.
+	FirstBranch uint32
+
+	// BranchCount is the number of branches created at this version that are stored in this changeset.
+	BranchCount uint32
+
+	// Root is the NodeID of the root of the tree at this version, which may have been created at an
+	// earlier version if the version didn't change the tree.
+	// It is empty if the tree is empty at this version.
+	Root NodeID
+}
+
+// OrphanLayout is the on-disk layout of an orphan entry in orphans.dat.
+// Orphan entries are appended to the changeset that contains the orphaned node, which is not
+// necessarily the changeset of the version at which the node was orphaned.
+// NOTE: changes to this struct will affect on-disk compatibility.
+type OrphanLayout struct {
+	// ID is the NodeID of the orphaned node.
+	ID NodeID
+
+	// OrphanedAt is the version at which the node was orphaned, the node is last visible at
+	// version OrphanedAt - 1.
+	OrphanedAt uint32
+}
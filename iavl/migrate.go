@@ -0,0 +1,212 @@
+package iavl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	legacyiavl "github.com/cosmos/iavl"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/iavl/internal"
+)
+
+// MigrateOptions configures MigrateStore.
+type MigrateOptions struct {
+	Options
+
+	// HistoryVersions is the number of versions before the migrated version that are migrated
+	// along with it, they must still be available in the legacy tree.
+	HistoryVersions uint32
+
+	Logger log.Logger
+}
+
+// MigrateStore migrates a legacy IAVL tree up to the given version to the changeset tree in dir and
+// returns its root hash, which is verified against the legacy tree at every migrated version.
+//
+// The first version of the history window is imported node by node from an export of the legacy
+// tree, so that nodes keep the versions they were created at, then the state changes of the later
+// versions are replayed. Replaying gives the same tree as long as the writes of each version were
+// applied in key order, which is the case for the versions committed by the SDK since writes are
+// flushed from a cachekv store.
+//
+// The migration can be resumed: an interrupted import is started over, while the replay continues
+// from the last version saved to dir.
+func MigrateStore(ctx context.Context, legacy *legacyiavl.MutableTree, dir string, version int64, opts MigrateOptions) ([]byte, error) {
+	if version <= 0 || version > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid version to migrate: %d", version)
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+	if !legacy.VersionExists(version) {
+		return nil, fmt.Errorf("version %d doesn't exist in the legacy tree", version)
+	}
+
+	// the history window starts at the first available version if older ones have been pruned
+	start := max(version-int64(opts.HistoryVersions), 1)
+	start += int64(sort.Search(int(version-start), func(i int) bool {
+		return legacy.VersionExists(start + int64(i))
+	}))
+
+	treeOpts := internal.DefaultTreeOptions()
+	treeOpts.ChangesetMaxSize = opts.ChangesetMaxSize
+	treeOpts.CompactionOrphanRatio = opts.CompactionOrphanRatio
+	treeOpts.Logger = opts.Logger
+
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		if err := importVersion(ctx, legacy, dir, start, treeOpts.TreeStoreOptions, opts.Logger); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	tree, err := internal.OpenTree(dir, treeOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer tree.Close()
+
+	current := int64(tree.Version())
+	if current < start || current > version {
+		return nil, fmt.Errorf("%s holds version %d which is outside of the migrated versions [%d, %d], remove it to start over", dir, current, start, version)
+	}
+
+	hash, err := tree.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyHash(legacy, current, hash); err != nil {
+		return nil, fmt.Errorf("%w, remove %s to start over", err, dir)
+	}
+
+	if current < version {
+		opts.Logger.Info("replaying versions", "from", current+1, "to", version)
+		latest, err := legacy.GetImmutable(version)
+		if err != nil {
+			return nil, err
+		}
+		err = latest.TraverseStateChanges(current+1, version, func(v int64, changeSet *legacyiavl.ChangeSet) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if hash, err = replayVersion(tree, v, changeSet); err != nil {
+				return err
+			}
+			return verifyHash(legacy, v, hash)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if int64(tree.Version()) != version {
+		return nil, fmt.Errorf("migrated tree is at version %d, expected %d", tree.Version(), version)
+	}
+	return hash, nil
+}
+
+// importVersion imports the given version of the legacy tree into dir. The import is written to a
+// temporary directory which is renamed once complete, so that an interrupted import is started over.
+func importVersion(ctx context.Context, legacy *legacyiavl.MutableTree, dir string, version int64, opts internal.TreeStoreOptions, logger log.Logger) error {
+	tmpDir := dir + ".import"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	itree, err := legacy.GetImmutable(version)
+	if err != nil {
+		return err
+	}
+	exporter, err := itree.Export()
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	importer, err := internal.NewImporter(tmpDir, uint32(version), opts)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	logger.Info("importing version", "version", version, "keys", itree.Size())
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		node, err := exporter.Next()
+		if errors.Is(err, legacyiavl.ErrorExportDone) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		err = importer.Add(&internal.ImportNode{
+			Key:     node.Key,
+			Value:   node.Value,
+			Version: uint32(node.Version),
+			Height:  node.Height,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	hash, err := importer.Commit()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, itree.Hash()) {
+		return fmt.Errorf("imported root hash %X doesn't match the legacy root hash %X at version %d", hash, itree.Hash(), version)
+	}
+	return os.Rename(tmpDir, dir)
+}
+
+// replayVersion applies the state changes of a version to the tree and saves it.
+func replayVersion(tree *internal.Tree, version int64, changeSet *legacyiavl.ChangeSet) ([]byte, error) {
+	for _, pair := range changeSet.Pairs {
+		var err error
+		switch {
+		case pair.Delete:
+			_, err = tree.Remove(pair.Key)
+		case pair.Value == nil:
+			// empty values are decoded as nil
+			_, err = tree.Set(pair.Key, []byte{})
+		default:
+			_, err = tree.Set(pair.Key, pair.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, saved, err := tree.SaveVersion()
+	if err != nil {
+		return nil, err
+	}
+	if int64(saved) != version {
+		return nil, fmt.Errorf("replayed version %d was saved as version %d", version, saved)
+	}
+	return hash, nil
+}
+
+func verifyHash(legacy *legacyiavl.MutableTree, version int64, hash []byte) error {
+	itree, err := legacy.GetImmutable(version)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, itree.Hash()) {
+		return fmt.Errorf("root hash %X doesn't match the legacy root hash %X at version %d", hash, itree.Hash(), version)
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+// Package iavl implements a store/v2 commit store backed by the changeset storage engine of
+// iavl/internal.
+//
+// The tree is hash compatible with github.com/cosmos/iavl, but instead of storing every node in a
+// key-value database it appends the nodes created at each version to changeset files, and pruned
+// versions are reclaimed by compacting old changesets in the background, so that neither disk usage
+// of archive nodes nor pruning pauses grow with the history of the chain.
+//
+// The store is selected in app.toml with `iavl-backend = "changeset"`, see NewStoreLoader.
+package iavl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/iavl/internal"
+	"github.com/cosmos/cosmos-sdk/store/v2/cachekv"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+var (
+	_ types.KVStore                 = (*Store)(nil)
+	_ types.CommitStore             = (*Store)(nil)
+	_ types.CommitKVStore           = (*Store)(nil)
+	_ types.Queryable               = (*Store)(nil)
+	_ types.StoreWithInitialVersion = (*Store)(nil)
+)
+
+// Options configures the changeset storage of a Store.
+type Options struct {
+	// ChangesetMaxSize is the size in bytes of a changeset after which a new one is started.
+	ChangesetMaxSize uint64
+
+	// CompactionOrphanRatio is the ratio of pruned nodes in a changeset above which it is compacted.
+	CompactionOrphanRatio float64
+}
+
+// DefaultOptions returns the default Options.
+func DefaultOptions() Options {
+	opts := internal.DefaultTreeStoreOptions()
+	return Options{
+		ChangesetMaxSize:      opts.ChangesetMaxSize,
+		CompactionOrphanRatio: opts.CompactionOrphanRatio,
+	}
+}
+
+// NewStoreLoader returns a loader of the IAVL stores of a multistore, the tree of each store is
+// stored in its own directory named after the store key under dir.
+// The database of the store is not used, the multistore only keeps the commit info in it.
+func NewStoreLoader(dir string, logger log.Logger, opts Options) func(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+	return func(_ dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+		return LoadStore(filepath.Join(dir, key.Name()), logger.With("store", key.Name()), id, initialVersion, opts)
+	}
+}
+
+// Store implements types.CommitKVStore on top of a changeset tree.
+type Store struct {
+	tree   *internal.Tree
+	logger log.Logger
+}
+
+// LoadStore opens the tree stored in dir and loads the version of id, or the latest version if it
+// is zero.
+func LoadStore(dir string, logger log.Logger, id types.CommitID, initialVersion uint64, opts Options) (*Store, error) {
+	if initialVersion > math.MaxUint32 || id.Version < 0 || id.Version > math.MaxUint32 {
+		return nil, fmt.Errorf("version overflows the changeset format: initial version %d, version %d", initialVersion, id.Version)
+	}
+
+	treeOpts := internal.DefaultTreeOptions()
+	treeOpts.ChangesetMaxSize = opts.ChangesetMaxSize
+	treeOpts.CompactionOrphanRatio = opts.CompactionOrphanRatio
+	treeOpts.InitialVersion = uint32(initialVersion)
+	treeOpts.Logger = logger
+
+	tree, err := internal.OpenTree(dir, treeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tree may be ahead of the commit info if the node stopped between saving the
+	// tree and writing the commit info, the later versions are discarded in that case
+	if id.Version != 0 {
+		if err := tree.LoadVersionForOverwriting(uint32(id.Version)); err != nil {
+			return nil, errors.Join(err, tree.Close())
+		}
+	}
+
+	logger.Debug("loaded changeset IAVL tree", "version", tree.Version())
+	return &Store{
+		tree:   tree,
+		logger: logger,
+	}, nil
+}
+
+// Commit commits the current store state and returns a CommitID with the new version and hash.
+func (st *Store) Commit() types.CommitID {
+	hash, version, err := st.tree.SaveVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	return types.CommitID{
+		Version: int64(version),
+		Hash:    hash,
+	}
+}
+
+// WorkingHash returns the hash of the current working tree.
+func (st *Store) WorkingHash() []byte {
+	hash, err := st.tree.WorkingHash()
+	if err != nil {
+		panic(err)
+	}
+	return hash
+}
+
+// LastCommitID implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	hash, err := st.tree.Hash()
+	if err != nil {
+		panic(err)
+	}
+
+	return types.CommitID{
+		Version: int64(st.tree.Version()),
+		Hash:    hash,
+	}
+}
+
+// SetPruning panics as pruning is driven by the multistore through DeleteVersionsTo.
+func (st *Store) SetPruning(_ pruningtypes.PruningOptions) {
+	panic("cannot set pruning options on an initialized IAVL store")
+}
+
+// GetPruning panics as pruning is driven by the multistore through DeleteVersionsTo.
+func (st *Store) GetPruning() pruningtypes.PruningOptions {
+	panic("cannot get pruning options on an initialized IAVL store")
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	return version > 0 && version <= math.MaxUint32 && st.tree.VersionExists(uint32(version))
+}
+
+// GetStoreType implements Store, returns StoreTypeIAVL.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements Store, returns a cachewrap around the store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// Set implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	if _, err := st.tree.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// Get implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	value, err := st.tree.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	has, err := st.tree.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// Delete implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	if _, err := st.tree.Remove(key); err != nil {
+		panic(err)
+	}
+}
+
+// Iterator implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.tree.Iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.tree.Iterator(start, end, false)
+}
+
+// SetInitialVersion sets the initial version of the tree. It is used when starting a new chain at
+// an arbitrary height.
+func (st *Store) SetInitialVersion(version int64) {
+	if version < 0 || version > math.MaxUint32 {
+		panic(fmt.Sprintf("initial version %d overflows the changeset format", version))
+	}
+	st.tree.SetInitialVersion(uint32(version))
+}
+
+// GetImmutableKVStore returns a read-only store of the tree at the given version.
+func (st *Store) GetImmutableKVStore(version int64) (types.KVStore, error) {
+	if !st.VersionExists(version) {
+		return nil, errors.New("version mismatch on immutable IAVL tree; version does not exist. Version has either been pruned, or is for a future block height")
+	}
+
+	tree, err := st.tree.GetImmutable(uint32(version))
+	if err != nil {
+		return nil, err
+	}
+	return &immutableStore{tree: tree}, nil
+}
+
+// DeleteVersionsTo prunes the versions up to and including the given version, the disk space is
+// reclaimed by a background compaction of the changesets.
+func (st *Store) DeleteVersionsTo(version int64) error {
+	if version <= 0 || version > math.MaxUint32 {
+		return fmt.Errorf("invalid version to prune: %d", version)
+	}
+	return st.tree.DeleteVersionsTo(uint32(version))
+}
+
+// LoadVersionForOverwriting loads the tree at a previously committed version and deletes the
+// versions greater than targetVersion.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) error {
+	if targetVersion <= 0 || targetVersion > math.MaxUint32 {
+		return fmt.Errorf("invalid version to load: %d", targetVersion)
+	}
+	return st.tree.LoadVersionForOverwriting(uint32(targetVersion))
+}
+
+// Close closes the tree, waiting for a running compaction to complete.
+func (st *Store) Close() error {
+	return st.tree.Close()
+}
+
+// Query implements types.Queryable. Only the "/key" path is supported and proofs are not
+// supported yet, they require an ICS23 proof spec for the changeset tree.
+//
+// By default the query is served from the latest height - 1 to match the height of the header
+// that commits to it, like the legacy IAVL store.
+func (st *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
+	if len(req.Data) == 0 {
+		return &types.ResponseQuery{}, errorsmod.Wrap(types.ErrTxDecode, "query cannot be zero length")
+	}
+	if req.Prove {
+		return &types.ResponseQuery{}, errorsmod.Wrap(types.ErrInvalidRequest, "proofs are not supported by the changeset IAVL store")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = int64(st.tree.Version())
+		if st.VersionExists(height - 1) {
+			height--
+		}
+	}
+
+	switch req.Path {
+	case "/key":
+		res := &types.ResponseQuery{
+			Key:    req.Data,
+			Height: height,
+		}
+		if !st.VersionExists(height) {
+			res.Log = fmt.Sprintf("version %d does not exist", height)
+			return res, nil
+		}
+
+		tree, err := st.tree.GetImmutable(uint32(height))
+		if err != nil {
+			return &types.ResponseQuery{}, err
+		}
+		res.Value, err = tree.Get(req.Data)
+		return res, err
+
+	default:
+		return &types.ResponseQuery{}, errorsmod.Wrapf(types.ErrUnknownRequest, "unexpected query path: %v", req.Path)
+	}
+}
+
+// immutableStore is a read-only types.KVStore of the tree at a saved version.
+type immutableStore struct {
+	tree *internal.ImmutableTree
+}
+
+var _ types.KVStore = (*immutableStore)(nil)
+
+// GetStoreType implements Store, returns StoreTypeIAVL.
+func (st *immutableStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements Store, returns a cachewrap around the store.
+func (st *immutableStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// Get implements types.KVStore.
+func (st *immutableStore) Get(key []byte) []byte {
+	value, err := st.tree.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements types.KVStore.
+func (st *immutableStore) Has(key []byte) bool {
+	has, err := st.tree.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// Set panics, the store is read-only.
+func (st *immutableStore) Set(_, _ []byte) {
+	panic("cannot call 'Set' on an immutable IAVL tree")
+}
+
+// Delete panics, the store is read-only.
+func (st *immutableStore) Delete(_ []byte) {
+	panic("cannot call 'Delete' on an immutable IAVL tree")
+}
+
+// Iterator implements types.KVStore.
+func (st *immutableStore) Iterator(start, end []byte) types.Iterator {
+	return st.tree.Iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *immutableStore) ReverseIterator(start, end []byte) types.Iterator {
+	return st.tree.Iterator(start, end, false)
+}
@@ -0,0 +1,225 @@
+// Package sqlite implements the sqlite type of the built-in state indexer, which writes the
+// state objects of the modules to a SQLite database with a table per object type.
+//
+// The package only uses database/sql, the binary enabling the indexer must also register a
+// SQLite driver, e.g. by importing github.com/mattn/go-sqlite3 (driver "sqlite3", the default)
+// or modernc.org/sqlite (driver "sqlite").
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/logutil"
+)
+
+const (
+	// IndexerType is the type of the indexer in the indexer target configuration.
+	IndexerType = "sqlite"
+
+	// DefaultDriver is the database/sql driver name used if none is configured.
+	DefaultDriver = "sqlite3"
+)
+
+func init() {
+	indexer.Register(IndexerType, indexer.Initializer{
+		InitFunc:   initIndexer,
+		ConfigType: Config{},
+	})
+}
+
+// Config is the configuration of a sqlite indexer target.
+type Config struct {
+	// Path is the path of the database file, it is created if missing.
+	Path string `json:"path"`
+	// Driver is the name of the registered database/sql driver, DefaultDriver if empty.
+	Driver string `json:"driver,omitempty"`
+}
+
+func initIndexer(params indexer.InitParams) (indexer.InitResult, error) {
+	cfg, ok := params.Config.Config.(Config)
+	if !ok {
+		return indexer.InitResult{}, fmt.Errorf("unexpected config type %T", params.Config.Config)
+	}
+	if cfg.Path == "" {
+		return indexer.InitResult{}, errors.New("sqlite indexer: missing path")
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DefaultDriver
+	}
+
+	db, err := sql.Open(cfg.Driver, cfg.Path)
+	if err != nil {
+		return indexer.InitResult{}, fmt.Errorf("sqlite indexer: failed to open %s: %w", cfg.Path, err)
+	}
+
+	logger := params.Logger
+	if logger == nil {
+		logger = logutil.NoopLogger{}
+	}
+	idx, err := newIndexer(db, params.AddressCodec, logger)
+	if err != nil {
+		_ = db.Close()
+		return indexer.InitResult{}, err
+	}
+
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	go func() {
+		<-ctx.Done()
+		_ = db.Close()
+	}()
+
+	return indexer.InitResult{Listener: idx.listener()}, nil
+}
+
+// sqlIndexer writes the updates of a block in a transaction committed with the block. Blocks at
+// or below the last indexed height, replayed after a restart, are skipped.
+type sqlIndexer struct {
+	db           *sql.DB
+	addressCodec addressutil.AddressCodec
+	logger       logutil.Logger
+
+	tables     map[string]map[string]*table
+	lastHeight uint64
+
+	height uint64
+	tx     *sql.Tx
+	skip   bool
+}
+
+func newIndexer(db *sql.DB, addressCodec addressutil.AddressCodec, logger logutil.Logger) (*sqlIndexer, error) {
+	// SQLite has a single writer, a connection held by the block transaction would block any other.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(createStateTableSQL); err != nil {
+		return nil, fmt.Errorf("sqlite indexer: failed to create the state table: %w", err)
+	}
+
+	var lastHeight sql.NullInt64
+	if err := db.QueryRow(selectHeightSQL).Scan(&lastHeight); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("sqlite indexer: failed to read the last indexed height: %w", err)
+	}
+
+	logger.Info("Opened sqlite index", "last_height", lastHeight.Int64)
+
+	return &sqlIndexer{
+		db:           db,
+		addressCodec: addressCodec,
+		logger:       logger,
+		tables:       make(map[string]map[string]*table),
+		lastHeight:   uint64(lastHeight.Int64),
+	}, nil
+}
+
+func (i *sqlIndexer) listener() appdata.Listener {
+	return appdata.Listener{
+		InitializeModuleData: i.initializeModuleData,
+		StartBlock:           i.startBlock,
+		OnObjectUpdate:       i.onObjectUpdate,
+		Commit:               i.commit,
+	}
+}
+
+func (i *sqlIndexer) exec(query string, args ...any) error {
+	var err error
+	if i.tx != nil {
+		_, err = i.tx.Exec(query, args...)
+	} else {
+		_, err = i.db.Exec(query, args...)
+	}
+	return err
+}
+
+func (i *sqlIndexer) initializeModuleData(data appdata.ModuleInitializationData) error {
+	tables := make(map[string]*table)
+	var err error
+	data.Schema.StateObjectTypes(func(objType schema.StateObjectType) bool {
+		t := newTable(data.ModuleName, objType, i.addressCodec)
+		if err = i.exec(t.createSQL()); err != nil {
+			err = fmt.Errorf("sqlite indexer: failed to create table %s: %w", t.name, err)
+			return false
+		}
+		tables[objType.Name] = t
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	i.tables[data.ModuleName] = tables
+	i.logger.Info("Indexing module", "module", data.ModuleName, "tables", len(tables))
+	return nil
+}
+
+func (i *sqlIndexer) startBlock(data appdata.StartBlockData) error {
+	if i.tx != nil {
+		return fmt.Errorf("sqlite indexer: block %d started before the previous block was committed", data.Height)
+	}
+
+	i.height = data.Height
+	i.skip = data.Height <= i.lastHeight
+	if i.skip {
+		i.logger.Debug("Skipping indexed block", "height", data.Height)
+		return nil
+	}
+
+	tx, err := i.db.Begin()
+	if err != nil {
+		return fmt.Errorf("sqlite indexer: failed to begin block %d: %w", data.Height, err)
+	}
+	i.tx = tx
+
+	return i.exec(upsertHeightSQL, int64(data.Height))
+}
+
+func (i *sqlIndexer) onObjectUpdate(data appdata.ObjectUpdateData) error {
+	if i.skip {
+		return nil
+	}
+
+	tables, ok := i.tables[data.ModuleName]
+	if !ok {
+		return fmt.Errorf("sqlite indexer: module %s not initialized", data.ModuleName)
+	}
+
+	for _, update := range data.Updates {
+		t, ok := tables[update.TypeName]
+		if !ok {
+			return fmt.Errorf("sqlite indexer: unknown object type %s of module %s", update.TypeName, data.ModuleName)
+		}
+
+		query, args, err := t.updateSQL(update)
+		if err != nil {
+			return fmt.Errorf("sqlite indexer: %s: %w", t.name, err)
+		}
+		if err := i.exec(query, args...); err != nil {
+			return fmt.Errorf("sqlite indexer: failed to update %s: %w", t.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (i *sqlIndexer) commit(appdata.CommitData) (func() error, error) {
+	if i.skip || i.tx == nil {
+		return nil, nil
+	}
+
+	tx := i.tx
+	i.tx = nil
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("sqlite indexer: failed to commit block %d: %w", i.height, err)
+	}
+	i.lastHeight = i.height
+
+	return nil, nil
+}
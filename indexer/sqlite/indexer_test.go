@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+func startIndexer(t *testing.T, path string) appdata.Listener {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	res, err := initIndexer(indexer.InitParams{
+		Config:  indexer.Config{Type: IndexerType, Config: Config{Path: path}},
+		Context: ctx,
+	})
+	require.NoError(t, err)
+	return res.Listener
+}
+
+type balanceRow struct {
+	address, denom, amount string
+}
+
+func readBalances(t *testing.T, path string) []balanceRow {
+	t.Helper()
+	db, err := sql.Open(DefaultDriver, path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT "address", "denom", "amount" FROM "bank_balance" ORDER BY "address", "denom"`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var balances []balanceRow
+	for rows.Next() {
+		var row balanceRow
+		require.NoError(t, rows.Scan(&row.address, &row.denom, &row.amount))
+		balances = append(balances, row)
+	}
+	require.NoError(t, rows.Err())
+	return balances
+}
+
+func TestIndexer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.sqlite")
+	listener := startIndexer(t, path)
+
+	initBank := appdata.ModuleInitializationData{
+		ModuleName: "bank",
+		Schema:     schema.MustCompileModuleSchema(balanceType),
+	}
+	require.NoError(t, listener.InitializeModuleData(initBank))
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 1}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "balance", Key: []any{[]byte{1}, "stake"}, Value: "10"},
+			{TypeName: "balance", Key: []any{[]byte{2}, "stake"}, Value: "20"},
+		},
+	}))
+	_, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	require.Equal(t, []balanceRow{{"01", "stake", "10"}, {"02", "stake", "20"}}, readBalances(t, path))
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates: []schema.StateObjectUpdate{
+			{TypeName: "balance", Key: []any{[]byte{1}, "stake"}, Value: "15"},
+			{TypeName: "balance", Key: []any{[]byte{2}, "stake"}, Delete: true},
+		},
+	}))
+	_, err = listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	require.Equal(t, []balanceRow{{"01", "stake", "15"}}, readBalances(t, path))
+
+	err = listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "gov"})
+	require.ErrorContains(t, err, "module gov not initialized")
+
+	// a restarted indexer skips the blocks already indexed
+	listener = startIndexer(t, path)
+	require.NoError(t, listener.InitializeModuleData(initBank))
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates:    []schema.StateObjectUpdate{{TypeName: "balance", Key: []any{[]byte{1}, "stake"}, Value: "99"}},
+	}))
+	_, err = listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	require.Equal(t, []balanceRow{{"01", "stake", "15"}}, readBalances(t, path))
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 3}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "bank",
+		Updates:    []schema.StateObjectUpdate{{TypeName: "balance", Key: []any{[]byte{3}, "atom"}, Value: "1"}},
+	}))
+	_, err = listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	require.Equal(t, []balanceRow{{"01", "stake", "15"}, {"03", "atom", "1"}}, readBalances(t, path))
+}
+
+func TestInitIndexerConfig(t *testing.T) {
+	_, err := initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: Config{}}})
+	require.ErrorContains(t, err, "missing path")
+
+	_, err = initIndexer(indexer.InitParams{Config: indexer.Config{Type: IndexerType, Config: Config{Path: "test", Driver: "unknown"}}})
+	require.ErrorContains(t, err, "unknown driver")
+}
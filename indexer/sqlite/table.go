@@ -0,0 +1,263 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+const (
+	createStateTableSQL = `CREATE TABLE IF NOT EXISTS "_indexer_state" ("id" INTEGER PRIMARY KEY CHECK ("id" = 1), "height" INTEGER NOT NULL)`
+	selectHeightSQL     = `SELECT "height" FROM "_indexer_state" WHERE "id" = 1`
+	upsertHeightSQL     = `INSERT INTO "_indexer_state" ("id", "height") VALUES (1, ?) ON CONFLICT ("id") DO UPDATE SET "height" = excluded."height"`
+
+	// singletonColumn is the primary key of the tables of the object types without key fields.
+	singletonColumn = "_id"
+	// deletedColumn flags the deleted rows of the object types retaining deletions.
+	deletedColumn = "_deleted"
+)
+
+// table is the table of a state object type, named after the module and the object type.
+type table struct {
+	name         string
+	objType      schema.StateObjectType
+	addressCodec addressutil.AddressCodec
+}
+
+func newTable(moduleName string, objType schema.StateObjectType, addressCodec addressutil.AddressCodec) *table {
+	return &table{
+		name:         moduleName + "_" + objType.Name,
+		objType:      objType,
+		addressCodec: addressCodec,
+	}
+}
+
+func (t *table) createSQL() string {
+	var cols, keys []string
+	if len(t.objType.KeyFields) == 0 {
+		cols = append(cols, fmt.Sprintf(`%s INTEGER NOT NULL CHECK (%s = 1)`, quote(singletonColumn), quote(singletonColumn)))
+		keys = append(keys, quote(singletonColumn))
+	}
+	for _, field := range t.objType.KeyFields {
+		cols = append(cols, columnSQL(field))
+		keys = append(keys, quote(field.Name))
+	}
+	for _, field := range t.objType.ValueFields {
+		cols = append(cols, columnSQL(field))
+	}
+	if t.objType.RetainDeletions {
+		cols = append(cols, fmt.Sprintf(`%s INTEGER NOT NULL DEFAULT 0`, quote(deletedColumn)))
+	}
+	cols = append(cols, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keys, ", ")))
+
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quote(t.name), strings.Join(cols, ", "))
+}
+
+// updateSQL returns the statement applying an update of the object type with its arguments.
+func (t *table) updateSQL(update schema.StateObjectUpdate) (string, []any, error) {
+	keyCols, keyArgs, err := t.keyColumns(update.Key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if update.Delete {
+		where := make([]string, len(keyCols))
+		for i, col := range keyCols {
+			where[i] = quote(col) + " = ?"
+		}
+		if t.objType.RetainDeletions {
+			return fmt.Sprintf("UPDATE %s SET %s = 1 WHERE %s", quote(t.name), quote(deletedColumn), strings.Join(where, " AND ")), keyArgs, nil
+		}
+		return fmt.Sprintf("DELETE FROM %s WHERE %s", quote(t.name), strings.Join(where, " AND ")), keyArgs, nil
+	}
+
+	valueCols, valueArgs, err := t.valueColumns(update.Value)
+	if err != nil {
+		return "", nil, err
+	}
+	if t.objType.RetainDeletions {
+		valueCols = append(valueCols, deletedColumn)
+		valueArgs = append(valueArgs, 0)
+	}
+
+	cols := append(keyCols, valueCols...)
+	quoted := make([]string, len(cols))
+	placeholders := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = quote(col)
+		placeholders[i] = "?"
+	}
+
+	conflict := "DO NOTHING"
+	if len(valueCols) > 0 {
+		set := make([]string, len(valueCols))
+		for i, col := range valueCols {
+			set[i] = fmt.Sprintf("%s = excluded.%s", quote(col), quote(col))
+		}
+		conflict = "DO UPDATE SET " + strings.Join(set, ", ")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s",
+		quote(t.name), strings.Join(quoted, ", "), strings.Join(placeholders, ", "),
+		strings.Join(quoted[:len(keyCols)], ", "), conflict)
+	return query, append(keyArgs, valueArgs...), nil
+}
+
+func (t *table) keyColumns(key any) ([]string, []any, error) {
+	fields := t.objType.KeyFields
+	if len(fields) == 0 {
+		return []string{singletonColumn}, []any{1}, nil
+	}
+
+	values, err := fieldValues(fields, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key: %w", err)
+	}
+	return t.bindFields(fields, values)
+}
+
+func (t *table) valueColumns(value any) ([]string, []any, error) {
+	fields := t.objType.ValueFields
+	if len(fields) == 0 {
+		return nil, nil, nil
+	}
+
+	if updates, ok := value.(schema.ValueUpdates); ok {
+		byName := make(map[string]schema.Field, len(fields))
+		for _, field := range fields {
+			byName[field.Name] = field
+		}
+
+		var (
+			cols []string
+			args []any
+			err  error
+		)
+		iterErr := updates.Iterate(func(col string, v any) bool {
+			field, ok := byName[col]
+			if !ok {
+				err = fmt.Errorf("unknown value field %s", col)
+				return false
+			}
+			var arg any
+			if arg, err = t.bind(field, v); err != nil {
+				return false
+			}
+			cols = append(cols, col)
+			args = append(args, arg)
+			return true
+		})
+		if iterErr != nil {
+			return nil, nil, iterErr
+		}
+		return cols, args, err
+	}
+
+	values, err := fieldValues(fields, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid value: %w", err)
+	}
+	return t.bindFields(fields, values)
+}
+
+// fieldValues returns the values of the fields of a key or value, which is the value itself for a
+// single field and a slice of the values otherwise.
+func fieldValues(fields []schema.Field, value any) ([]any, error) {
+	if len(fields) == 1 {
+		return []any{value}, nil
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected %d values, got %T", len(fields), value)
+	}
+	if len(values) != len(fields) {
+		return nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+	return values, nil
+}
+
+func (t *table) bindFields(fields []schema.Field, values []any) ([]string, []any, error) {
+	cols := make([]string, len(fields))
+	args := make([]any, len(fields))
+	for i, field := range fields {
+		arg, err := t.bind(field, values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		cols[i] = field.Name
+		args[i] = arg
+	}
+	return cols, args, nil
+}
+
+// bind converts the value of a field to the database/sql argument stored in its column.
+func (t *table) bind(field schema.Field, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		// values out of the range of the SQLite integers are stored as text
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10), nil
+		}
+		return int64(v), nil
+	case time.Time:
+		return v.UnixNano(), nil
+	case time.Duration:
+		return int64(v), nil
+	case json.RawMessage:
+		return string(v), nil
+	case []byte:
+		if field.Kind != schema.AddressKind {
+			return v, nil
+		}
+		if t.addressCodec == nil {
+			return fmt.Sprintf("%X", v), nil
+		}
+		addr, err := t.addressCodec.BytesToString(v)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		return addr, nil
+	default:
+		return value, nil
+	}
+}
+
+func columnSQL(field schema.Field) string {
+	col := quote(field.Name) + " " + columnType(field.Kind)
+	if !field.Nullable {
+		col += " NOT NULL"
+	}
+	return col
+}
+
+// columnType returns the SQLite type of the column of a field kind. Times and durations are
+// stored in nanoseconds, addresses with the address codec of the chain.
+func columnType(kind schema.Kind) string {
+	switch kind {
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind,
+		schema.Uint32Kind, schema.Int64Kind, schema.Uint64Kind, schema.BoolKind, schema.TimeKind,
+		schema.DurationKind:
+		return "INTEGER"
+	case schema.Float32Kind, schema.Float64Kind:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
+
+func quote(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
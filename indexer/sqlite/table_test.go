@@ -0,0 +1,136 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+)
+
+var balanceType = schema.StateObjectType{
+	Name: "balance",
+	KeyFields: []schema.Field{
+		{Name: "address", Kind: schema.AddressKind},
+		{Name: "denom", Kind: schema.StringKind},
+	},
+	ValueFields: []schema.Field{
+		{Name: "amount", Kind: schema.IntegerKind},
+	},
+}
+
+func TestCreateSQL(t *testing.T) {
+	require.Equal(t,
+		`CREATE TABLE IF NOT EXISTS "bank_balance" ("address" TEXT NOT NULL, "denom" TEXT NOT NULL, "amount" TEXT NOT NULL, PRIMARY KEY ("address", "denom"))`,
+		newTable("bank", balanceType, nil).createSQL())
+
+	params := schema.StateObjectType{
+		Name: "params",
+		ValueFields: []schema.Field{
+			{Name: "max_entries", Kind: schema.Uint32Kind},
+			{Name: "unbonding_time", Kind: schema.DurationKind, Nullable: true},
+		},
+		RetainDeletions: true,
+	}
+	require.Equal(t,
+		`CREATE TABLE IF NOT EXISTS "staking_params" ("_id" INTEGER NOT NULL CHECK ("_id" = 1), "max_entries" INTEGER NOT NULL, "unbonding_time" INTEGER, "_deleted" INTEGER NOT NULL DEFAULT 0, PRIMARY KEY ("_id"))`,
+		newTable("staking", params, nil).createSQL())
+}
+
+func TestUpdateSQL(t *testing.T) {
+	tbl := newTable("bank", balanceType, nil)
+
+	query, args, err := tbl.updateSQL(schema.StateObjectUpdate{
+		TypeName: "balance",
+		Key:      []any{[]byte{0xab}, "stake"},
+		Value:    "10",
+	})
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "bank_balance" ("address", "denom", "amount") VALUES (?, ?, ?) ON CONFLICT ("address", "denom") DO UPDATE SET "amount" = excluded."amount"`, query)
+	require.Equal(t, []any{"AB", "stake", "10"}, args)
+
+	query, args, err = tbl.updateSQL(schema.StateObjectUpdate{
+		TypeName: "balance",
+		Key:      []any{[]byte{0xab}, "stake"},
+		Delete:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "bank_balance" WHERE "address" = ? AND "denom" = ?`, query)
+	require.Equal(t, []any{"AB", "stake"}, args)
+
+	_, _, err = tbl.updateSQL(schema.StateObjectUpdate{TypeName: "balance", Key: "stake", Value: "10"})
+	require.ErrorContains(t, err, "invalid key")
+
+	retained := newTable("gov", schema.StateObjectType{
+		Name:      "proposal",
+		KeyFields: []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+		ValueFields: []schema.Field{
+			{Name: "title", Kind: schema.StringKind},
+			{Name: "metadata", Kind: schema.JSONKind},
+		},
+		RetainDeletions: true,
+	}, nil)
+
+	query, args, err = retained.updateSQL(schema.StateObjectUpdate{
+		TypeName: "proposal",
+		Key:      uint64(1),
+		Value:    schema.MapValueUpdates{"title": "upgrade"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "gov_proposal" ("id", "title", "_deleted") VALUES (?, ?, ?) ON CONFLICT ("id") DO UPDATE SET "title" = excluded."title", "_deleted" = excluded."_deleted"`, query)
+	require.Equal(t, []any{int64(1), "upgrade", 0}, args)
+
+	query, args, err = retained.updateSQL(schema.StateObjectUpdate{TypeName: "proposal", Key: uint64(1), Delete: true})
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "gov_proposal" SET "_deleted" = 1 WHERE "id" = ?`, query)
+	require.Equal(t, []any{int64(1)}, args)
+
+	_, _, err = retained.updateSQL(schema.StateObjectUpdate{
+		TypeName: "proposal",
+		Key:      uint64(1),
+		Value:    schema.MapValueUpdates{"summary": "upgrade"},
+	})
+	require.ErrorContains(t, err, "unknown value field summary")
+
+	set := newTable("feegrant", schema.StateObjectType{
+		Name:      "allowed",
+		KeyFields: []schema.Field{{Name: "granter", Kind: schema.StringKind}},
+	}, nil)
+	query, _, err = set.updateSQL(schema.StateObjectUpdate{TypeName: "allowed", Key: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "feegrant_allowed" ("granter") VALUES (?) ON CONFLICT ("granter") DO NOTHING`, query)
+}
+
+type prefixCodec struct{}
+
+func (prefixCodec) StringToBytes(string) ([]byte, error) { return nil, nil }
+
+func (prefixCodec) BytesToString(bz []byte) (string, error) { return "cosmos" + string(bz), nil }
+
+func TestBind(t *testing.T) {
+	tbl := newTable("test", schema.StateObjectType{Name: "test"}, prefixCodec{})
+	now := time.Unix(10, 5)
+
+	for _, tc := range []struct {
+		kind     schema.Kind
+		value    any
+		expected any
+	}{
+		{schema.AddressKind, []byte("1abc"), "cosmos1abc"},
+		{schema.BytesKind, []byte("1abc"), []byte("1abc")},
+		{schema.Uint64Kind, uint64(5), int64(5)},
+		{schema.Uint64Kind, uint64(math.MaxUint64), "18446744073709551615"},
+		{schema.TimeKind, now, now.UnixNano()},
+		{schema.DurationKind, time.Second, int64(time.Second)},
+		{schema.JSONKind, json.RawMessage(`{"a":1}`), `{"a":1}`},
+		{schema.StringKind, nil, nil},
+		{schema.BoolKind, true, true},
+	} {
+		arg, err := tbl.bind(schema.Field{Name: "f", Kind: tc.kind}, tc.value)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, arg, tc.kind.String())
+	}
+}
@@ -72,6 +72,9 @@ func (e *Executor) Run() error {
 func (e *Executor) TryExecute(version TxnVersion) (TxnVersion, TaskKind) {
 	start := instNow()
 	e.scheduler.executedTxns.Add(1)
+	if e.scheduler.report != nil {
+		e.scheduler.report.recordIncarnation(version.Index)
+	}
 	view := e.execute(version.Index)
 
 	// Track read and write counts
@@ -3,6 +3,7 @@ package blockstm
 import (
 	"bytes"
 	"context"
+	"slices"
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel/metric"
@@ -21,6 +22,9 @@ type dataEntry[V any] struct {
 
 	// mark all the writes in this txn as ESTIMATE
 	Estimate bool
+	// EstimatePrefixes marks every key under them as ESTIMATE too, they are only set by the
+	// pre-estimation and dropped with the entry once the txn is executed.
+	EstimatePrefixes []Key
 }
 
 type MVData = GMVData[[]byte]
@@ -42,6 +46,33 @@ type GMVData[V any] struct {
 	index tree2.BTree[indexEntry]
 	// txn -> (incarnation, estimate, key -> value)
 	data []atomic.Pointer[dataEntry[V]]
+	// prefix estimates indexed by prefix, it's not modified after the initialization.
+	prefixes prefixIndex
+}
+
+// prefixIndex indexes the prefix estimates, so that a read only looks up the prefixes of its key
+// instead of scanning all the declared prefixes.
+type prefixIndex struct {
+	// prefix -> txns declaring it, in ascending order
+	txns map[string][]TxnIndex
+	// distinct lengths of the prefixes, in ascending order
+	lengths []int
+}
+
+// add indexes the prefix declared by txn, txns must be added in ascending order.
+func (p *prefixIndex) add(prefix Key, txn TxnIndex) {
+	if p.txns == nil {
+		p.txns = make(map[string][]TxnIndex)
+	}
+	txns := p.txns[string(prefix)]
+	if len(txns) > 0 && txns[len(txns)-1] == txn {
+		return
+	}
+	p.txns[string(prefix)] = append(txns, txn)
+
+	if i, found := slices.BinarySearch(p.lengths, len(prefix)); !found {
+		p.lengths = slices.Insert(p.lengths, i, len(prefix))
+	}
 }
 
 func NewMVStore(key storetypes.StoreKey, blockSize int) MVStore {
@@ -181,6 +212,55 @@ func (d *GMVData[V]) InitWithEstimates(ctx context.Context, txn TxnIndex, estima
 	}
 }
 
+// InitWithPrefixEstimates marks every key starting with one of the prefixes as ESTIMATE for the
+// txns lower than txn, until txn is executed. It must be called before the execution, with
+// ascending txns.
+func (d *GMVData[V]) InitWithPrefixEstimates(_ context.Context, txn TxnIndex, prefixes Locations) {
+	if len(prefixes) == 0 {
+		return
+	}
+	entry := d.data[txn].Load()
+	if entry == nil {
+		entry = &dataEntry[V]{WriteSet: NewWriteSet(d.isZero, d.valueLen)}
+	} else {
+		entry = &dataEntry[V]{WriteSet: entry.WriteSet}
+	}
+	entry.Estimate = true
+	entry.EstimatePrefixes = prefixes
+	d.data[txn].Store(entry)
+	for _, prefix := range prefixes {
+		d.prefixes.add(prefix, txn)
+	}
+}
+
+// prefixEstimate returns the closest txn lower than txn which is not executed yet and has
+// a prefix estimate covering the key.
+func (d *GMVData[V]) prefixEstimate(key Key, txn TxnIndex) (TxnIndex, bool) {
+	var (
+		closest TxnIndex
+		found   bool
+	)
+	for _, length := range d.prefixes.lengths {
+		if length > len(key) {
+			break
+		}
+		txns := d.prefixes.txns[string(key[:length])]
+		i, _ := slices.BinarySearch(txns, txn)
+		for i--; i >= 0; i-- {
+			estimated := txns[i]
+			if found && estimated <= closest {
+				break
+			}
+			// the prefix estimates are dropped once the txn is executed
+			if entry := d.data[estimated].Load(); entry != nil && entry.Estimate && len(entry.EstimatePrefixes) > 0 {
+				closest, found = estimated, true
+				break
+			}
+		}
+	}
+	return closest, found
+}
+
 // setIndex adds txn to the key's bitmap index.
 func (d *GMVData[V]) setIndex(ctx context.Context, key Key, txn TxnIndex) {
 	idx := d.getIndexOrDefault(ctx, key)
@@ -207,12 +287,21 @@ func (d *GMVData[V]) Read(ctx context.Context, key Key, txn TxnIndex) (V, TxnVer
 		return zero, InvalidTxnVersion, false
 	}
 
+	estimated, hasPrefixEstimate := d.prefixEstimate(key, txn)
 	store := d.getIndex(ctx, key)
 	if store == nil {
+		if hasPrefixEstimate {
+			return zero, TxnVersion{Index: estimated, Incarnation: 0}, true
+		}
 		return zero, InvalidTxnVersion, false
 	}
 
-	return d.resolveValue(key, txn, store)
+	value, version, estimate := d.resolveValue(key, txn, store)
+	if hasPrefixEstimate && version.Index < estimated {
+		// the prefix estimate is closer than the latest write
+		return zero, TxnVersion{Index: estimated, Incarnation: 0}, true
+	}
+	return value, version, estimate
 }
 
 func (d *GMVData[V]) resolveValue(key Key, txn TxnIndex, store *BitmapIndex) (V, TxnVersion, bool) {
@@ -261,16 +350,23 @@ func (d *GMVData[V]) Iterator(
 // ValidateReadSet validates the read descriptors,
 // returns true if valid.
 func (d *GMVData[V]) ValidateReadSet(ctx context.Context, txn TxnIndex, rs *ReadSet, storage Storage) bool {
+	_, _, conflict := d.ConflictingRead(ctx, txn, rs, storage)
+	return !conflict
+}
+
+// ConflictingRead validates the read descriptors like `ValidateReadSet`, and returns the first
+// read that's no longer valid, for iterators the key is the start of the iterated range.
+func (d *GMVData[V]) ConflictingRead(ctx context.Context, txn TxnIndex, rs *ReadSet, storage Storage) (ConflictKind, Key, bool) {
 	for _, desc := range rs.Reads {
 		_, version, estimate := d.Read(ctx, desc.Key, txn)
 		if estimate {
 			// previously read entry from data, now ESTIMATE
-			return false
+			return ConflictKindRead, desc.Key, true
 		}
 		if version != desc.Version {
 			// previously read entry from data, now NOT_FOUND,
 			// or read some entry, but not the same version as before
-			return false
+			return ConflictKindRead, desc.Key, true
 		}
 	}
 
@@ -279,11 +375,11 @@ func (d *GMVData[V]) ValidateReadSet(ctx context.Context, txn TxnIndex, rs *Read
 		for _, desc := range rs.HasReads {
 			value, version, estimate := d.Read(ctx, desc.Key, txn)
 			if estimate {
-				return false
+				return ConflictKindHas, desc.Key, true
 			}
 			if version.Valid() {
 				if (!d.isZero(value)) != desc.Exists {
-					return false
+					return ConflictKindHas, desc.Key, true
 				}
 				continue
 			}
@@ -293,18 +389,18 @@ func (d *GMVData[V]) ValidateReadSet(ctx context.Context, txn TxnIndex, rs *Read
 				continue
 			}
 			if gs == nil || gs.Has(desc.Key) != desc.Exists {
-				return false
+				return ConflictKindHas, desc.Key, true
 			}
 		}
 	}
 
 	for _, desc := range rs.Iterators {
 		if !d.validateIterator(desc, txn) {
-			return false
+			return ConflictKindIterator, desc.Start, true
 		}
 	}
 
-	return true
+	return "", nil, false
 }
 
 // validateIterator validates the iteration descriptor by replaying and compare the recorded reads.
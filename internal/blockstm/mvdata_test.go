@@ -86,6 +86,72 @@ func TestMVData(t *testing.T) {
 	require.Nil(t, value)
 }
 
+func TestMVDataPrefixEstimates(t *testing.T) {
+	ctx := context.Background()
+	data := NewMVData(10)
+	data.InitWithEstimates(ctx, 1, Locations{Key("fee")})
+	data.InitWithPrefixEstimates(ctx, 1, Locations{Key("ab")})
+
+	// every key under the prefix is an estimate for the later txns
+	_, version, estimate := data.Read(ctx, []byte("abc"), 3)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(1), version.Index)
+	_, _, estimate = data.Read(ctx, []byte("fee"), 3)
+	require.True(t, estimate)
+	_, _, estimate = data.Read(ctx, []byte("abc"), 1)
+	require.False(t, estimate)
+	_, _, estimate = data.Read(ctx, []byte("b"), 3)
+	require.False(t, estimate)
+
+	// a closer write hides the prefix estimate
+	data.Consolidate(ctx, TxnVersion{Index: 2, Incarnation: 1}, KV([]byte("abc"), []byte("2")))
+	value, _, estimate := data.Read(ctx, []byte("abc"), 3)
+	require.False(t, estimate)
+	require.Equal(t, []byte("2"), value)
+
+	// a farther write doesn't
+	data.Consolidate(ctx, TxnVersion{Index: 0, Incarnation: 1}, KV([]byte("abd"), []byte("0")))
+	_, version, estimate = data.Read(ctx, []byte("abd"), 3)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(1), version.Index)
+
+	// the prefix estimate is dropped once the txn is executed
+	data.Consolidate(ctx, TxnVersion{Index: 1, Incarnation: 1}, KV([]byte("abe"), []byte("1")))
+	value, _, estimate = data.Read(ctx, []byte("abd"), 3)
+	require.False(t, estimate)
+	require.Equal(t, []byte("0"), value)
+	_, _, estimate = data.Read(ctx, []byte("abf"), 3)
+	require.False(t, estimate)
+}
+
+func TestMVDataPrefixEstimatesIndex(t *testing.T) {
+	ctx := context.Background()
+	data := NewMVData(10)
+	data.InitWithPrefixEstimates(ctx, 1, Locations{Key("a"), Key("abc")})
+	data.InitWithPrefixEstimates(ctx, 3, Locations{Key("ab")})
+	data.InitWithPrefixEstimates(ctx, 5, Locations{Key("abcd")})
+
+	// the closest lower txn declaring a prefix of the key
+	_, version, estimate := data.Read(ctx, []byte("abcde"), 9)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(5), version.Index)
+	_, version, estimate = data.Read(ctx, []byte("abcde"), 5)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(3), version.Index)
+	_, version, estimate = data.Read(ctx, []byte("ax"), 9)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(1), version.Index)
+	_, _, estimate = data.Read(ctx, []byte("b"), 9)
+	require.False(t, estimate)
+
+	// the executed txns are skipped
+	data.Consolidate(ctx, TxnVersion{Index: 5, Incarnation: 1}, KV([]byte("x"), []byte("5")))
+	data.Consolidate(ctx, TxnVersion{Index: 3, Incarnation: 1}, KV([]byte("x"), []byte("3")))
+	_, version, estimate = data.Read(ctx, []byte("abcde"), 9)
+	require.True(t, estimate)
+	require.Equal(t, TxnIndex(1), version.Index)
+}
+
 func TestReadErrConversion(t *testing.T) {
 	err := fmt.Errorf("wrap: %w", ErrReadError{BlockingTxn: 1})
 	var readErr ErrReadError
@@ -10,13 +10,26 @@ import (
 type (
 	Locations      []Key // keys are sorted
 	MultiLocations map[int]Locations
+
+	// CommutativePrefixes are the key prefixes of each store that are only read and written
+	// by a single transaction each, e.g. the per-transaction slots of `types/accumulator`,
+	// the reads under them are served by the storage and skip validation. An empty prefix
+	// covers the whole store.
+	CommutativePrefixes map[int][][]byte
 )
 
+// commutativeView is implemented by the views of all value types.
+type commutativeView interface {
+	setCommutative(prefixes [][]byte)
+}
+
 // MVMemory implements `Algorithm 2 The MVMemory module`
 type MVMemory struct {
 	scheduler *Scheduler
 	// map StoreKey to array index
 	stores map[storetypes.StoreKey]int
+	// map array index to store name, used in conflict reports
+	names []string
 
 	// multi-version data structure for each store
 	data []MVStore
@@ -25,6 +38,9 @@ type MVMemory struct {
 
 	// read sets of transactions
 	lastReadSet []atomic.Pointer[MultiReadSet]
+
+	// optional commutative prefixes of each store
+	commutative CommutativePrefixes
 }
 
 func NewMVMemory(
@@ -39,14 +55,17 @@ func NewMVMemoryWithEstimates(
 	storage []Storage, scheduler *Scheduler, estimates []MultiLocations,
 ) *MVMemory {
 	data := make([]MVStore, len(stores))
+	names := make([]string, len(stores))
 	for key, i := range stores {
 		data[i] = NewMVStore(key, block_size)
+		names[i] = key.Name()
 	}
 
 	mv := &MVMemory{
 		storage:     storage,
 		scheduler:   scheduler,
 		stores:      stores,
+		names:       names,
 		data:        data,
 		lastReadSet: make([]atomic.Pointer[MultiReadSet], block_size),
 	}
@@ -62,6 +81,17 @@ func NewMVMemoryWithEstimates(
 	return mv
 }
 
+// initPrefixEstimates marks the keys under the prefixes declared by each txn as ESTIMATE, like
+// the pre-estimates but matched by prefix on reads. Iterators only see the exact estimates.
+func (mv *MVMemory) initPrefixEstimates(prefixEstimates []MultiLocations) {
+	ctx := context.Background()
+	for txn, est := range prefixEstimates {
+		for store, prefixes := range est {
+			mv.data[store].InitWithPrefixEstimates(ctx, TxnIndex(txn), prefixes)
+		}
+	}
+}
+
 func (mv *MVMemory) Record(version TxnVersion, view *MultiMVMemoryView) bool {
 	wroteNewLocation := view.ApplyWriteSet(version)
 	mv.lastReadSet[version.Index].Store(view.ReadSet())
@@ -84,14 +114,31 @@ func (mv *MVMemory) ClearEstimates(txn TxnIndex) {
 func (mv *MVMemory) ValidateReadSet(ctx context.Context, txn TxnIndex) bool {
 	// Invariant: at least one `Record` call has been made for `txn`
 	rs := *mv.lastReadSet[txn].Load()
+	report := mv.report()
 	for store, readSet := range rs {
-		if !mv.data[store].ValidateReadSet(ctx, txn, readSet, mv.storage[store]) {
+		if report == nil {
+			if !mv.data[store].ValidateReadSet(ctx, txn, readSet, mv.storage[store]) {
+				return false
+			}
+			continue
+		}
+
+		if kind, key, conflict := mv.data[store].ConflictingRead(ctx, txn, readSet, mv.storage[store]); conflict {
+			report.recordConflict(txn, mv.names[store], kind, key)
 			return false
 		}
 	}
 	return true
 }
 
+// report returns the conflict report of the block, `nil` if reporting is disabled.
+func (mv *MVMemory) report() *ConflictReport {
+	if mv.scheduler == nil {
+		return nil
+	}
+	return mv.scheduler.report
+}
+
 func (mv *MVMemory) WriteSnapshot(ctx context.Context, parent MultiStore) {
 	for name, i := range mv.stores {
 		mv.data[i].SnapshotToStore(ctx, parent.GetStore(name))
@@ -105,7 +152,11 @@ func (mv *MVMemory) View(ctx context.Context, txn TxnIndex) *MultiMVMemoryView {
 
 func (mv *MVMemory) newMVView(ctx context.Context, name storetypes.StoreKey, txn TxnIndex) MVView {
 	i := mv.stores[name]
-	return NewMVView(ctx, i, mv.storage[i], mv.GetMVStore(i), mv.scheduler, txn)
+	view := NewMVView(ctx, i, mv.storage[i], mv.GetMVStore(i), mv.scheduler, txn)
+	if prefixes := mv.commutative[i]; len(prefixes) > 0 {
+		view.(commutativeView).setCommutative(prefixes)
+	}
+	return view
 }
 
 func (mv *MVMemory) GetMVStore(i int) MVStore {
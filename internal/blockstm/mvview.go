@@ -1,6 +1,7 @@
 package blockstm
 
 import (
+	"bytes"
 	"context"
 
 	"go.opentelemetry.io/otel/metric"
@@ -27,6 +28,9 @@ type GMVMemoryView[V any] struct {
 	txn      TxnIndex
 	readSet  *ReadSet
 	writeSet *GMemDB[V]
+
+	// prefixes of the commutative keys, see `CommutativePrefixes`
+	commutative [][]byte
 }
 
 func NewMVView(ctx context.Context, store int, storage Storage, mvData MVStore, scheduler *Scheduler, txn TxnIndex) MVView {
@@ -52,6 +56,20 @@ func NewGMVMemoryView[V any](ctx context.Context, store int, storage GStorage[V]
 	}
 }
 
+func (s *GMVMemoryView[V]) setCommutative(prefixes [][]byte) {
+	s.commutative = prefixes
+}
+
+// isCommutative returns true if the key is under one of the commutative prefixes.
+func (s *GMVMemoryView[V]) isCommutative(key []byte) bool {
+	for _, prefix := range s.commutative {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *GMVMemoryView[V]) init() {
 	if s.writeSet == nil {
 		s.writeSet = NewWriteSet(s.mvData.isZero, s.mvData.valueLen)
@@ -93,6 +111,13 @@ func (s *GMVMemoryView[V]) Get(key []byte) V {
 		}
 	}
 
+	if s.isCommutative(key) {
+		// the key is only written by this txn, read the storage without tracking
+		result := s.storage.Get(key)
+		measureSince(s.ctx, func() metric.Int64Histogram { return inst.MVViewReadStorage }, start)
+		return result
+	}
+
 	for {
 		value, version, estimate := s.mvData.Read(s.ctx, key, s.txn)
 		if estimate {
@@ -126,6 +151,12 @@ func (s *GMVMemoryView[V]) Has(key []byte) bool {
 		}
 	}
 
+	if s.isCommutative(key) {
+		exists := s.storage.Has(key)
+		measureSince(s.ctx, func() metric.Int64Histogram { return inst.MVViewReadStorage }, start)
+		return exists
+	}
+
 	for {
 		value, version, estimate := s.mvData.Read(s.ctx, key, s.txn)
 		if estimate {
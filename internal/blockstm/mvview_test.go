@@ -310,3 +310,31 @@ func CollectIterator[V any](iter storetypes.GIterator[V]) []GKVPair[V] {
 	}
 	return res
 }
+
+func TestMVMemoryViewCommutativeKeys(t *testing.T) {
+	ctx := context.Background()
+	stores := map[storetypes.StoreKey]int{StoreKeyAuth: 0}
+	storage := NewMultiMemDB(stores)
+	storage.GetKVStore(StoreKeyAuth).Set([]byte("slot/1"), []byte("0"))
+
+	// txn 0 has an ESTIMATE mark on the slot read by txn 1
+	estimates := []MultiLocations{{0: Locations{Key("slot/1")}}, nil}
+	mv := NewMVMemoryWithEstimates(2, stores, MultiStoreToCachedStorage(storage, stores), NewScheduler(2), estimates)
+	mv.commutative = CommutativePrefixes{0: {[]byte("slot/")}}
+
+	mview := mv.View(ctx, 1)
+	view := mview.GetKVStore(StoreKeyAuth)
+
+	// commutative reads go to storage without waiting or tracking
+	require.Equal(t, []byte("0"), view.Get([]byte("slot/1")))
+	require.True(t, view.Has([]byte("slot/1")))
+	view.Set([]byte("slot/1"), []byte("1"))
+	require.Equal(t, []byte("1"), view.Get([]byte("slot/1")))
+
+	// the other keys are tracked as usual
+	require.Nil(t, view.Get([]byte("other")))
+
+	rs := (*mview.ReadSet())[0]
+	require.Equal(t, []ReadDescriptor{{Key: []byte("other"), Version: InvalidTxnVersion}}, rs.Reads)
+	require.Empty(t, rs.HasReads)
+}
@@ -0,0 +1,101 @@
+package blockstm
+
+import (
+	"slices"
+)
+
+// TxAccess is what the reordering pre-pass knows about a single transaction.
+type TxAccess struct {
+	// Writes is the estimated write set, keyed by store index.
+	Writes MultiLocations
+	// Senders identifies the accounts whose sequence order must be preserved,
+	// transactions sharing a sender are never reordered relative to each other.
+	Senders []string
+	// Pinned keeps the transaction at its original position in the block, it's
+	// used for transactions the pre-pass can't reason about (e.g. undecodable
+	// bytes injected by the proposal handler).
+	Pinned bool
+}
+
+type storeLocation struct {
+	store int
+	key   string
+}
+
+// DependencyLevels builds the dependency graph implied by the estimated write sets and
+// senders, and returns the level of each transaction, a transaction's level is one more
+// than the highest level of the earlier transactions it conflicts with. Transactions on
+// the same level have no estimated conflicts between each other, so they can be executed
+// concurrently without hitting an ESTIMATE mark. Pinned transactions get level -1.
+func DependencyLevels(accesses []TxAccess) []int {
+	levels := make([]int, len(accesses))
+	lastWriter := make(map[storeLocation]int)
+	lastSender := make(map[string]int)
+
+	for i, access := range accesses {
+		if access.Pinned {
+			levels[i] = -1
+			continue
+		}
+
+		level := 0
+		for store, locs := range access.Writes {
+			for _, key := range locs {
+				if dep, ok := lastWriter[storeLocation{store, string(key)}]; ok {
+					level = max(level, levels[dep]+1)
+				}
+			}
+		}
+		for _, sender := range access.Senders {
+			if dep, ok := lastSender[sender]; ok {
+				level = max(level, levels[dep]+1)
+			}
+		}
+		levels[i] = level
+
+		for store, locs := range access.Writes {
+			for _, key := range locs {
+				lastWriter[storeLocation{store, string(key)}] = i
+			}
+		}
+		for _, sender := range access.Senders {
+			lastSender[sender] = i
+		}
+	}
+
+	return levels
+}
+
+// ReorderByLevels returns a permutation of the transactions that groups them by
+// dependency level, see `DependencyLevels`. The result is a topological order of the
+// dependency graph, so conflicting transactions and transactions of the same sender
+// keep their relative order, and the outcome is the same as the original order as long
+// as the estimates are complete. Pinned transactions keep their original positions.
+//
+// result[i] is the original index of the transaction to put at position i.
+func ReorderByLevels(accesses []TxAccess) []TxnIndex {
+	levels := DependencyLevels(accesses)
+
+	movable := make([]TxnIndex, 0, len(accesses))
+	for i, level := range levels {
+		if level >= 0 {
+			movable = append(movable, TxnIndex(i))
+		}
+	}
+	// stable sort keeps the original order within the same level
+	slices.SortStableFunc(movable, func(a, b TxnIndex) int {
+		return levels[a] - levels[b]
+	})
+
+	order := make([]TxnIndex, len(accesses))
+	next := 0
+	for i, level := range levels {
+		if level < 0 {
+			order[i] = TxnIndex(i)
+			continue
+		}
+		order[i] = movable[next]
+		next++
+	}
+	return order
+}
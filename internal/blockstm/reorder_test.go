@@ -0,0 +1,67 @@
+package blockstm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyLevels(t *testing.T) {
+	pool := Key("pool")
+	accesses := []TxAccess{
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"alice"}},
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"bob"}},
+		{Writes: MultiLocations{1: {pool}}, Senders: []string{"carol"}}, // same key, other store
+		{Senders: []string{"alice"}},
+		{Pinned: true},
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"dave"}},
+	}
+
+	require.Equal(t, []int{0, 1, 0, 1, -1, 2}, DependencyLevels(accesses))
+}
+
+func TestReorderByLevels(t *testing.T) {
+	pool := Key("pool")
+	accesses := []TxAccess{
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"a"}}, // level 0
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"b"}}, // level 1
+		{Writes: MultiLocations{0: {pool}}, Senders: []string{"c"}}, // level 2
+		{Senders: []string{"d"}},                                    // level 0
+		{Senders: []string{"b"}},                                    // level 2, after its sender's previous tx
+		{Senders: []string{"e"}},                                    // level 0
+	}
+
+	order := ReorderByLevels(accesses)
+	require.Equal(t, []TxnIndex{0, 3, 5, 1, 2, 4}, order)
+
+	// the sender order of "b" is preserved
+	require.Less(t, indexOf(order, 1), indexOf(order, 4))
+}
+
+func TestReorderByLevelsPinned(t *testing.T) {
+	pool := Key("pool")
+	accesses := []TxAccess{
+		{Pinned: true},
+		{Writes: MultiLocations{0: {pool}}},
+		{Writes: MultiLocations{0: {pool}}},
+		{},
+		{Pinned: true},
+		{},
+	}
+
+	order := ReorderByLevels(accesses)
+	require.Equal(t, []TxnIndex{0, 1, 3, 5, 4, 2}, order)
+}
+
+func TestReorderByLevelsEmpty(t *testing.T) {
+	require.Empty(t, ReorderByLevels(nil))
+}
+
+func indexOf(order []TxnIndex, txn TxnIndex) int {
+	for i, v := range order {
+		if v == txn {
+			return i
+		}
+	}
+	return -1
+}
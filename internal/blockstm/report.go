@@ -0,0 +1,237 @@
+package blockstm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ConflictKind classifies the read that failed validation.
+type ConflictKind string
+
+const (
+	ConflictKindRead     ConflictKind = "read"
+	ConflictKindHas      ConflictKind = "has"
+	ConflictKindIterator ConflictKind = "iterator"
+)
+
+// ConflictReport collects per-transaction diagnostics during the execution of a block,
+// it's populated by `ExecuteBlockWithReport` and is safe for concurrent use by the executors.
+type ConflictReport struct {
+	txs []txConflicts
+}
+
+type txConflicts struct {
+	sync.Mutex
+	incarnations int
+	waitedOn     []TxnIndex
+	conflicts    []Conflict
+}
+
+// Conflict is a read that failed validation and caused a re-execution.
+type Conflict struct {
+	Store string       `json:"store"`
+	Kind  ConflictKind `json:"kind"`
+	// Key is hex encoded, for iterators it's the start of the iterated range.
+	Key string `json:"key"`
+}
+
+// TxReport is the report of a single transaction.
+type TxReport struct {
+	Index int `json:"index"`
+	// Incarnations is the number of times the transaction was executed.
+	Incarnations int `json:"incarnations"`
+	// ValidationFailures lists the reads that were invalidated by earlier transactions, in order of occurrence.
+	ValidationFailures []Conflict `json:"validation_failures,omitempty"`
+	// WaitedOn lists the earlier transactions whose ESTIMATE marks suspended the execution.
+	WaitedOn []int `json:"waited_on,omitempty"`
+}
+
+// BlockReport is the conflict report of a block.
+type BlockReport struct {
+	Height int64      `json:"height"`
+	Txs    []TxReport `json:"txs"`
+}
+
+func NewConflictReport(blockSize int) *ConflictReport {
+	return &ConflictReport{txs: make([]txConflicts, blockSize)}
+}
+
+func (r *ConflictReport) recordIncarnation(txn TxnIndex) {
+	entry := &r.txs[txn]
+	entry.Lock()
+	entry.incarnations++
+	entry.Unlock()
+}
+
+func (r *ConflictReport) recordWait(txn, blockingTxn TxnIndex) {
+	entry := &r.txs[txn]
+	entry.Lock()
+	entry.waitedOn = append(entry.waitedOn, blockingTxn)
+	entry.Unlock()
+}
+
+func (r *ConflictReport) recordConflict(txn TxnIndex, store string, kind ConflictKind, key Key) {
+	entry := &r.txs[txn]
+	entry.Lock()
+	entry.conflicts = append(entry.conflicts, Conflict{
+		Store: store,
+		Kind:  kind,
+		Key:   hex.EncodeToString(key),
+	})
+	entry.Unlock()
+}
+
+// HasConflicts returns if any transaction was re-executed or suspended.
+func (r *ConflictReport) HasConflicts() bool {
+	for i := range r.txs {
+		entry := &r.txs[i]
+		entry.Lock()
+		conflicted := entry.incarnations > 1 || len(entry.waitedOn) > 0 || len(entry.conflicts) > 0
+		entry.Unlock()
+		if conflicted {
+			return true
+		}
+	}
+	return false
+}
+
+// BlockReport returns a snapshot of the report, it should be called after the execution is done.
+func (r *ConflictReport) BlockReport(height int64) *BlockReport {
+	report := &BlockReport{
+		Height: height,
+		Txs:    make([]TxReport, len(r.txs)),
+	}
+	for i := range r.txs {
+		entry := &r.txs[i]
+		entry.Lock()
+
+		var waitedOn []int
+		for _, txn := range entry.waitedOn {
+			waitedOn = append(waitedOn, int(txn))
+		}
+		slices.Sort(waitedOn)
+
+		report.Txs[i] = TxReport{
+			Index:              i,
+			Incarnations:       entry.incarnations,
+			ValidationFailures: slices.Clone(entry.conflicts),
+			WaitedOn:           slices.Compact(waitedOn),
+		}
+		entry.Unlock()
+	}
+	return report
+}
+
+// WriteBlockReport writes the report as `<height>.json` into dir, creating dir if needed.
+func WriteBlockReport(dir string, report *BlockReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, fmt.Sprintf("%d.json", report.Height)), bz, 0o600)
+}
+
+// reportQueueSize is the number of reports waiting to be written before new ones are dropped.
+const reportQueueSize = 64
+
+// ReportWriter writes block reports into a directory from a background goroutine, so that
+// the file system is kept off the commit path, and keeps only the most recent reports.
+type ReportWriter struct {
+	dir      string
+	maxFiles int
+	onError  func(height int64, err error)
+
+	reports chan *BlockReport
+	done    chan struct{}
+}
+
+// NewReportWriter starts a writer of the reports into dir, keeping at most maxFiles of them
+// (0 keeps all). onError is called from the writer goroutine when a report can't be written
+// or is dropped because the writer lags behind.
+func NewReportWriter(dir string, maxFiles int, onError func(height int64, err error)) *ReportWriter {
+	if maxFiles < 0 {
+		panic("max report files must not be negative")
+	}
+	w := &ReportWriter{
+		dir:      dir,
+		maxFiles: maxFiles,
+		onError:  onError,
+		reports:  make(chan *BlockReport, reportQueueSize),
+		done:     make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Write queues the report without blocking, the report is dropped if the queue is full.
+func (w *ReportWriter) Write(report *BlockReport) {
+	select {
+	case w.reports <- report:
+	default:
+		w.onError(report.Height, errors.New("report queue is full, dropping the report"))
+	}
+}
+
+// Close writes the queued reports and stops the writer, Write must not be called afterwards.
+func (w *ReportWriter) Close() {
+	close(w.reports)
+	<-w.done
+}
+
+func (w *ReportWriter) run() {
+	defer close(w.done)
+	for report := range w.reports {
+		if err := WriteBlockReport(w.dir, report); err != nil {
+			w.onError(report.Height, err)
+			continue
+		}
+		if err := pruneBlockReports(w.dir, w.maxFiles); err != nil {
+			w.onError(report.Height, err)
+		}
+	}
+}
+
+// pruneBlockReports removes the reports of the lowest heights in dir until at most maxFiles are left.
+func pruneBlockReports(dir string, maxFiles int) error {
+	if maxFiles == 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var heights []int64
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		if height, err := strconv.ParseInt(name, 10, 64); err == nil {
+			heights = append(heights, height)
+		}
+	}
+	if len(heights) <= maxFiles {
+		return nil
+	}
+
+	slices.Sort(heights)
+	var errs []error
+	for _, height := range heights[:len(heights)-maxFiles] {
+		errs = append(errs, os.Remove(filepath.Join(dir, fmt.Sprintf("%d.json", height))))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,117 @@
+package blockstm
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+func TestExecuteBlockWithReport(t *testing.T) {
+	stores := map[storetypes.StoreKey]int{StoreKeyAuth: 0}
+	storage := NewMultiMemDB(stores)
+	key := []byte("k")
+
+	// txn 1 suspends on the ESTIMATE mark of txn 0
+	estimates := make([]MultiLocations, 3)
+	estimates[0] = MultiLocations{0: Locations{key}}
+
+	report := NewConflictReport(3)
+	err := ExecuteBlockWithReport(context.Background(), 3, stores, storage, 2, estimates, report,
+		func(txn TxnIndex, store MultiStore) {
+			kv := store.GetKVStore(StoreKeyAuth)
+			switch txn {
+			case 0:
+				time.Sleep(50 * time.Millisecond)
+				kv.Set(key, []byte{1})
+			case 1:
+				kv.Get(key)
+			}
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, report.HasConflicts())
+
+	block := report.BlockReport(10)
+	require.Equal(t, int64(10), block.Height)
+	require.Len(t, block.Txs, 3)
+	require.Equal(t, []int{0}, block.Txs[1].WaitedOn)
+	for i, tx := range block.Txs {
+		require.Equal(t, i, tx.Index)
+		require.GreaterOrEqual(t, tx.Incarnations, 1)
+	}
+	require.Empty(t, block.Txs[2].WaitedOn)
+	require.Empty(t, block.Txs[2].ValidationFailures)
+}
+
+func TestMVMemoryValidateReadSetRecordsConflict(t *testing.T) {
+	ctx := context.Background()
+	stores := map[storetypes.StoreKey]int{StoreKeyAuth: 0}
+	storage := NewMultiMemDB(stores)
+	key := []byte("k")
+
+	scheduler := NewScheduler(2)
+	scheduler.report = NewConflictReport(2)
+	mv := NewMVMemory(2, stores, MultiStoreToStorage(storage, stores), scheduler)
+
+	// txn 1 reads k from storage, then txn 0 writes it
+	view := mv.View(ctx, 1)
+	view.GetKVStore(StoreKeyAuth).Get(key)
+	mv.Record(TxnVersion{1, 0}, view)
+
+	view = mv.View(ctx, 0)
+	view.GetKVStore(StoreKeyAuth).Set(key, []byte{1})
+	mv.Record(TxnVersion{0, 0}, view)
+
+	require.False(t, mv.ValidateReadSet(ctx, 1))
+
+	block := scheduler.report.BlockReport(1)
+	require.Equal(t, []Conflict{{
+		Store: StoreKeyAuth.Name(),
+		Kind:  ConflictKindRead,
+		Key:   hex.EncodeToString(key),
+	}}, block.Txs[1].ValidationFailures)
+}
+
+func TestWriteBlockReport(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	report := &BlockReport{
+		Height: 7,
+		Txs:    []TxReport{{Index: 0, Incarnations: 2, WaitedOn: []int{}}},
+	}
+	require.NoError(t, WriteBlockReport(dir, report))
+
+	bz, err := os.ReadFile(filepath.Join(dir, "7.json"))
+	require.NoError(t, err)
+
+	var decoded BlockReport
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, int64(7), decoded.Height)
+	require.Equal(t, 2, decoded.Txs[0].Incarnations)
+}
+
+func TestReportWriterRetention(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	w := NewReportWriter(dir, 2, func(height int64, err error) {
+		t.Errorf("report %d: %v", height, err)
+	})
+	for _, height := range []int64{9, 10, 11, 12} {
+		w.Write(&BlockReport{Height: height})
+	}
+	w.Close()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{"11.json", "12.json"}, names)
+}
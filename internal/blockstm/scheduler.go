@@ -51,6 +51,9 @@ type Scheduler struct {
 	// metrics
 	executedTxns  atomic.Int64
 	validatedTxns atomic.Int64
+
+	// optional per-transaction diagnostics, `nil` if disabled
+	report *ConflictReport
 }
 
 func NewScheduler(blockSize int) *Scheduler {
@@ -163,6 +166,10 @@ func (s *Scheduler) WaitForDependency(txn, blockingTxn TxnIndex) *Condvar {
 	entry.dependents = append(entry.dependents, txn)
 	entry.Unlock()
 
+	if s.report != nil {
+		s.report.recordWait(txn, blockingTxn)
+	}
+
 	return cond
 }
 
@@ -34,6 +34,42 @@ func ExecuteBlockWithEstimates(
 	executors int,
 	estimates []MultiLocations, // txn -> multi-locations
 	txExecutor TxExecutor,
+) error {
+	return ExecuteBlockWithReport(
+		ctx, blockSize, stores, parent, executors,
+		estimates, nil, txExecutor,
+	)
+}
+
+// ExecuteBlockWithReport is like `ExecuteBlockWithEstimates`, and collects per-transaction
+// diagnostics into report if it's not `nil`, see `NewConflictReport`.
+func ExecuteBlockWithReport(
+	ctx context.Context,
+	blockSize int,
+	stores map[storetypes.StoreKey]int,
+	parent MultiStore,
+	executors int,
+	estimates []MultiLocations, // txn -> multi-locations
+	report *ConflictReport,
+	txExecutor TxExecutor,
+) error {
+	return executeBlock(
+		ctx, blockSize, stores, parent, executors,
+		estimates, nil, report, nil, txExecutor,
+	)
+}
+
+func executeBlock(
+	ctx context.Context,
+	blockSize int,
+	stores map[storetypes.StoreKey]int,
+	parent MultiStore,
+	executors int,
+	estimates []MultiLocations, // txn -> multi-locations
+	prefixEstimates []MultiLocations, // txn -> multi-location prefixes
+	report *ConflictReport,
+	commutative CommutativePrefixes,
+	txExecutor TxExecutor,
 ) error {
 	if blockSize > math.MaxUint32 {
 		return fmt.Errorf("block size overflows uint32: %d", blockSize)
@@ -48,11 +84,14 @@ func ExecuteBlockWithEstimates(
 
 	// Create a new scheduler
 	scheduler := NewScheduler(blockSize)
+	scheduler.report = report
 
 	// wrap parent storage with read cache
 	storage := MultiStoreToCachedStorage(parent, stores)
 
 	mvMemory := NewMVMemoryWithEstimates(blockSize, stores, storage, scheduler, estimates)
+	mvMemory.initPrefixEstimates(prefixEstimates)
+	mvMemory.commutative = commutative
 
 	// var wg sync.WaitGroup
 	var wg errgroup.Group
@@ -288,3 +288,28 @@ func StoreEqual(a, b storetypes.KVStore) bool {
 		iter2.Next()
 	}
 }
+
+func TestExecuteBlock_CommutativeKeys(t *testing.T) {
+	stores := map[storetypes.StoreKey]int{StoreKeyAuth: 0}
+	storage := NewMultiMemDB(stores)
+	commutative := CommutativePrefixes{0: {[]byte("slot/")}}
+
+	const blockSize = 16
+	report := NewConflictReport(blockSize)
+	err := executeBlock(context.Background(), blockSize, stores, storage, 4, nil, nil, report, commutative,
+		func(txn TxnIndex, store MultiStore) {
+			// read-modify-write of the slot of the txn
+			kv := store.GetKVStore(StoreKeyAuth)
+			key := []byte(fmt.Sprintf("slot/%02d", txn))
+			value := kv.Get(key)
+			kv.Set(key, append(value, byte(txn)))
+		},
+	)
+	require.NoError(t, err)
+	require.False(t, report.HasConflicts())
+
+	kv := storage.GetKVStore(StoreKeyAuth)
+	for i := 0; i < blockSize; i++ {
+		require.Equal(t, []byte{byte(i)}, kv.Get([]byte(fmt.Sprintf("slot/%02d", i))))
+	}
+}
@@ -1,7 +1,9 @@
 package blockstm
 
 import (
+	"bytes"
 	"context"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -9,6 +11,7 @@ import (
 
 	"cosmossdk.io/collections"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -37,11 +40,53 @@ type STMRunner struct {
 	workers   int
 	estimate  bool
 	coinDenom func(storetypes.MultiStore) string
+
+	// optional receiver of the conflict reports of blocks with re-executions or suspensions
+	reporter func(*BlockReport)
+
+	// commutative key prefixes by store name
+	commutative map[string][][]byte
 }
 
-func (e STMRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]byte, deliverTx sdk.DeliverTxFunc) ([]*abci.ExecTxResult, error) {
-	var authStore, bankStore int
-	index := make(map[storetypes.StoreKey]int, len(e.stores))
+// SetConflictReporter enables the per-transaction conflict report, reporter is called
+// after the execution of every block in which a transaction was re-executed or suspended.
+func (e *STMRunner) SetConflictReporter(reporter func(*BlockReport)) {
+	e.reporter = reporter
+}
+
+// SetCommutativeKeys marks the keys of the store under the prefixes as commutative, all of
+// its keys if no prefix is given. Each commutative key must only be read and written by a single
+// transaction of the block, e.g. the per-transaction slots of `types/accumulator`: their reads
+// are not validated and never observe the writes of the other transactions.
+func (e *STMRunner) SetCommutativeKeys(storeName string, prefixes ...[]byte) {
+	if e.commutative == nil {
+		e.commutative = make(map[string][][]byte)
+	}
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{{}}
+	}
+	e.commutative[storeName] = append(e.commutative[storeName], prefixes...)
+}
+
+// commutativePrefixes resolves the commutative prefixes by store index, unknown stores are ignored.
+func (e STMRunner) commutativePrefixes() CommutativePrefixes {
+	if len(e.commutative) == 0 {
+		return nil
+	}
+
+	names := e.storeNames()
+	prefixes := make(CommutativePrefixes, len(e.commutative))
+	for name, p := range e.commutative {
+		if store, ok := names[name]; ok {
+			prefixes[store] = p
+		}
+	}
+	return prefixes
+}
+
+// storeIndex returns the index of each store, and the indexes of the auth and bank stores used by pre-estimation.
+func (e STMRunner) storeIndex() (index map[storetypes.StoreKey]int, authStore, bankStore int) {
+	index = make(map[storetypes.StoreKey]int, len(e.stores))
 	for i, k := range e.stores {
 		switch k.Name() {
 		case "acc":
@@ -51,6 +96,20 @@ func (e STMRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]by
 		}
 		index[k] = i
 	}
+	return index, authStore, bankStore
+}
+
+// storeNames returns the index of each store by name, used to resolve declared access lists.
+func (e STMRunner) storeNames() map[string]int {
+	names := make(map[string]int, len(e.stores))
+	for i, k := range e.stores {
+		names[k.Name()] = i
+	}
+	return names
+}
+
+func (e STMRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]byte, deliverTx sdk.DeliverTxFunc) ([]*abci.ExecTxResult, error) {
+	index, authStore, bankStore := e.storeIndex()
 
 	blockSize := len(txs)
 	if blockSize == 0 {
@@ -63,22 +122,29 @@ func (e STMRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]by
 		incarnationCache[i].Store(&m)
 	}
 
-	var (
-		estimates []MultiLocations
-		memTxs    []sdk.Tx
-	)
-
+	// the declared access lists drive the scheduling whether or not the fee payer keys are
+	// pre-estimated, the decoded txs are reused by the execution.
+	var coinDenom string
 	if e.estimate {
-		memTxs, estimates = preEstimates(txs, e.workers, authStore, bankStore, e.coinDenom(ms), e.txDecoder)
+		coinDenom = e.coinDenom(ms)
+	}
+	memTxs, estimates, declared := preEstimates(txs, e.workers, e.estimate, authStore, bankStore, e.storeNames(), coinDenom, e.txDecoder)
+
+	var report *ConflictReport
+	if e.reporter != nil {
+		report = NewConflictReport(blockSize)
 	}
 
-	if err := ExecuteBlockWithEstimates(
+	if err := executeBlock(
 		ctx,
 		blockSize,
 		index,
 		stmMultiStoreWrapper{ms},
 		e.workers,
 		estimates,
+		declared,
+		report,
+		e.commutativePrefixes(),
 		func(txn TxnIndex, ms MultiStore) {
 			var cache map[string]any
 
@@ -103,14 +169,142 @@ func (e STMRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]by
 		return nil, err
 	}
 
+	if report != nil && report.HasConflicts() {
+		var height int64
+		if sdkCtx, ok := ctx.(sdk.Context); ok {
+			height = sdkCtx.BlockHeight()
+		}
+		e.reporter(report.BlockReport(height))
+	}
+
 	return results, nil
 }
 
-// preEstimates returns a static estimation of the written keys for each transaction.
+// ReorderTxs reorders the transactions of a proposal to reduce ESTIMATE stalls during
+// execution, using the same pre-estimation as `Run` plus the signers of each transaction
+// to build the dependency graph, see `ReorderByLevels`. Transactions that fail to decode
+// keep their original positions.
+func (e STMRunner) ReorderTxs(ctx sdk.Context, txs [][]byte) [][]byte {
+	if len(txs) < 2 {
+		return txs
+	}
+
+	_, authStore, bankStore := e.storeIndex()
+	memTxs, estimates, declared := preEstimates(txs, e.workers, true, authStore, bankStore, e.storeNames(), e.coinDenom(ctx.MultiStore()), e.txDecoder)
+
+	accesses := make([]TxAccess, len(txs))
+	for i, tx := range memTxs {
+		if tx == nil {
+			accesses[i].Pinned = true
+			continue
+		}
+		// the declared prefixes only conflict with the same prefixes declared by other txs
+		accesses[i].Writes = mergeLocations(estimates[i], declared[i])
+		accesses[i].Senders = txSenders(tx)
+	}
+
+	reordered := make([][]byte, len(txs))
+	for i, txn := range ReorderByLevels(accesses) {
+		reordered[i] = txs[txn]
+	}
+	return reordered
+}
+
+// mergeLocations returns the union of the locations of a and b.
+func mergeLocations(a, b MultiLocations) MultiLocations {
+	if len(b) == 0 {
+		return a
+	}
+	merged := make(MultiLocations, len(a)+len(b))
+	for store, keys := range a {
+		merged[store] = slices.Clone(keys)
+	}
+	for store, keys := range b {
+		merged[store] = append(merged[store], keys...)
+	}
+	return merged
+}
+
+// txSenders returns the signers of the transaction, or nil if they can't be extracted.
+func txSenders(tx sdk.Tx) []string {
+	sigTx, ok := tx.(interface{ GetSigners() ([][]byte, error) })
+	if !ok {
+		return nil
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil
+	}
+	senders := make([]string, len(signers))
+	for i, signer := range signers {
+		senders[i] = string(signer)
+	}
+	return senders
+}
+
+// AccessDeclaration is implemented by the tx extension options that declare the keys written
+// by the transaction, by store name, see `x/auth/types.ExtensionOptionAccessList`.
+type AccessDeclaration interface {
+	DeclaredWrites() map[string][][]byte
+	// Validate bounds the number of prefixes and rejects the empty ones, which would mark
+	// every key of a store as ESTIMATE.
+	Validate() error
+}
+
+// declaredWrites returns the write prefixes declared in the extension options of the
+// transaction, invalid declarations and declarations of unknown stores are ignored.
+func declaredWrites(tx sdk.Tx, stores map[string]int) (MultiLocations, bool) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+		GetNonCriticalExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return nil, false
+	}
+
+	for _, opts := range [][]*codectypes.Any{extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()} {
+		for _, opt := range opts {
+			declaration, ok := opt.GetCachedValue().(AccessDeclaration)
+			if !ok {
+				continue
+			}
+			if err := declaration.Validate(); err != nil {
+				return nil, false
+			}
+
+			locations := make(MultiLocations)
+			for name, keys := range declaration.DeclaredWrites() {
+				store, ok := stores[name]
+				if !ok {
+					continue
+				}
+				for _, key := range keys {
+					locations[store] = append(locations[store], Key(key))
+				}
+			}
+			for store, keys := range locations {
+				slices.SortFunc(keys, func(a, b Key) int { return bytes.Compare(a, b) })
+				locations[store] = slices.CompactFunc(keys, func(a, b Key) bool { return bytes.Equal(a, b) })
+			}
+			return locations, true
+		}
+	}
+
+	return nil, false
+}
+
+// preEstimates decodes the transactions, and returns the write prefixes declared by the
+// transactions with an access list and, if estimate is set, a static estimation of the written
+// keys for each transaction. The declaration only covers the messages, so the fee payer keys
+// written by the ante handlers are estimated regardless.
 // NOTE: make sure it sync with the latest sdk logic when sdk upgrade.
-func preEstimates(txs [][]byte, workers, authStore, bankStore int, coinDenom string, txDecoder sdk.TxDecoder) ([]sdk.Tx, []MultiLocations) {
+func preEstimates(txs [][]byte, workers int, estimate bool, authStore, bankStore int, stores map[string]int, coinDenom string, txDecoder sdk.TxDecoder) ([]sdk.Tx, []MultiLocations, []MultiLocations) {
 	memTxs := make([]sdk.Tx, len(txs))
-	estimates := make([]MultiLocations, len(txs))
+	declared := make([]MultiLocations, len(txs))
+	var estimates []MultiLocations
+	if estimate {
+		estimates = make([]MultiLocations, len(txs))
+	}
 
 	job := func(start, end int) {
 		for i := start; i < end; i++ {
@@ -121,6 +315,13 @@ func preEstimates(txs [][]byte, workers, authStore, bankStore int, coinDenom str
 			}
 			memTxs[i] = tx
 
+			if prefixes, ok := declaredWrites(tx, stores); ok {
+				declared[i] = prefixes
+			}
+			if !estimate {
+				continue
+			}
+
 			feeTx, ok := tx.(sdk.FeeTx)
 			if !ok {
 				continue
@@ -168,5 +369,5 @@ func preEstimates(txs [][]byte, workers, authStore, bankStore int, coinDenom str
 	}
 	wg.Wait()
 
-	return memTxs, estimates
+	return memTxs, estimates, declared
 }
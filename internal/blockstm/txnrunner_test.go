@@ -13,6 +13,7 @@ import (
 
 	"cosmossdk.io/collections"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -257,6 +258,22 @@ func TestSTMRunner_Run_StoreIndexMapping(t *testing.T) {
 	require.Len(t, results, 1)
 }
 
+// TestSTMRunner_CommutativePrefixes tests the resolution of the commutative keys by store name
+func TestSTMRunner_CommutativePrefixes(t *testing.T) {
+	stores := []storetypes.StoreKey{StoreKeyAuth, StoreKeyBank}
+	runner := NewSTMRunner(mockTxDecoder, stores, 2, false, testCoinDenomFunc)
+	require.Nil(t, runner.commutativePrefixes())
+
+	runner.SetCommutativeKeys(StoreKeyBank.Name(), []byte{0x00})
+	runner.SetCommutativeKeys(StoreKeyAuth.Name())
+	runner.SetCommutativeKeys("unknown", []byte{0x01})
+
+	require.Equal(t, CommutativePrefixes{
+		0: {{}},
+		1: {{0x00}},
+	}, runner.commutativePrefixes())
+}
+
 // TestSTMRunner_Run_ContextCancellation tests context cancellation for STMRunner
 func TestSTMRunner_Run_ContextCancellation(t *testing.T) {
 	decoder := mockTxDecoder
@@ -294,7 +311,7 @@ func TestSTMRunner_Run_ContextCancellation(t *testing.T) {
 func TestPreEstimates(t *testing.T) {
 	t.Run("empty transactions", func(t *testing.T) {
 		decoder := mockTxDecoderWithFeeTx
-		memTxs, estimates := preEstimates([][]byte{}, 2, 0, 1, "stake", decoder)
+		memTxs, estimates, _ := preEstimates([][]byte{}, 2, true, 0, 1, nil, "stake", decoder)
 
 		require.Empty(t, memTxs)
 		require.Empty(t, estimates)
@@ -312,7 +329,7 @@ func TestPreEstimates(t *testing.T) {
 			append(addr2, 0x02),
 		}
 
-		memTxs, estimates := preEstimates(txs, 2, 0, 1, "stake", decoder)
+		memTxs, estimates, _ := preEstimates(txs, 2, true, 0, 1, nil, "stake", decoder)
 
 		require.Len(t, memTxs, len(txs))
 		require.Len(t, estimates, len(txs))
@@ -336,7 +353,7 @@ func TestPreEstimates(t *testing.T) {
 			{0x01, 0x02}, // valid
 		}
 
-		memTxs, estimates := preEstimates(txs, 2, 0, 1, "stake", decoder)
+		memTxs, estimates, _ := preEstimates(txs, 2, true, 0, 1, nil, "stake", decoder)
 
 		require.Len(t, memTxs, len(txs))
 		require.Len(t, estimates, len(txs))
@@ -359,7 +376,7 @@ func TestPreEstimates(t *testing.T) {
 			txs[i] = append(addr, byte(i))
 		}
 
-		memTxs, estimates := preEstimates(txs, 4, 0, 1, "stake", decoder)
+		memTxs, estimates, _ := preEstimates(txs, 4, true, 0, 1, nil, "stake", decoder)
 
 		require.Len(t, memTxs, len(txs))
 		require.Len(t, estimates, len(txs))
@@ -374,7 +391,7 @@ func TestPreEstimates(t *testing.T) {
 			{0x03, 0x04},
 		}
 
-		memTxs, estimates := preEstimates(txs, 2, 0, 1, "stake", decoder)
+		memTxs, estimates, _ := preEstimates(txs, 2, true, 0, 1, nil, "stake", decoder)
 
 		require.Len(t, memTxs, len(txs))
 		require.Len(t, estimates, len(txs))
@@ -393,7 +410,7 @@ func TestPreEstimates_KeyEncoding(t *testing.T) {
 	addr := sdk.AccAddress("testaddress12345")
 	tx := append(addr, 0x01)
 
-	memTxs, estimates := preEstimates([][]byte{tx}, 1, 0, 1, "stake", decoder)
+	memTxs, estimates, _ := preEstimates([][]byte{tx}, 1, true, 0, 1, nil, "stake", decoder)
 
 	require.Len(t, memTxs, 1)
 	require.Len(t, estimates, 1)
@@ -426,6 +443,138 @@ func TestPreEstimates_KeyEncoding(t *testing.T) {
 	}
 }
 
+type mockAccessList map[string][][]byte
+
+func (m mockAccessList) DeclaredWrites() map[string][][]byte {
+	return m
+}
+
+func (m mockAccessList) Validate() error {
+	for _, prefixes := range m {
+		for _, prefix := range prefixes {
+			if len(prefix) == 0 {
+				return errors.New("empty prefix")
+			}
+		}
+	}
+	return nil
+}
+
+type mockAccessListTx struct {
+	mockFeeTx
+	nonCritical []*codectypes.Any
+}
+
+func (m *mockAccessListTx) GetExtensionOptions() []*codectypes.Any {
+	return nil
+}
+
+func (m *mockAccessListTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return m.nonCritical
+}
+
+// TestPreEstimates_DeclaredAccessList tests that declared access lists are returned as prefix estimates
+func TestPreEstimates_DeclaredAccessList(t *testing.T) {
+	declared := mockAccessList{
+		"bank":    {[]byte("b"), []byte("a"), []byte("b")},
+		"unknown": {[]byte("x")},
+	}
+	decoder := func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := mockTxDecoderWithFeeTx(txBytes)
+		if err != nil || txBytes[0] != 0x01 {
+			return tx, err
+		}
+		return &mockAccessListTx{
+			mockFeeTx:   *tx.(*mockFeeTx),
+			nonCritical: []*codectypes.Any{codectypes.UnsafePackAny(declared)},
+		}, nil
+	}
+
+	stores := map[string]int{"acc": 0, "bank": 1}
+	memTxs, estimates, declaredPrefixes := preEstimates([][]byte{{0x01}, {0x02}}, 1, true, 0, 1, stores, "stake", decoder)
+	require.NotNil(t, memTxs[0])
+
+	// sorted and deduplicated, unknown stores are ignored
+	require.Equal(t, MultiLocations{1: {Key("a"), Key("b")}}, declaredPrefixes[0])
+	require.Nil(t, declaredPrefixes[1])
+
+	// the fee payer keys are estimated for both transactions
+	require.Len(t, estimates[0], 2)
+	require.Len(t, estimates[1], 2)
+}
+
+// TestPreEstimates_WithoutEstimation tests that the declared access lists are returned without
+// the fee payer estimates, and that invalid declarations are ignored
+func TestPreEstimates_WithoutEstimation(t *testing.T) {
+	decoder := func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := mockTxDecoderWithFeeTx(txBytes)
+		if err != nil {
+			return tx, err
+		}
+		declared := mockAccessList{"bank": {[]byte("a")}}
+		if txBytes[0] == 0x02 {
+			// an empty prefix would mark every key of the store as ESTIMATE
+			declared = mockAccessList{"bank": {[]byte{}}}
+		}
+		return &mockAccessListTx{
+			mockFeeTx:   *tx.(*mockFeeTx),
+			nonCritical: []*codectypes.Any{codectypes.UnsafePackAny(declared)},
+		}, nil
+	}
+
+	stores := map[string]int{"acc": 0, "bank": 1}
+	memTxs, estimates, declaredPrefixes := preEstimates([][]byte{{0x01}, {0x02}}, 2, false, 0, 1, stores, "", decoder)
+	require.NotNil(t, memTxs[0])
+	require.NotNil(t, memTxs[1])
+	require.Nil(t, estimates)
+	require.Equal(t, MultiLocations{1: {Key("a")}}, declaredPrefixes[0])
+	require.Nil(t, declaredPrefixes[1])
+}
+
+// TestSTMRunner_Run_DeclaredAccessListWithoutEstimation tests that the declared access lists are
+// used, and the decoded txs reused, when pre-estimation is disabled
+func TestSTMRunner_Run_DeclaredAccessListWithoutEstimation(t *testing.T) {
+	decoder := func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := mockTxDecoderWithFeeTx(txBytes)
+		if err != nil {
+			return tx, err
+		}
+		return &mockAccessListTx{
+			mockFeeTx:   *tx.(*mockFeeTx),
+			nonCritical: []*codectypes.Any{codectypes.UnsafePackAny(mockAccessList{"bank": {[]byte("counter")}})},
+		}, nil
+	}
+	stores := []storetypes.StoreKey{StoreKeyAuth, StoreKeyBank}
+	runner := NewSTMRunner(decoder, stores, 4, false, nil)
+
+	storeIndex := map[storetypes.StoreKey]int{
+		StoreKeyAuth: 0,
+		StoreKeyBank: 1,
+	}
+	ms := msWrapper{NewMultiMemDB(storeIndex)}
+
+	// every tx increments the same counter, declared by all of them
+	txs := make([][]byte, 20)
+	for i := range txs {
+		txs[i] = []byte{byte(i + 1)}
+	}
+	deliverTx := func(_ []byte, memTx sdk.Tx, ms storetypes.MultiStore, _ int, _ map[string]any) *abci.ExecTxResult {
+		require.IsType(t, &mockAccessListTx{}, memTx)
+		store := ms.GetKVStore(StoreKeyBank)
+		var counter byte
+		if v := store.Get([]byte("counter")); v != nil {
+			counter = v[0]
+		}
+		store.Set([]byte("counter"), []byte{counter + 1})
+		return &abci.ExecTxResult{}
+	}
+
+	results, err := runner.Run(context.Background(), ms, txs, deliverTx)
+	require.NoError(t, err)
+	require.Len(t, results, len(txs))
+	require.Equal(t, []byte{byte(len(txs))}, ms.GetKVStore(StoreKeyBank).Get([]byte("counter")))
+}
+
 // TestTxRunnerInterface tests that both runners implement TxRunner interface
 func TestTxRunnerInterface(t *testing.T) {
 	decoder := mockTxDecoder
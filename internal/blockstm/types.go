@@ -76,11 +76,13 @@ type MultiStore interface {
 // MVStore is a value type agnostic interface for `MVData`, to keep `MVMemory` value type agnostic.
 type MVStore interface {
 	InitWithEstimates(context.Context, TxnIndex, Locations)
+	InitWithPrefixEstimates(context.Context, TxnIndex, Locations)
 	ConvertWritesToEstimates(txn TxnIndex)
 	ClearEstimates(txn TxnIndex)
 	ConsolidateEmpty(context.Context, TxnIndex)
 
 	ValidateReadSet(context.Context, TxnIndex, *ReadSet, Storage) bool
+	ConflictingRead(context.Context, TxnIndex, *ReadSet, Storage) (ConflictKind, Key, bool)
 	SnapshotToStore(context.Context, storetypes.Store)
 }
 
@@ -0,0 +1,328 @@
+package blockstm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ErrExecutionMismatch is returned by `VerifyRunner` when block-stm diverges from sequential execution.
+var ErrExecutionMismatch = errors.New("block-stm execution diverged from sequential execution")
+
+// maxDiffLines bounds the size of the mismatch error.
+const maxDiffLines = 100
+
+var _ sdk.TxRunner = VerifyRunner{}
+
+// VerifyRunner executes every block twice, with the sequential runner and with block-stm, each
+// on its own branch of the block state, and compares the tx results, events and write sets of the two.
+// The sequential outcome is the one committed; on any mismatch it fails the block with a
+// detailed diff, which halts the node.
+//
+// Only the KV stores are compared, writes to object stores are not comparable and are
+// taken from the sequential execution as is.
+type VerifyRunner struct {
+	seq sdk.TxRunner
+	stm *STMRunner
+}
+
+// NewVerifyRunner checks stm against seq, the runner of the sequential execution, which is
+// expected to deliver every transaction into the store passed to deliverTx.
+func NewVerifyRunner(seq sdk.TxRunner, stm *STMRunner) *VerifyRunner {
+	return &VerifyRunner{seq: seq, stm: stm}
+}
+
+// Unwrap returns the block-stm runner, so the parallel execution guards apply to the verify runner too.
+func (v VerifyRunner) Unwrap() sdk.TxRunner {
+	return v.stm
+}
+
+func (v VerifyRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]byte, deliverTx sdk.DeliverTxFunc) ([]*abci.ExecTxResult, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	index, _, _ := v.stm.storeIndex()
+
+	seqStore := newOverlayMultiStore(ms, index)
+	seqResults, err := v.seq.Run(ctx, msWrapper{seqStore}, txs, func(tx []byte, memTx sdk.Tx, _ storetypes.MultiStore, txIndex int, cache map[string]any) *abci.ExecTxResult {
+		return deliverTx(tx, memTx, msWrapper{seqStore}, txIndex, cache)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stmStore := newOverlayMultiStore(ms, index)
+	stmResults, err := v.stm.Run(ctx, msWrapper{stmStore}, txs, deliverTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if diff := diffExecution(seqResults, stmResults, seqStore, stmStore); len(diff) > 0 {
+		return nil, fmt.Errorf("%w:\n%s", ErrExecutionMismatch, strings.Join(diff, "\n"))
+	}
+
+	seqStore.WriteTo(ms)
+	return seqResults, nil
+}
+
+// diffExecution compares the outcome of two executions of the same block, and returns a
+// human readable line per difference, at most `maxDiffLines` lines.
+func diffExecution(expResults, actResults []*abci.ExecTxResult, expStore, actStore *overlayMultiStore) []string {
+	var diff []string
+	add := func(format string, args ...any) bool {
+		if len(diff) == maxDiffLines {
+			diff = append(diff, "... (truncated)")
+			return false
+		}
+		if len(diff) > maxDiffLines {
+			return false
+		}
+		diff = append(diff, fmt.Sprintf(format, args...))
+		return true
+	}
+
+	if len(expResults) != len(actResults) {
+		add("tx results: sequential has %d, block-stm has %d", len(expResults), len(actResults))
+	}
+	for i := 0; i < min(len(expResults), len(actResults)); i++ {
+		for _, d := range diffTxResult(expResults[i], actResults[i]) {
+			if !add("tx %d: %s", i, d) {
+				return diff
+			}
+		}
+	}
+
+	for i, name := range expStore.names {
+		exp, ok := expStore.stores[i].(*overlayStore[[]byte])
+		if !ok {
+			continue
+		}
+		act := actStore.stores[i].(*overlayStore[[]byte])
+		diffWriteSets(exp.writes, act.writes, func(key, expValue, actValue []byte, expFound, actFound bool) bool {
+			return add("store %s key %X: sequential %s, block-stm %s",
+				name, key, describeWrite(expValue, expFound), describeWrite(actValue, actFound))
+		})
+	}
+
+	return diff
+}
+
+func diffTxResult(exp, act *abci.ExecTxResult) []string {
+	var diff []string
+	if exp.Code != act.Code || exp.Codespace != act.Codespace {
+		diff = append(diff, fmt.Sprintf("code: sequential %s/%d, block-stm %s/%d", exp.Codespace, exp.Code, act.Codespace, act.Code))
+	}
+	if exp.GasWanted != act.GasWanted || exp.GasUsed != act.GasUsed {
+		diff = append(diff, fmt.Sprintf("gas (wanted/used): sequential %d/%d, block-stm %d/%d", exp.GasWanted, exp.GasUsed, act.GasWanted, act.GasUsed))
+	}
+	if !bytes.Equal(exp.Data, act.Data) {
+		diff = append(diff, fmt.Sprintf("data: sequential %X, block-stm %X", exp.Data, act.Data))
+	}
+	// block-stm decodes the txs inside deliverTx, so the log of an undecodable tx carries
+	// the decoding error while the sequential runner only reports the code
+	if exp.Log != act.Log && !isTxDecodeError(exp) {
+		diff = append(diff, fmt.Sprintf("log: sequential %q, block-stm %q", exp.Log, act.Log))
+	}
+	if len(exp.Events) != len(act.Events) {
+		diff = append(diff, fmt.Sprintf("events: sequential has %d, block-stm has %d", len(exp.Events), len(act.Events)))
+	}
+	for j := 0; j < min(len(exp.Events), len(act.Events)); j++ {
+		if !reflect.DeepEqual(exp.Events[j], act.Events[j]) {
+			diff = append(diff, fmt.Sprintf("event %d: sequential %s, block-stm %s", j, exp.Events[j].String(), act.Events[j].String()))
+		}
+	}
+	return diff
+}
+
+func isTxDecodeError(res *abci.ExecTxResult) bool {
+	return res.Codespace == sdkerrors.ErrTxDecode.Codespace() && res.Code == sdkerrors.ErrTxDecode.ABCICode()
+}
+
+// diffWriteSets walks two write sets in key order, and calls cb for every key written
+// differently, cb returns false to stop.
+func diffWriteSets(exp, act *MemDB, cb func(key, expValue, actValue []byte, expFound, actFound bool) bool) {
+	expIter := exp.Iterator(nil, nil)
+	defer expIter.Close()
+	actIter := act.Iterator(nil, nil)
+	defer actIter.Close()
+
+	for expIter.Valid() || actIter.Valid() {
+		switch {
+		case !actIter.Valid() || (expIter.Valid() && bytes.Compare(expIter.Key(), actIter.Key()) < 0):
+			if !cb(expIter.Key(), expIter.Value(), nil, true, false) {
+				return
+			}
+			expIter.Next()
+		case !expIter.Valid() || bytes.Compare(expIter.Key(), actIter.Key()) > 0:
+			if !cb(actIter.Key(), nil, actIter.Value(), false, true) {
+				return
+			}
+			actIter.Next()
+		default:
+			if !bytes.Equal(expIter.Value(), actIter.Value()) {
+				if !cb(expIter.Key(), expIter.Value(), actIter.Value(), true, true) {
+					return
+				}
+			}
+			expIter.Next()
+			actIter.Next()
+		}
+	}
+}
+
+func describeWrite(value []byte, found bool) string {
+	switch {
+	case !found:
+		return "<untouched>"
+	case value == nil:
+		return "<deleted>"
+	default:
+		return fmt.Sprintf("%X", value)
+	}
+}
+
+// overlayMultiStore branches a multi store and records the writes of each store.
+type overlayMultiStore struct {
+	index  map[storetypes.StoreKey]int
+	keys   []storetypes.StoreKey
+	names  []string
+	stores []storetypes.Store
+}
+
+var _ MultiStore = (*overlayMultiStore)(nil)
+
+func newOverlayMultiStore(parent storetypes.MultiStore, index map[storetypes.StoreKey]int) *overlayMultiStore {
+	ms := &overlayMultiStore{
+		index:  index,
+		keys:   make([]storetypes.StoreKey, len(index)),
+		names:  make([]string, len(index)),
+		stores: make([]storetypes.Store, len(index)),
+	}
+	for key, i := range index {
+		ms.keys[i] = key
+		ms.names[i] = key.Name()
+		switch store := parent.GetStore(key).(type) {
+		case storetypes.KVStore:
+			ms.stores[i] = newOverlayStore(store, storetypes.BytesIsZero, storetypes.BytesValueLen)
+		case storetypes.ObjKVStore:
+			ms.stores[i] = newOverlayStore(store, storetypes.AnyIsZero, storetypes.AnyValueLen)
+		default:
+			panic("unsupported store type")
+		}
+	}
+	return ms
+}
+
+func (ms *overlayMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	i, ok := ms.index[key]
+	if !ok {
+		panic(fmt.Sprintf("store %s is not configured for block-stm", key.Name()))
+	}
+	return ms.stores[i]
+}
+
+func (ms *overlayMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return ms.GetStore(key).(storetypes.KVStore)
+}
+
+func (ms *overlayMultiStore) GetObjKVStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	return ms.GetStore(key).(storetypes.ObjKVStore)
+}
+
+// WriteTo flushes the recorded writes into parent.
+func (ms *overlayMultiStore) WriteTo(parent storetypes.MultiStore) {
+	for i, key := range ms.keys {
+		switch store := ms.stores[i].(type) {
+		case *overlayStore[[]byte]:
+			store.writeTo(parent.GetKVStore(key))
+		case *overlayStore[any]:
+			store.writeTo(parent.GetObjKVStore(key))
+		}
+	}
+}
+
+// overlayStore reads through to the parent store and records the writes, the zero value
+// represents deleted keys.
+type overlayStore[V any] struct {
+	parent   storetypes.GKVStore[V]
+	writes   *GMemDB[V]
+	isZero   func(V) bool
+	valueLen func(V) int
+}
+
+var (
+	_ storetypes.KVStore    = (*overlayStore[[]byte])(nil)
+	_ storetypes.ObjKVStore = (*overlayStore[any])(nil)
+)
+
+func newOverlayStore[V any](parent storetypes.GKVStore[V], isZero func(V) bool, valueLen func(V) int) *overlayStore[V] {
+	return &overlayStore[V]{
+		parent:   parent,
+		writes:   NewGMemDB(isZero, valueLen),
+		isZero:   isZero,
+		valueLen: valueLen,
+	}
+}
+
+func (s *overlayStore[V]) Get(key []byte) V {
+	if value, found := s.writes.OverlayGet(key); found {
+		return value
+	}
+	return s.parent.Get(key)
+}
+
+func (s *overlayStore[V]) Has(key []byte) bool {
+	if value, found := s.writes.OverlayGet(key); found {
+		return !s.isZero(value)
+	}
+	return s.parent.Has(key)
+}
+
+func (s *overlayStore[V]) Set(key []byte, value V) {
+	if s.isZero(value) {
+		panic("nil value is not allowed")
+	}
+	s.writes.OverlaySet(bytes.Clone(key), value)
+}
+
+func (s *overlayStore[V]) Delete(key []byte) {
+	var zero V
+	s.writes.OverlaySet(bytes.Clone(key), zero)
+}
+
+func (s *overlayStore[V]) Iterator(start, end []byte) storetypes.GIterator[V] {
+	return NewCacheMergeIterator(s.parent.Iterator(start, end), s.writes.Iterator(start, end), true, nil, s.isZero)
+}
+
+func (s *overlayStore[V]) ReverseIterator(start, end []byte) storetypes.GIterator[V] {
+	return NewCacheMergeIterator(s.parent.ReverseIterator(start, end), s.writes.ReverseIterator(start, end), false, nil, s.isZero)
+}
+
+func (s *overlayStore[V]) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *overlayStore[V]) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewGStore(s, s.isZero, s.valueLen)
+}
+
+func (s *overlayStore[V]) writeTo(parent storetypes.GKVStore[V]) {
+	s.writes.Scan(func(key Key, value V) bool {
+		if s.isZero(value) {
+			parent.Delete(key)
+		} else {
+			parent.Set(key, value)
+		}
+		return true
+	})
+}
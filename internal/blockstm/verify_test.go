@@ -0,0 +1,93 @@
+package blockstm
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// sequentialRunner mirrors the DefaultRunner of baseapp/txnrunner, which can't be imported here.
+type sequentialRunner struct{}
+
+func (sequentialRunner) Run(ctx context.Context, _ storetypes.MultiStore, txs [][]byte, deliverTx sdk.DeliverTxFunc) ([]*abci.ExecTxResult, error) {
+	results := make([]*abci.ExecTxResult, len(txs))
+	for i, rawTx := range txs {
+		if memTx, err := mockTxDecoder(rawTx); err == nil {
+			results[i] = deliverTx(rawTx, memTx, nil, i, nil)
+		} else {
+			results[i] = sdkerrors.ResponseExecTxResultWithEvents(sdkerrors.ErrTxDecode, 0, 0, nil, false)
+		}
+	}
+	return results, ctx.Err()
+}
+
+func newTestVerifyRunner() (*VerifyRunner, storetypes.MultiStore) {
+	stores := []storetypes.StoreKey{StoreKeyAuth, StoreKeyBank}
+	runner := NewVerifyRunner(sequentialRunner{}, NewSTMRunner(mockTxDecoder, stores, 2, false, testCoinDenomFunc))
+	ms := msWrapper{NewMultiMemDB(map[storetypes.StoreKey]int{
+		StoreKeyAuth: 0,
+		StoreKeyBank: 1,
+	})}
+	return runner, ms
+}
+
+func TestVerifyRunner(t *testing.T) {
+	runner, ms := newTestVerifyRunner()
+	counter := []byte("counter")
+
+	txs := [][]byte{{0x01}, {0x02}, {0xFF}, {0x03}}
+	deliverTx := func(tx []byte, memTx sdk.Tx, ms storetypes.MultiStore, txIndex int, cache map[string]any) *abci.ExecTxResult {
+		// block-stm delivers the undecodable txs, which fail before any write as in RunTx
+		if _, err := mockTxDecoder(tx); err != nil {
+			return sdkerrors.ResponseExecTxResultWithEvents(sdkerrors.ErrTxDecode.Wrap(err.Error()), 0, 0, nil, false)
+		}
+		store := ms.GetKVStore(StoreKeyAuth)
+		var n uint64
+		if bz := store.Get(counter); bz != nil {
+			n = binary.BigEndian.Uint64(bz)
+		}
+		store.Set(counter, binary.BigEndian.AppendUint64(nil, n+1))
+		return &abci.ExecTxResult{Data: tx, GasUsed: int64(n)}
+	}
+
+	results, err := runner.Run(context.Background(), ms, txs, deliverTx)
+	require.NoError(t, err)
+	require.Len(t, results, len(txs))
+	require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), results[2].Code)
+	require.Equal(t, int64(2), results[3].GasUsed)
+
+	// only the sequential outcome is written into the parent store
+	require.Equal(t, uint64(3), binary.BigEndian.Uint64(ms.GetKVStore(StoreKeyAuth).Get(counter)))
+}
+
+func TestVerifyRunnerMismatch(t *testing.T) {
+	runner, ms := newTestVerifyRunner()
+
+	txs := [][]byte{{0x01}, {0x02}}
+	var calls atomic.Int32
+	deliverTx := func(tx []byte, memTx sdk.Tx, ms storetypes.MultiStore, txIndex int, cache map[string]any) *abci.ExecTxResult {
+		// the sequential execution runs first, diverge afterwards
+		diverged := calls.Add(1) > int32(len(txs))
+		value := []byte{0x01}
+		if diverged && txIndex == 1 {
+			value = []byte{0x02}
+		}
+		ms.GetKVStore(StoreKeyBank).Set(tx, value)
+		return &abci.ExecTxResult{}
+	}
+
+	_, err := runner.Run(context.Background(), ms, txs, deliverTx)
+	require.ErrorIs(t, err, ErrExecutionMismatch)
+	require.ErrorContains(t, err, "store bank key 02: sequential 01, block-stm 02")
+
+	// nothing is written on mismatch
+	require.Nil(t, ms.GetKVStore(StoreKeyBank).Get([]byte{0x01}))
+}
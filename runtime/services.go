@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/header"
 
 	"github.com/cosmos/cosmos-sdk/runtime/services"
+	collectionsv1 "github.com/cosmos/cosmos-sdk/runtime/services/collections/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -21,6 +22,7 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error {
 		appv1alpha1.RegisterQueryServer(cfg.QueryServer(), services.NewAppQueryService(a.appConfig))
 	}
 	autocliv1.RegisterQueryServer(cfg.QueryServer(), services.NewAutoCLIQueryService(a.ModuleManager.Modules))
+	collectionsv1.RegisterQueryServer(cfg.QueryServer(), services.NewCollectionsQueryService(a.ModuleManager.Modules))
 
 	reflectionSvc, err := services.NewReflectionService()
 	if err != nil {
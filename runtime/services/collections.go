@@ -0,0 +1,240 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+
+	collectionsv1 "github.com/cosmos/cosmos-sdk/runtime/services/collections/v1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MaxIterateLimit is the maximum number of entries returned by a Query/Iterate request, a
+// larger pagination limit is reduced to it.
+const MaxIterateLimit = 1000
+
+// CollectionsQueryService implements the cosmos.collections.v1.Query service.
+type CollectionsQueryService struct {
+	collectionsv1.UnimplementedQueryServer
+
+	schemas map[string]collections.Schema
+}
+
+// NewCollectionsQueryService returns a CollectionsQueryService for the provided modules.
+func NewCollectionsQueryService(appModules map[string]any) *CollectionsQueryService {
+	return &CollectionsQueryService{
+		schemas: ExtractCollectionsSchemas(appModules),
+	}
+}
+
+// ExtractCollectionsSchemas extracts the collections schemas from the provided app modules.
+// A module exposes its schema by implementing CollectionsSchema() collections.Schema.
+//
+// Example Usage:
+//
+//	ExtractCollectionsSchemas(ModuleManager.Modules)
+func ExtractCollectionsSchemas(appModules map[string]any) map[string]collections.Schema {
+	schemas := map[string]collections.Schema{}
+	for modName, mod := range appModules {
+		if mod, ok := mod.(interface {
+			CollectionsSchema() collections.Schema
+		}); ok {
+			schemas[modName] = mod.CollectionsSchema()
+		}
+	}
+	return schemas
+}
+
+func (s CollectionsQueryService) Collections(_ context.Context, req *collectionsv1.QueryCollectionsRequest) (*collectionsv1.QueryCollectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, ok := s.schemas[req.Module]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s has no collections schema", req.Module)
+	}
+
+	colls := schema.ListCollections()
+	descriptors := make([]*collectionsv1.CollectionDescriptor, 0, len(colls))
+	for _, coll := range colls {
+		descriptor := &collectionsv1.CollectionDescriptor{
+			Name:      coll.GetName(),
+			Prefix:    coll.GetPrefix(),
+			KeyType:   coll.KeyCodec().KeyType(),
+			ValueType: coll.ValueCodec().ValueType(),
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+	return &collectionsv1.QueryCollectionsResponse{Collections: descriptors}, nil
+}
+
+func (s CollectionsQueryService) Get(ctx context.Context, req *collectionsv1.QueryGetRequest) (*collectionsv1.QueryGetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	coll, err := s.getCollection(req.Module, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	kc := coll.KeyCodec()
+
+	key, err := encodeKeyJSON(kc, req.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid key: %v", err)
+	}
+
+	raw, err := coll.GetRaw(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "key %s not found in collection %s", req.Key, req.Collection)
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	value, err := valueToJSON(coll.ValueCodec(), raw)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &collectionsv1.QueryGetResponse{Value: value}, nil
+}
+
+func (s CollectionsQueryService) Iterate(ctx context.Context, req *collectionsv1.QueryIterateRequest) (*collectionsv1.QueryIterateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	coll, err := s.getCollection(req.Module, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	kc := coll.KeyCodec()
+
+	var start, end []byte
+	if req.Start != "" {
+		if start, err = encodeKeyJSON(kc, req.Start); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start key: %v", err)
+		}
+	}
+	if req.End != "" {
+		if end, err = encodeKeyJSON(kc, req.End); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end key: %v", err)
+		}
+	}
+
+	page := req.Pagination
+	if page == nil {
+		page = &query.PageRequest{}
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, MaxIterateLimit)
+
+	// the pagination key is the next key to return, which narrows the range
+	// in the direction of the iteration.
+	if len(page.Key) > 0 {
+		if page.Reverse {
+			pageEnd := append(bytes.Clone(page.Key), 0)
+			if end == nil || bytes.Compare(pageEnd, end) < 0 {
+				end = pageEnd
+			}
+		} else if bytes.Compare(page.Key, start) > 0 {
+			start = page.Key
+		}
+	}
+
+	var (
+		entries []*collectionsv1.Entry
+		skipped uint64
+		total   uint64
+		nextKey []byte
+	)
+	err = coll.WalkRaw(ctx, start, end, page.Reverse, func(key, value []byte) (bool, error) {
+		if skipped < page.Offset {
+			skipped++
+			return false, nil
+		}
+		if uint64(len(entries)) == limit {
+			if nextKey == nil {
+				nextKey = bytes.Clone(key)
+			}
+			total++
+			return !page.CountTotal, nil
+		}
+
+		entry, err := entryToJSON(kc, coll.ValueCodec(), key, value)
+		if err != nil {
+			return true, err
+		}
+		entries = append(entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if page.CountTotal && len(page.Key) == 0 {
+		pageRes.Total = skipped + uint64(len(entries)) + total
+	}
+	return &collectionsv1.QueryIterateResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+func (s CollectionsQueryService) getCollection(module, name string) (collections.Collection, error) {
+	schema, ok := s.schemas[module]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s has no collections schema", module)
+	}
+
+	for _, coll := range schema.ListCollections() {
+		if coll.GetName() == name {
+			return coll, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "collection %s not found in module %s", name, module)
+}
+
+func encodeKeyJSON(kc codec.UntypedKeyCodec, keyJSON string) ([]byte, error) {
+	key, err := kc.DecodeJSON([]byte(keyJSON))
+	if err != nil {
+		return nil, err
+	}
+	return kc.Encode(key)
+}
+
+func valueToJSON(vc codec.UntypedValueCodec, raw []byte) (string, error) {
+	value, err := vc.Decode(raw)
+	if err != nil {
+		return "", err
+	}
+	bz, err := vc.EncodeJSON(value)
+	return string(bz), err
+}
+
+func entryToJSON(kc codec.UntypedKeyCodec, vc codec.UntypedValueCodec, rawKey, rawValue []byte) (*collectionsv1.Entry, error) {
+	key, err := kc.Decode(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	keyJSON, err := kc.EncodeJSON(key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := valueToJSON(vc, rawValue)
+	if err != nil {
+		return nil, err
+	}
+	return &collectionsv1.Entry{Key: string(keyJSON), Value: value}, nil
+}
@@ -0,0 +1,65 @@
+package collectionsv1
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+var QueryAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: Query_serviceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod:      "Collections",
+			Use:            "list [module]",
+			Short:          "List the collections of a module",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}},
+		},
+		{
+			RpcMethod: "Get",
+			Use:       "get [module] [collection] [key]",
+			Short:     "Query the value of a collection key",
+			Long:      "Query the value stored under a key of a module collection. The key is JSON encoded, for example '\"cosmos1...\"' or '[\"cosmos1...\",\"stake\"]' for pair keys.",
+			Example:   `$ <appd> query collections get bank supply '"stake"'`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "module"},
+				{ProtoField: "collection"},
+				{ProtoField: "key"},
+			},
+		},
+		{
+			RpcMethod: "Iterate",
+			Use:       "iterate [module] [collection]",
+			Short:     "Query the entries of a collection",
+			Long:      "Query the entries of a module collection, optionally within a range of JSON encoded keys, where start is inclusive and end is exclusive.",
+			Example:   `$ <appd> query collections iterate bank balances --start '["cosmos1...",""]' --limit 10`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "module"},
+				{ProtoField: "collection"},
+			},
+			FlagOptions: map[string]*autocliv1.FlagOptions{
+				"start": {Usage: "JSON encoded key to start iterating from (inclusive)"},
+				"end":   {Usage: "JSON encoded key to stop iterating at (exclusive)"},
+			},
+		},
+	},
+}
+
+// NewCollectionsCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI.
+func NewCollectionsCommands() *collectionsModule {
+	return &collectionsModule{}
+}
+
+type collectionsModule struct{}
+
+func (m collectionsModule) IsOnePerModuleType() {}
+func (m collectionsModule) IsAppModule()        {}
+
+func (m collectionsModule) Name() string {
+	return "collections"
+}
+
+func (m collectionsModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: QueryAutoCLIDescriptor,
+	}
+}
@@ -28,6 +28,18 @@ const (
 	// DefaultBlockSTMPreEstimate controls whether block-stm pre-estimation is enabled by default.
 	DefaultBlockSTMPreEstimate = false
 
+	// DefaultBlockSTMReorderProposals controls whether proposals are reordered for block-stm by default.
+	DefaultBlockSTMReorderProposals = false
+
+	// DefaultBlockSTMConflictReport controls whether block-stm conflict reports are written by default.
+	DefaultBlockSTMConflictReport = false
+
+	// DefaultBlockSTMConflictReportMaxFiles is the default number of block-stm conflict reports kept.
+	DefaultBlockSTMConflictReportMaxFiles = 1000
+
+	// DefaultIAVLBackend is the default storage engine of the IAVL stores.
+	DefaultIAVLBackend = IAVLBackendLegacy
+
 	// DefaultAPIAddress defines the default address to bind the API server to.
 	DefaultAPIAddress = "tcp://localhost:1317"
 
@@ -46,9 +58,29 @@ const (
 const (
 	BlockExecutorSequential = "sequential"
 	BlockExecutorBlockSTM   = "block-stm"
+	// BlockExecutorVerify executes every block both sequentially and with block-stm,
+	// commits the sequential outcome and halts on any mismatch.
+	BlockExecutorVerify = "verify"
 )
 
-var blockExecutors = []string{BlockExecutorSequential, BlockExecutorBlockSTM}
+var blockExecutors = []string{BlockExecutorSequential, BlockExecutorBlockSTM, BlockExecutorVerify}
+
+const (
+	// IAVLBackendLegacy stores the IAVL trees in the application database with github.com/cosmos/iavl.
+	IAVLBackendLegacy = "iavl"
+	// IAVLBackendChangeset stores the IAVL trees in per-version changeset files under data/iavl,
+	// pruned versions are reclaimed by background compactions.
+	IAVLBackendChangeset = "changeset"
+)
+
+var iavlBackends = []string{IAVLBackendLegacy, IAVLBackendChangeset}
+
+var pruningStrategies = []string{
+	pruningtypes.PruningOptionDefault,
+	pruningtypes.PruningOptionNothing,
+	pruningtypes.PruningOptionEverything,
+	pruningtypes.PruningOptionCustom,
+}
 
 // BaseConfig defines the server's basic configuration
 type BaseConfig struct {
@@ -71,6 +103,18 @@ type BaseConfig struct {
 	// BlockSTMPreEstimate enables pre-estimation for block-stm execution.
 	BlockSTMPreEstimate bool `mapstructure:"block-stm-pre-estimate"`
 
+	// BlockSTMReorderProposals reorders the transactions of the proposals built
+	// by this node to reduce conflicts during block-stm execution.
+	BlockSTMReorderProposals bool `mapstructure:"block-stm-reorder-proposals"`
+
+	// BlockSTMConflictReport writes a per-transaction conflict report for every
+	// block with re-executions under <home>/data/blockstm-reports.
+	BlockSTMConflictReport bool `mapstructure:"block-stm-conflict-report"`
+
+	// BlockSTMConflictReportMaxFiles is the number of most recent conflict
+	// reports kept, older ones are removed. 0 keeps all reports.
+	BlockSTMConflictReportMaxFiles int `mapstructure:"block-stm-conflict-report-max-files"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -117,11 +161,22 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// IAVLBackend selects the storage engine of the IAVL stores.
+	IAVLBackend string `mapstructure:"iavl-backend"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
 }
 
+// StorePruningConfig overrides the pruning strategy of a single store, the
+// fields have the same meaning as the pruning-* fields of BaseConfig.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+}
+
 // APIConfig defines the API listener configuration.
 type APIConfig struct {
 	// Enable defines if the API server should be enabled.
@@ -223,6 +278,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		File FileListenerConfig `mapstructure:"file"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -230,8 +286,34 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the file streaming listener
+	FileListenerConfig struct {
+		Keys           []string `mapstructure:"keys"`
+		Dir            string   `mapstructure:"dir"`
+		MaxSegmentSize int64    `mapstructure:"max-segment-size"`
+		DisableFsync   bool     `mapstructure:"disable-fsync"`
+	}
 )
 
+// IndexerConfig defines the configuration of the built-in state indexer, which
+// decodes the committed state of the modules with their collections schema and
+// writes it to the configured targets.
+type IndexerConfig struct {
+	// Target configures the indexer targets by target name, the indexer is
+	// disabled if it is empty.
+	Target map[string]IndexerTargetConfig `mapstructure:"target"`
+	// ChannelBufferSize is the buffer size of the channels feeding the targets.
+	ChannelBufferSize int `mapstructure:"channel_buffer_size"`
+}
+
+// IndexerTargetConfig defines the configuration of an indexer target.
+type IndexerTargetConfig struct {
+	// Type is the registered indexer type of the target, e.g. sqlite.
+	Type string `mapstructure:"type"`
+	// Config holds the options specific to the indexer type.
+	Config map[string]any `mapstructure:"config"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -244,6 +326,11 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+
+	// StorePruning overrides the pruning strategy of the stores by store name.
+	StorePruning map[string]StorePruningConfig `mapstructure:"store-pruning"`
+
+	Indexer IndexerConfig `mapstructure:"indexer"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -269,20 +356,24 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			BlockExecutor:       DefaultBlockExecutor,
-			BlockSTMWorkers:     DefaultBlockSTMWorkers,
-			BlockSTMPreEstimate: DefaultBlockSTMPreEstimate,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:                   defaultMinGasPrices,
+			QueryGasLimit:                  0,
+			BlockExecutor:                  DefaultBlockExecutor,
+			BlockSTMWorkers:                DefaultBlockSTMWorkers,
+			BlockSTMPreEstimate:            DefaultBlockSTMPreEstimate,
+			BlockSTMReorderProposals:       DefaultBlockSTMReorderProposals,
+			BlockSTMConflictReport:         DefaultBlockSTMConflictReport,
+			BlockSTMConflictReportMaxFiles: DefaultBlockSTMConflictReportMaxFiles,
+			InterBlockCache:                true,
+			Pruning:                        pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:              "0",
+			PruningInterval:                "0",
+			MinRetainBlocks:                0,
+			IndexEvents:                    make([]string, 0),
+			IAVLCacheSize:                  781250,
+			IAVLDisableFastNode:            false,
+			IAVLBackend:                    DefaultIAVLBackend,
+			AppDBBackend:                   "",
 		},
 		//nolint:staticcheck // TODO: switch to OpenTelemetry
 		Telemetry: telemetry.Config{
@@ -316,10 +407,16 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys: []string{},
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
 		},
+		Indexer: IndexerConfig{
+			ChannelBufferSize: 1024,
+		},
 	}
 }
 
@@ -374,9 +471,32 @@ func (c Config) ValidateBasic() error {
 		return sdkerrors.ErrAppConfig.Wrapf("invalid block executor %q, available types: %v", c.BlockExecutor, blockExecutors)
 	}
 
+	if !slices.Contains(iavlBackends, c.IAVLBackend) {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid iavl backend %q, available types: %v", c.IAVLBackend, iavlBackends)
+	}
+
 	if c.BlockSTMWorkers < 0 {
 		return sdkerrors.ErrAppConfig.Wrapf("invalid block-stm-workers %d: must be >= 0", c.BlockSTMWorkers)
 	}
+	if c.BlockSTMConflictReportMaxFiles < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid block-stm-conflict-report-max-files %d: must be >= 0", c.BlockSTMConflictReportMaxFiles)
+	}
+
+	for name, storeCfg := range c.StorePruning {
+		if !slices.Contains(pruningStrategies, storeCfg.Pruning) {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid pruning strategy %q for store %s, available strategies: %v", storeCfg.Pruning, name, pruningStrategies)
+		}
+	}
+
+	if c.Streaming.File.MaxSegmentSize < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid streaming max-segment-size %d: must be >= 0", c.Streaming.File.MaxSegmentSize)
+	}
+
+	for name, target := range c.Indexer.Target {
+		if target.Type == "" {
+			return sdkerrors.ErrAppConfig.Wrapf("missing type for indexer target %s", name)
+		}
+	}
 
 	if c.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
 		return sdkerrors.ErrAppConfig.Wrapf(
@@ -384,5 +504,11 @@ func (c Config) ValidateBasic() error {
 		)
 	}
 
+	if c.IAVLBackend == IAVLBackendChangeset && c.StateSync.SnapshotInterval > 0 {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"cannot enable state sync snapshots with the '%s' iavl backend", IAVLBackendChangeset,
+		)
+	}
+
 	return nil
 }
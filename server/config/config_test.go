@@ -33,6 +33,71 @@ func TestValidateBasicBlockSTMConfig(t *testing.T) {
 	require.ErrorContains(t, cfg.ValidateBasic(), "invalid block-stm-workers")
 }
 
+func TestValidateBasicIAVLBackendConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.IAVLBackend = IAVLBackendChangeset
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotInterval = 100
+	require.ErrorContains(t, cfg.ValidateBasic(), "cannot enable state sync snapshots with the 'changeset' iavl backend")
+	cfg.IAVLBackend = "rocks"
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid iavl backend")
+}
+
+func TestStorePruningWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.StorePruning = map[string]StorePruningConfig{
+		"bank": {Pruning: "nothing", PruningKeepRecent: "0", PruningInterval: "0"},
+		"ibc":  {Pruning: "custom", PruningKeepRecent: "100", PruningInterval: "10"},
+	}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.StorePruning, cfg.StorePruning)
+
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.StorePruning["ibc"] = StorePruningConfig{Pruning: "recent"}
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid pruning strategy \"recent\" for store ibc")
+}
+
+func TestIndexerWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Indexer.Target = map[string]IndexerTargetConfig{
+		"local":  {Type: "sqlite", Config: map[string]any{"path": "/tmp/index.sqlite"}},
+		"remote": {Type: "postgres"},
+	}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.Indexer, cfg.Indexer)
+
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Indexer.Target["remote"] = IndexerTargetConfig{}
+	require.ErrorContains(t, cfg.ValidateBasic(), "missing type for indexer target remote")
+}
+
+func TestValidateBasicStreamingConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Streaming.File.MaxSegmentSize = -1
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid streaming max-segment-size")
+}
+
 func TestGetAndSetMinimumGas(t *testing.T) {
 	cfg := DefaultConfig()
 
@@ -67,6 +132,12 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Keys:           []string{"bank"},
+				Dir:            "data/streaming",
+				MaxSegmentSize: 1 << 20,
+				DisableFsync:   true,
+			},
 		},
 	}
 
@@ -83,6 +154,10 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`keys = ["bank", ]`,
+		`dir = "data/streaming"`,
+		`max-segment-size = 1048576`,
+		`disable-fsync = true`,
 	}
 
 	for _, line := range expectedLines {
@@ -25,7 +25,10 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
-# BlockExecutor sets block execution mode: "block-stm" or "sequential".
+# BlockExecutor sets block execution mode: "block-stm", "sequential" or "verify".
+# "verify" is a shadow mode to build confidence in block-stm: every block is executed both sequentially
+# and with block-stm, the sequential outcome is committed, and the node halts with a detailed diff if the
+# tx results, events or write sets differ. It roughly doubles the block execution time.
 block-executor = "{{ .BaseConfig.BlockExecutor }}"
 
 # BlockSTMWorkers sets the number of workers for block-stm execution (0 = auto).
@@ -34,6 +37,21 @@ block-stm-workers = {{ .BaseConfig.BlockSTMWorkers }}
 # BlockSTMPreEstimate enables pre-estimation for block-stm execution.
 block-stm-pre-estimate = {{ .BaseConfig.BlockSTMPreEstimate }}
 
+# BlockSTMReorderProposals reorders the transactions of the proposals built by this node, using the
+# estimated write sets and the signers of each transaction, so that conflicting transactions are spread
+# out and block-stm stalls less. The sequence order of each sender is preserved.
+block-stm-reorder-proposals = {{ .BaseConfig.BlockSTMReorderProposals }}
+
+# BlockSTMConflictReport writes a JSON report for every block in which block-stm re-executed or suspended
+# a transaction, into <home>/data/blockstm-reports/<height>.json. For each transaction it lists the number
+# of incarnations, the store keys that failed validation and the earlier transactions it waited on.
+# It's a debugging aid, the reports are written in the background and may be dropped under load.
+block-stm-conflict-report = {{ .BaseConfig.BlockSTMConflictReport }}
+
+# BlockSTMConflictReportMaxFiles is the number of most recent conflict reports kept, older ones are
+# removed (0 = keep all).
+block-stm-conflict-report-max-files = {{ .BaseConfig.BlockSTMConflictReportMaxFiles }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -90,6 +108,13 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# IAVLBackend selects the storage engine of the IAVL stores: "iavl" or "changeset".
+# "changeset" appends the nodes of each version to changeset files under data/iavl and reclaims pruned
+# versions with background compactions, instead of storing every node in the application database.
+# It is not compatible with existing data, and doesn't support state sync snapshots nor query proofs yet:
+# snapshot-interval must be 0, and queries with prove = true fail.
+iavl-backend = "{{ .BaseConfig.IAVLBackend }}"
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -222,7 +247,8 @@ enable = {{ .GRPCWeb.Enable }}
 [state-sync]
 
 # snapshot-interval specifies the block interval at which local state sync snapshots are
-# taken (0 to disable).
+# taken (0 to disable). It must be 0 with the "changeset" iavl-backend, which can't take nor
+# restore snapshots yet: the node refuses to start otherwise.
 snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
@@ -254,6 +280,25 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the file streaming listener, which writes
+# every block and its state changes to segment files replayable from any height.
+# The node stops if a block can't be written, so that no height is missing.
+[streaming.file]
+
+# List of kv store keys to write to the files, ["*"] to write all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# The directory of the segment files, relative to the node home if not absolute.
+# Streaming to files is only enabled if this is set.
+dir = "{{ .Streaming.File.Dir }}"
+
+# The size in bytes above which a new segment file is started, 128MiB if zero.
+max-segment-size = {{ .Streaming.File.MaxSegmentSize }}
+
+# disable-fsync skips syncing the files at each commit, a crash of the machine may then lose
+# the last blocks written.
+disable-fsync = {{ .Streaming.File.DisableFsync }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -266,6 +311,66 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+###############################################################################
+###                         Store Pruning                                   ###
+###############################################################################
+
+# Per-store pruning strategies, overriding the pruning-* settings above for the given stores.
+# A store without an entry follows the pruning-* settings. Queries at a height pruned from a
+# store only fail for that store, with a "height pruned for this store" error.
+#
+# Example, keeping the full history of bank and staking and only the recent states of ibc:
+#
+# [store-pruning.bank]
+# pruning = "nothing"
+#
+# [store-pruning.staking]
+# pruning = "nothing"
+#
+# [store-pruning.ibc]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+{{- range $name, $store := .StorePruning }}
+
+[store-pruning.{{ $name }}]
+pruning = "{{ $store.Pruning }}"
+pruning-keep-recent = "{{ $store.PruningKeepRecent }}"
+pruning-interval = "{{ $store.PruningInterval }}"
+{{- end }}
+
+###############################################################################
+###                         Indexer                                         ###
+###############################################################################
+
+# The built-in indexer decodes the state committed by the modules having a collections schema
+# and writes it as typed tables to the configured targets. It is enabled by configuring at least
+# one target, each target starts indexing from the first block committed after it is enabled.
+[indexer]
+
+# channel_buffer_size is the buffer size of the channels feeding the targets.
+channel_buffer_size = {{ .Indexer.ChannelBufferSize }}
+
+# Example, indexing the state into a SQLite database:
+#
+# [indexer.target.local]
+# type = "sqlite"
+#
+# [indexer.target.local.config]
+# path = "/home/user/.simapp/data/index.sqlite"
+{{- range $name, $target := .Indexer.Target }}
+
+[indexer.target.{{ $name }}]
+type = "{{ $target.Type }}"
+{{- if $target.Config }}
+
+[indexer.target.{{ $name }}.config]
+{{- range $key, $value := $target.Config }}
+{{ $key }} = {{ printf "%q" (print $value) }}
+{{- end }}
+{{- end }}
+{{- end }}
 `
 
 var configTemplate *template.Template
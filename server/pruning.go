@@ -14,7 +14,28 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(appOpts.Get(FlagPruning), appOpts.Get(FlagPruningKeepRecent), appOpts.Get(FlagPruningInterval))
+}
+
+// GetStorePruningOptionsFromFlags parses the store-pruning section of the app
+// config and returns the PruningOptions of each store listed there.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	storeOpts := make(map[string]pruningtypes.PruningOptions)
+	for name, cfg := range cast.ToStringMap(appOpts.Get(FlagStorePruning)) {
+		storeCfg := cast.ToStringMap(cfg)
+		opts, err := parsePruningOptions(storeCfg[FlagPruning], storeCfg[FlagPruningKeepRecent], storeCfg[FlagPruningInterval])
+		if err != nil {
+			return nil, fmt.Errorf("store %s: %w", name, err)
+		}
+
+		storeOpts[name] = opts
+	}
+
+	return storeOpts, nil
+}
+
+func parsePruningOptions(strategyOpt, keepRecentOpt, intervalOpt any) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(strategyOpt))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -22,8 +43,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecentOpt),
+			cast.ToUint64(intervalOpt),
 		)
 
 		if err := opts.Validate(); err != nil {
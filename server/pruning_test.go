@@ -64,3 +64,28 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagStorePruning, map[string]any{
+		"bank": map[string]any{FlagPruning: pruningtypes.PruningOptionNothing},
+		"ibc": map[string]any{
+			FlagPruning:           pruningtypes.PruningOptionCustom,
+			FlagPruningKeepRecent: "100",
+			FlagPruningInterval:   "10",
+		},
+	})
+
+	opts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"bank": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		"ibc":  pruningtypes.NewCustomPruningOptions(100, 10),
+	}, opts)
+
+	v.Set(FlagStorePruning, map[string]any{
+		"ibc": map[string]any{FlagPruning: pruningtypes.PruningOptionCustom, FlagPruningInterval: "1"},
+	})
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.ErrorContains(t, err, "store ibc: invalid custom pruning options")
+}
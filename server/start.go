@@ -78,6 +78,8 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLSyncPruning     = "iavl-sync-pruning"
+	FlagIAVLBackend         = "iavl-backend"
+	FlagStorePruning        = "store-pruning"
 	FlagShutdownGrace       = "shutdown-grace"
 
 	// state sync-related flags
@@ -111,9 +113,12 @@ const (
 
 	// block stm related flags
 
-	FlagBlockExecutor       = "block-executor"
-	FlagBlockSTMWorkers     = "block-stm-workers"
-	FlagBlockSTMPreEstimate = "block-stm-pre-estimate"
+	FlagBlockExecutor          = "block-executor"
+	FlagBlockSTMWorkers        = "block-stm-workers"
+	FlagBlockSTMPreEstimate    = "block-stm-pre-estimate"
+	FlagBlockSTMReorder        = "block-stm-reorder-proposals"
+	FlagBlockSTMReport         = "block-stm-conflict-report"
+	FlagBlockSTMReportMaxFiles = "block-stm-conflict-report-max-files"
 
 	// testnet keys
 
@@ -1030,11 +1035,15 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().String(FlagIAVLBackend, serverconfig.DefaultIAVLBackend, "Storage engine of the IAVL stores (iavl|changeset)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
-	cmd.Flags().String(FlagBlockExecutor, serverconfig.DefaultBlockExecutor, "Block executor mode (block-stm|sequential)")
+	cmd.Flags().String(FlagBlockExecutor, serverconfig.DefaultBlockExecutor, "Block executor mode (block-stm|sequential|verify)")
 	cmd.Flags().Int(FlagBlockSTMWorkers, serverconfig.DefaultBlockSTMWorkers, "Number of workers for block-stm execution (0 = auto)")
 	cmd.Flags().Bool(FlagBlockSTMPreEstimate, serverconfig.DefaultBlockSTMPreEstimate, "Enable pre-estimation for block-stm execution")
+	cmd.Flags().Bool(FlagBlockSTMReorder, serverconfig.DefaultBlockSTMReorderProposals, "Reorder proposed transactions to reduce block-stm conflicts")
+	cmd.Flags().Bool(FlagBlockSTMReport, serverconfig.DefaultBlockSTMConflictReport, "Write per-transaction block-stm conflict reports under <home>/data/blockstm-reports")
+	cmd.Flags().Int(FlagBlockSTMReportMaxFiles, serverconfig.DefaultBlockSTMConflictReportMaxFiles, "Number of most recent block-stm conflict reports kept (0 = keep all)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -28,9 +28,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/iavl"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/v2"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
 	"github.com/cosmos/cosmos-sdk/store/v2/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/store/v2/snapshots/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -515,6 +517,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	storePruningOpts, err := GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -555,7 +562,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		)
 	}
 
-	return []func(*baseapp.BaseApp){
+	opts := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -572,6 +579,39 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
 	}
+
+	for name, storeOpts := range storePruningOpts {
+		opts = append(opts, baseapp.SetStorePruning(name, storeOpts))
+	}
+
+	if _, err := GetIAVLStoreLoader(appOpts, log.NewNopLogger()); err != nil {
+		panic(err)
+	}
+	// the changeset backend can't take nor restore snapshots, see rootmulti.VersionedStore
+	if cast.ToString(appOpts.Get(FlagIAVLBackend)) == config.IAVLBackendChangeset && snapshotOptions.Interval > 0 {
+		panic(fmt.Errorf("cannot enable state sync snapshots with the '%s' iavl backend", config.IAVLBackendChangeset))
+	}
+	opts = append(opts, func(bapp *baseapp.BaseApp) {
+		if loader, _ := GetIAVLStoreLoader(appOpts, bapp.Logger()); loader != nil {
+			baseapp.SetIAVLStoreLoader(loader)(bapp)
+		}
+	})
+
+	return opts
+}
+
+// GetIAVLStoreLoader returns the loader of the IAVL stores of the iavl-backend selected in the
+// app config, nil for the default backend.
+func GetIAVLStoreLoader(appOpts types.AppOptions, logger log.Logger) (rootmulti.IAVLStoreLoader, error) {
+	switch backend := cast.ToString(appOpts.Get(FlagIAVLBackend)); backend {
+	case "", config.IAVLBackendLegacy:
+		return nil, nil
+	case config.IAVLBackendChangeset:
+		iavlDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "iavl")
+		return iavl.NewStoreLoader(iavlDir, logger, iavl.DefaultOptions()), nil
+	default:
+		return nil, fmt.Errorf("unknown iavl backend %q", backend)
+	}
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
@@ -8,6 +8,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	_ "github.com/mattn/go-sqlite3" // register the SQLite driver of the sqlite indexer
 	"github.com/spf13/cast"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
@@ -16,6 +17,7 @@ import (
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log/v2"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/baseapp/blockexec"
@@ -26,8 +28,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/indexer/sqlite" // register the sqlite indexer type
 	"github.com/cosmos/cosmos-sdk/runtime"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
+	collectionsv1 "github.com/cosmos/cosmos-sdk/runtime/services/collections/v1"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -36,6 +40,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -69,6 +74,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -106,6 +114,12 @@ var (
 		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
 		govtypes.ModuleName:                 {authtypes.Burner},
+		feemarkettypes.ModuleName:           {authtypes.Burner},
+	}
+
+	// names of the module stores which aren't named after their module
+	moduleStoreNames = map[string]string{
+		authtypes.ModuleName: authtypes.StoreKey,
 	}
 )
 
@@ -125,7 +139,8 @@ type SimApp struct {
 	interfaceRegistry types.InterfaceRegistry
 
 	// keys to access the substores
-	keys map[string]*storetypes.KVStoreKey
+	keys  map[string]*storetypes.KVStoreKey
+	okeys map[string]*storetypes.ObjectStoreKey
 
 	// essential keepers
 	AccountKeeper         authkeeper.AccountKeeper
@@ -140,9 +155,10 @@ type SimApp struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// supplementary keepers
-	FeeGrantKeeper feegrantkeeper.Keeper
-	AuthzKeeper    authzkeeper.Keeper
-	EpochsKeeper   *epochskeeper.Keeper
+	FeeGrantKeeper  feegrantkeeper.Keeper
+	AuthzKeeper     authzkeeper.Keeper
+	EpochsKeeper    *epochskeeper.Keeper
+	FeeMarketKeeper feemarketkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -219,6 +235,28 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
+	// The app-side mempool and the proposal handler order the transactions of
+	// every sequence lane of an account independently, each lane being a sender
+	// of its own, see ante.SequenceLaneSignerExtractionAdapter.
+	if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs >= 0 {
+		mempoolOpt := func(bApp *baseapp.BaseApp) {
+			signerExtractor := ante.NewSequenceLaneSignerExtractionAdapter(nil)
+
+			mempoolConfig := mempool.DefaultPriorityNonceMempoolConfig()
+			mempoolConfig.MaxTx = maxTxs
+			mempoolConfig.SignerExtractor = signerExtractor
+			mp := mempool.NewPriorityMempool(mempoolConfig)
+
+			abciPropHandler := baseapp.NewDefaultProposalHandler(mp, bApp)
+			abciPropHandler.SetSignerExtractionAdapter(signerExtractor)
+
+			bApp.SetMempool(mp)
+			bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
+			bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
+		}
+		baseAppOptions = append(baseAppOptions, mempoolOpt)
+	}
+
 	// create and set dummy vote extension handler
 	voteExtOp := func(bApp *baseapp.BaseApp) {
 		voteExtHandler := NewVoteExtensionHandler()
@@ -245,14 +283,26 @@ func NewSimApp(
 		evidencetypes.StoreKey,
 		authzkeeper.StoreKey,
 		epochstypes.StoreKey,
+		feemarkettypes.StoreKey,
 	)
 
-	stores := make([]storetypes.StoreKey, 0, len(keys))
+	// the object stores hold the per-transaction slots of the bank virtual balances,
+	// which the fees are deferred to, see ante.HandlerOptions.DeferFees, and of the
+	// gas and base fees accumulated by the fee market
+	okeys := storetypes.NewObjectStoreKeys(banktypes.ObjectStoreKey, feemarkettypes.ObjectStoreKey)
+
+	stores := make([]storetypes.StoreKey, 0, len(keys)+len(okeys))
 	for _, k := range keys {
 		stores = append(stores, k)
 	}
+	for _, k := range okeys {
+		stores = append(stores, k)
+	}
 	blockexec.Apply(bApp, appOpts, stores, txConfig.TxDecoder(),
 		func(storetypes.MultiStore) string { return sdk.DefaultBondDenom },
+		// each slot is only read and written by its own transaction
+		blockexec.WithCommutativeKeys(banktypes.ObjectStoreKey, banktypes.VirtualBalancesPrefix.Bytes()),
+		blockexec.WithCommutativeKeys(feemarkettypes.ObjectStoreKey),
 	)
 
 	// register streaming services
@@ -267,6 +317,7 @@ func NewSimApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		okeys:             okeys,
 	}
 
 	// set the BaseApp's parameter store
@@ -297,7 +348,7 @@ func NewSimApp(
 		BlockedAddresses(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
-	)
+	).WithObjStoreKey(okeys[banktypes.ObjectStoreKey])
 
 	txConfig, err := authtx.NewTxConfigWithOptions(
 		appCodec,
@@ -383,6 +434,9 @@ func NewSimApp(
 		app.BaseApp,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// reject the messages of modules whose incremental store migration is in progress, other
+	// circuit breakers such as the x/circuit keeper can be added to the list
+	app.SetCircuitBreaker(baseapp.CircuitBreakers{app.UpgradeKeeper})
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -441,6 +495,17 @@ func NewSimApp(
 		),
 	)
 
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[feemarkettypes.StoreKey]),
+		okeys[feemarkettypes.ObjectStoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	/****  Module Options ****/
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -464,8 +529,17 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		epochs.NewAppModule(app.EpochsKeeper),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper, app.AccountKeeper),
 	)
 
+	// enable the built-in indexer if targets are configured, the modules are keyed by store name
+	// so that the writes of each store are decoded by the module owning it
+	if len(cast.ToStringMap(appOpts.Get("indexer.target"))) > 0 {
+		if err := app.EnableIndexer(appOpts.Get(baseapp.IndexerTomlKey), keys, byStoreName(app.ModuleManager.Modules), app.AccountKeeper.AddressCodec()); err != nil {
+			panic(err)
+		}
+	}
+
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
 	// non-dependent module elements, such as codec registration and genesis verification.
 	// By default it is composed of all the module from the module manager.
@@ -500,8 +574,11 @@ func NewSimApp(
 		authz.ModuleName,
 		epochstypes.ModuleName,
 	)
+	// The fee market collects the base fees after the bank module credited the
+	// deferred fees to the fee collector.
 	app.ModuleManager.SetOrderEndBlockers(
 		banktypes.ModuleName,
+		feemarkettypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -527,6 +604,7 @@ func NewSimApp(
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		epochstypes.ModuleName,
+		feemarkettypes.ModuleName,
 	}
 
 	exportModuleOrder := []string{
@@ -545,6 +623,7 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		epochstypes.ModuleName,
+		feemarkettypes.ModuleName,
 	}
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -564,6 +643,7 @@ func NewSimApp(
 	app.RegisterUpgradeHandlers()
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
+	collectionsv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewCollectionsQueryService(app.ModuleManager.Modules))
 
 	reflectionSvc, err := runtimeservices.NewReflectionService()
 	if err != nil {
@@ -587,6 +667,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountObjectStores(okeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -627,6 +708,13 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			// the fee collector balance is credited once at the end of the block, instead
+			// of being written by every transaction
+			DeferFees: true,
+			// accept the sequence lanes selected in the critical extension options
+			ExtensionOptionChecker: ante.AcceptSequenceLaneExtensionOption(nil),
+			// the fees must pay for the gas limit at the base fee of the fee market
+			TxFeeChecker: app.FeeMarketKeeper.CheckTxFee,
 			SigVerifyOptions: []ante.SigVerificationDecoratorOption{
 				// change below as needed.
 				ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
@@ -643,13 +731,14 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 }
 
 func (app *SimApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler := sdk.ChainPostDecorators(
+		posthandler.NewAccessListDecorator(posthandler.DefaultUndeclaredWriteGas),
+		// the fee market counts the gas used by the transaction, it must come last
+		feemarketkeeper.NewGasUsedDecorator(app.FeeMarketKeeper),
 	)
-	if err != nil {
-		panic(err)
-	}
 
+	// the access list enforcement inspects the keys written by the messages
+	app.SetTrackTxWrites(true)
 	app.SetPostHandler(postHandler)
 }
 
@@ -741,8 +830,16 @@ func (app *SimApp) AutoCliOpts() autocli.AppOptions {
 }
 
 // DefaultGenesis returns a default genesis from the registered AppModuleBasic's.
+// The base fee of the fee market is disabled, as the test transactions pay
+// fixed fees below it.
 func (a *SimApp) DefaultGenesis() map[string]json.RawMessage {
-	return a.BasicModuleManager.DefaultGenesis(a.appCodec)
+	genesis := a.BasicModuleManager.DefaultGenesis(a.appCodec)
+
+	feeMarketGenesis := feemarkettypes.DefaultGenesisState()
+	feeMarketGenesis.Params.Enabled = false
+	genesis[feemarkettypes.ModuleName] = a.appCodec.MustMarshalJSON(feeMarketGenesis)
+
+	return genesis
 }
 
 // GetKey returns the KVStoreKey for the provided store key.
@@ -762,6 +859,28 @@ func (app *SimApp) GetStoreKeys() []storetypes.StoreKey {
 	return keys
 }
 
+// ModuleCodecs returns the codecs decoding the state of the modules by store name.
+func (app *SimApp) ModuleCodecs() (map[string]schema.ModuleCodec, error) {
+	codecs, err := app.ModuleManager.ModuleCodecs()
+	if err != nil {
+		return nil, err
+	}
+
+	return byStoreName(codecs), nil
+}
+
+// byStoreName re-keys a map keyed by module name with the names of the module stores.
+func byStoreName[V any](byModule map[string]V) map[string]V {
+	byStore := make(map[string]V, len(byModule))
+	for name, v := range byModule {
+		if storeName, ok := moduleStoreNames[name]; ok {
+			name = storeName
+		}
+		byStore[name] = v
+	}
+	return byStore
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
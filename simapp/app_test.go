@@ -3,6 +3,7 @@ package simapp
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,23 +14,31 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
+	collectionsv1 "github.com/cosmos/cosmos-sdk/runtime/services/collections/v1"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -46,6 +55,8 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -401,20 +412,21 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.ModuleManager.RunMigrations(
 				app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()}), configurator,
 				module.VersionMap{
-					banktypes.ModuleName:     1,
-					authtypes.ModuleName:     auth.AppModule{}.ConsensusVersion(),
-					authz.ModuleName:         authzmodule.AppModule{}.ConsensusVersion(),
-					stakingtypes.ModuleName:  staking.AppModule{}.ConsensusVersion(),
-					minttypes.ModuleName:     mint.AppModule{}.ConsensusVersion(),
-					distrtypes.ModuleName:    distribution.AppModule{}.ConsensusVersion(),
-					slashingtypes.ModuleName: slashing.AppModule{}.ConsensusVersion(),
-					govtypes.ModuleName:      gov.AppModule{}.ConsensusVersion(),
-					upgradetypes.ModuleName:  upgrade.AppModule{}.ConsensusVersion(),
-					vestingtypes.ModuleName:  vesting.AppModule{}.ConsensusVersion(),
-					feegrant.ModuleName:      feegrantmodule.AppModule{}.ConsensusVersion(),
-					evidencetypes.ModuleName: evidence.AppModule{}.ConsensusVersion(),
-					genutiltypes.ModuleName:  genutil.AppModule{}.ConsensusVersion(),
-					epochstypes.ModuleName:   epochs.AppModule{}.ConsensusVersion(),
+					banktypes.ModuleName:      1,
+					authtypes.ModuleName:      auth.AppModule{}.ConsensusVersion(),
+					authz.ModuleName:          authzmodule.AppModule{}.ConsensusVersion(),
+					stakingtypes.ModuleName:   staking.AppModule{}.ConsensusVersion(),
+					minttypes.ModuleName:      mint.AppModule{}.ConsensusVersion(),
+					distrtypes.ModuleName:     distribution.AppModule{}.ConsensusVersion(),
+					slashingtypes.ModuleName:  slashing.AppModule{}.ConsensusVersion(),
+					govtypes.ModuleName:       gov.AppModule{}.ConsensusVersion(),
+					upgradetypes.ModuleName:   upgrade.AppModule{}.ConsensusVersion(),
+					vestingtypes.ModuleName:   vesting.AppModule{}.ConsensusVersion(),
+					feegrant.ModuleName:       feegrantmodule.AppModule{}.ConsensusVersion(),
+					evidencetypes.ModuleName:  evidence.AppModule{}.ConsensusVersion(),
+					genutiltypes.ModuleName:   genutil.AppModule{}.ConsensusVersion(),
+					epochstypes.ModuleName:    epochs.AppModule{}.ConsensusVersion(),
+					feemarkettypes.ModuleName: feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -560,3 +572,75 @@ func TestAddressCodecFactory(t *testing.T) {
 	_, ok = consAddressCodec.(customAddressCodec)
 	require.True(t, ok)
 }
+
+func TestModuleCodecs(t *testing.T) {
+	app := Setup(t, false)
+	codecs, err := app.ModuleCodecs()
+	require.NoError(t, err)
+	require.Contains(t, codecs, authtypes.StoreKey)
+	require.Contains(t, codecs, banktypes.StoreKey)
+
+	ctx := app.NewContext(true)
+	addr := sdk.AccAddress("addr________________")
+	balanceKey := collections.Join(addr, "stake")
+	require.NoError(t, app.BankKeeper.Balances.Set(ctx, balanceKey, math.NewInt(10)))
+
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, app.BankKeeper.Balances.KeyCodec(), balanceKey)
+	require.NoError(t, err)
+	value := ctx.KVStore(app.GetKey(banktypes.StoreKey)).Get(key)
+	require.NotNil(t, value)
+
+	updates, err := codecs[banktypes.StoreKey].KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, "balances", updates[0].TypeName)
+	require.Equal(t, []any{[]byte(addr), "stake"}, updates[0].Key)
+	require.Equal(t, "10", updates[0].Value)
+}
+
+func TestCollectionsQueryService(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(true)
+	addr := sdk.AccAddress("addr________________")
+	require.NoError(t, app.BankKeeper.Balances.Set(ctx, collections.Join(addr, "stake"), math.NewInt(10)))
+	svc := runtimeservices.NewCollectionsQueryService(app.ModuleManager.Modules)
+
+	colls, err := svc.Collections(ctx, &collectionsv1.QueryCollectionsRequest{Module: banktypes.ModuleName})
+	require.NoError(t, err)
+	require.Contains(t, colls.Collections, &collectionsv1.CollectionDescriptor{
+		Name:      "balances",
+		Prefix:    banktypes.BalancesPrefix.Bytes(),
+		KeyType:   app.BankKeeper.Balances.KeyCodec().KeyType(),
+		ValueType: banktypes.BalanceValueCodec.ValueType(),
+	})
+
+	key := fmt.Sprintf(`[%q,"stake"]`, addr.String())
+	get, err := svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: banktypes.ModuleName, Collection: "balances", Key: key})
+	require.NoError(t, err)
+	require.Equal(t, `"10"`, get.Value)
+
+	iter, err := svc.Iterate(ctx, &collectionsv1.QueryIterateRequest{
+		Module:     banktypes.ModuleName,
+		Collection: "balances",
+		Start:      key,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*collectionsv1.Entry{{Key: key, Value: `"10"`}}, iter.Entries)
+
+	// the page size is capped
+	for i := range runtimeservices.MaxIterateLimit {
+		require.NoError(t, app.BankKeeper.Balances.Set(ctx, collections.Join(addr, fmt.Sprintf("denom%04d", i)), math.NewInt(1)))
+	}
+	iter, err = svc.Iterate(ctx, &collectionsv1.QueryIterateRequest{
+		Module:     banktypes.ModuleName,
+		Collection: "balances",
+		Pagination: &query.PageRequest{Limit: runtimeservices.MaxIterateLimit + 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, iter.Entries, runtimeservices.MaxIterateLimit)
+	require.NotNil(t, iter.Pagination.NextKey)
+
+	_, err = svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: banktypes.ModuleName, Collection: "balances", Key: fmt.Sprintf(`[%q,"atom"]`, addr.String())})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -1,18 +1,28 @@
 package simapp
 
 import (
+	"math/rand"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func TestNewSimApp_BlockExecutorWiring(t *testing.T) {
@@ -61,3 +71,83 @@ func TestNewSimApp_BlockExecutorWiring(t *testing.T) {
 		})
 	}
 }
+
+func TestDeferredFeesBlockSTM(t *testing.T) {
+	// the verify executor fails the block if block-stm diverges from the sequential execution
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), true, simtestutil.AppOptionsMap{
+		flags.FlagHome:                 t.TempDir(),
+		server.FlagBlockExecutor:       serverconfig.BlockExecutorVerify,
+		server.FlagBlockSTMPreEstimate: true,
+	})
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	initial := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+	privKeys := make([]*secp256k1.PrivKey, 8)
+	genAccs := make([]authtypes.GenesisAccount, len(privKeys))
+	balances := make([]banktypes.Balance, len(privKeys))
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKeys[i].PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addr, privKeys[i].PubKey(), 0, 0)
+		balances[i] = banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(initial)}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+	// no inflation, so the fee collector only receives the fees
+	mintGenesis := minttypes.DefaultGenesisState()
+	mintGenesis.Minter = minttypes.InitialMinter(sdkmath.LegacyZeroDec())
+	mintGenesis.Params.InflationMin = sdkmath.LegacyZeroDec()
+	mintGenesis.Params.InflationMax = sdkmath.LegacyZeroDec()
+	mintGenesis.Params.InflationRateChange = sdkmath.LegacyZeroDec()
+	genesisState[minttypes.ModuleName] = app.AppCodec().MustMarshalJSON(mintGenesis)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// every tx pays the fee collector, which no longer serializes the block
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	ctx := app.NewContext(true)
+	r := rand.New(rand.NewSource(1))
+	txs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		acc := app.AccountKeeper.GetAccount(ctx, addr)
+		msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		tx, err := simtestutil.GenSignedMockTx(r, app.TxConfig(), []sdk.Msg{msg}, sdk.NewCoins(fee),
+			simtestutil.DefaultGenTxGas, app.ChainID(), []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, privKey)
+		require.NoError(t, err)
+		txs[i], err = app.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Txs: txs, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	for i, txRes := range res.TxResults {
+		require.Zero(t, txRes.Code, "tx %d: %s", i, txRes.Log)
+	}
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// the deferred fees are credited at the end of the block
+	ctx = app.NewContext(true)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, fee.Amount.MulRaw(int64(len(txs))), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	for _, privKey := range privKeys {
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		require.Equal(t, initial.Sub(fee), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom))
+	}
+}
@@ -0,0 +1,147 @@
+package simapp
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestFeeMarketBaseFee(t *testing.T) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), true, simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()})
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	privKeys := make([]*secp256k1.PrivKey, 2)
+	genAccs := make([]authtypes.GenesisAccount, len(privKeys))
+	balances := make([]banktypes.Balance, len(privKeys))
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKeys[i].PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addr, privKeys[i].PubKey(), 0, 0)
+		balances[i] = banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+	// the base fee starts above its minimum, so that it can decrease
+	const targetGas = 500_000
+	minBaseFee := feemarkettypes.DefaultMinBaseFee
+	params := feemarkettypes.DefaultParams()
+	params.TargetBlockGas = targetGas
+	startBaseFee := sdkmath.LegacyNewDecWithPrec(4, 3)
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarkettypes.NewGenesisState(params,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, startBaseFee))))
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(1))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+	newTx := func(privKey *secp256k1.PrivKey, gas uint64, msgs ...sdk.Msg) []byte {
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		acc := app.AccountKeeper.GetAccount(app.NewContext(true), addr)
+		tx, err := simtestutil.GenSignedMockTx(r, app.TxConfig(), msgs, fee, gas, app.ChainID(),
+			[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, privKey)
+		require.NoError(t, err)
+		bz, err := app.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	baseFee := func() sdkmath.LegacyDec {
+		baseFee, err := app.FeeMarketKeeper.GetBaseFee(app.NewContext(true), sdk.DefaultBondDenom)
+		require.NoError(t, err)
+		return baseFee.Amount
+	}
+	// finalizeBlock returns the gas used by the txs of the block, and checks that
+	// it is the gas the base fee is adjusted to
+	finalizeBlock := func(txs ...[]byte) uint64 {
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1, Txs: txs, NextValidatorsHash: valSet.Hash()})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		var gasUsed uint64
+		for i, txRes := range res.TxResults {
+			require.Zero(t, txRes.Code, "tx %d: %s", i, txRes.Log)
+			gasUsed += uint64(txRes.GasUsed)
+		}
+		var blockGas string
+		for _, event := range res.Events {
+			if event.Type != feemarkettypes.EventTypeBaseFee {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == feemarkettypes.AttributeKeyBlockGas {
+					blockGas = attr.Value
+				}
+			}
+		}
+		require.Equal(t, strconv.FormatUint(gasUsed, 10), blockGas)
+		return gasUsed
+	}
+
+	addr0, addr1 := sdk.AccAddress(privKeys[0].PubKey().Address()), sdk.AccAddress(privKeys[1].PubKey().Address())
+	send := func(from, to sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	}
+
+	// the gas limits exceed the target gas, but the gas used doesn't
+	current := baseFee()
+	gasUsed := finalizeBlock(
+		newTx(privKeys[0], 1_000_000, send(addr0, addr1)),
+		newTx(privKeys[1], 1_000_000, send(addr1, addr0)),
+	)
+	require.Less(t, gasUsed, uint64(targetGas))
+	next := baseFee()
+	require.True(t, next.LT(current), "%s >= %s", next, current)
+	require.True(t, feemarkettypes.NextBaseFee(current, minBaseFee, params.MaxChangeRate, gasUsed, targetGas).Equal(next), next.String())
+
+	// the gas used exceeds the target gas
+	msgs := make([]sdk.Msg, 100)
+	for i := range msgs {
+		msgs[i] = send(addr0, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	}
+	current = next
+	gasUsed = finalizeBlock(newTx(privKeys[0], 5_000_000, msgs...))
+	require.Greater(t, gasUsed, uint64(targetGas))
+	next = baseFee()
+	require.True(t, next.GT(current), "%s <= %s", next, current)
+	require.True(t, feemarkettypes.NextBaseFee(current, minBaseFee, params.MaxChangeRate, gasUsed, targetGas).Equal(next), next.String())
+
+	// an empty block lowers the base fee by the max change rate
+	current = next
+	require.Zero(t, finalizeBlock())
+	next = baseFee()
+	require.True(t, next.LT(current), "%s >= %s", next, current)
+	require.True(t, feemarkettypes.NextBaseFee(current, minBaseFee, params.MaxChangeRate, 0, targetGas).Equal(next), next.String())
+}
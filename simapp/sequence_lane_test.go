@@ -0,0 +1,140 @@
+package simapp
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSequenceLanesMempool(t *testing.T) {
+	// the app-side mempool is enabled by default
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), true, simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()})
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, privKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	accNum := app.AccountKeeper.GetAccount(app.NewContext(true), addr).GetAccountNumber()
+	newTx := func(lane, sequence uint64) []byte {
+		txBuilder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+		txBuilder.SetGasLimit(simtestutil.DefaultGenTxGas)
+		if lane > 0 {
+			opt, err := codectypes.NewAnyWithValue(&authtypes.ExtensionOptionSequenceLane{Lane: lane})
+			require.NoError(t, err)
+			txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+		}
+
+		sig := signing.SignatureV2{
+			PubKey:   privKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}
+		require.NoError(t, txBuilder.SetSignatures(sig))
+		signBytes, err := authsign.GetSignBytesAdapter(context.Background(), app.TxConfig().SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT,
+			authsign.SignerData{
+				Address:       addr.String(),
+				ChainID:       app.ChainID(),
+				AccountNumber: accNum,
+				Sequence:      sequence,
+				PubKey:        privKey.PubKey(),
+			}, txBuilder.GetTx())
+		require.NoError(t, err)
+		sig.Data.(*signing.SingleSignatureData).Signature, err = privKey.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		bz, err := app.TxConfig().TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the first txs of every lane share the same sequence, each lane is a sender
+	// of its own in the mempool
+	lane0, lane1First, lane1Second, lane2 := newTx(0, 0), newTx(1, 0), newTx(1, 1), newTx(2, 0)
+	for i, tx := range [][]byte{lane0, lane1First, lane1Second, lane2} {
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: tx, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.Zero(t, res.Code, "tx %d: %s", i, res.Log)
+	}
+	require.Equal(t, 4, app.Mempool().CountTx())
+
+	prepareRes, err := app.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes:         1 << 20,
+		Height:             2,
+		NextValidatorsHash: valSet.Hash(),
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{lane0, lane1First, lane1Second, lane2}, prepareRes.Txs)
+	// the txs of a lane are proposed in sequence order
+	indexOf := func(tx []byte) int {
+		return slices.IndexFunc(prepareRes.Txs, func(proposed []byte) bool { return bytes.Equal(proposed, tx) })
+	}
+	require.Less(t, indexOf(lane1First), indexOf(lane1Second))
+
+	processRes, err := app.ProcessProposal(&abci.RequestProcessProposal{
+		Txs:                prepareRes.Txs,
+		Height:             2,
+		NextValidatorsHash: valSet.Hash(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+
+	finalizeRes, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Txs: prepareRes.Txs, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	for i, txRes := range finalizeRes.TxResults {
+		require.Zero(t, txRes.Code, "tx %d: %s", i, txRes.Log)
+	}
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	ctx := app.NewContext(true)
+	for lane, expected := range []uint64{1, 2, 1} {
+		sequence, err := app.AccountKeeper.GetLaneSequence(ctx, addr, uint64(lane))
+		require.NoError(t, err)
+		require.Equal(t, expected, sequence, "lane %d", lane)
+	}
+	require.Zero(t, app.Mempool().CountTx())
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/client/state"
+	"github.com/cosmos/cosmos-sdk/client/store"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -101,6 +103,7 @@ func initRootCmd(
 	rootCmd *cobra.Command,
 	txConfig client.TxConfig,
 	basicManager module.BasicManager,
+	moduleCodecs state.ModuleCodecsProvider,
 ) {
 	cfg := sdk.GetConfig()
 	cfg.Seal()
@@ -112,6 +115,8 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, simapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		store.Cmd(simapp.DefaultNodeHome),
+		state.Cmd(moduleCodecs),
 		NewBankSpeedTest(),
 	)
 
@@ -18,9 +18,11 @@ import (
 // determining when to prune old heights of the store
 // based on the strategy described by the pruning options.
 type Manager struct {
-	db               dbm.DB
-	logger           log.Logger
-	opts             types.PruningOptions
+	db     dbm.DB
+	logger log.Logger
+	opts   types.PruningOptions
+	// storeOpts overrides opts for the stores with their own pruning strategy.
+	storeOpts        map[string]types.PruningOptions
 	snapshotInterval uint64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchronously via HandleSnapshotHeight.
@@ -55,6 +57,7 @@ func NewManager(db dbm.DB, logger log.Logger) *Manager {
 		db:                   db,
 		logger:               logger,
 		opts:                 types.NewPruningOptions(types.PruningNothing),
+		storeOpts:            make(map[string]types.PruningOptions),
 		pruneSnapshotHeights: []int64{0}, // init with 0 block height
 	}
 }
@@ -69,9 +72,36 @@ func (m *Manager) GetOptions() types.PruningOptions {
 	return m.opts
 }
 
+// SetStoreOptions sets the pruning strategy of a single store, overriding the strategy set
+// with SetOptions for that store.
+func (m *Manager) SetStoreOptions(storeName string, opts types.PruningOptions) {
+	m.storeOpts[storeName] = opts
+}
+
+// GetStoreOptions fetches the pruning strategy of a store, the second return value is false
+// if the store follows the strategy set with SetOptions.
+func (m *Manager) GetStoreOptions(storeName string) (types.PruningOptions, bool) {
+	opts, ok := m.storeOpts[storeName]
+	return opts, ok
+}
+
+// pruningEnabled reports whether any height may be pruned, either by the manager strategy or
+// by the strategy of a store.
+func (m *Manager) pruningEnabled() bool {
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		return true
+	}
+	for _, opts := range m.storeOpts {
+		if opts.GetPruningStrategy() != types.PruningNothing {
+			return true
+		}
+	}
+	return false
+}
+
 // AnnounceSnapshotHeight announces a new snapshot height for tracking and pruning.
 func (m *Manager) AnnounceSnapshotHeight(height int64) {
-	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
+	if !m.pruningEnabled() || height <= 0 {
 		return
 	}
 	m.pruneSnapshotHeightsMx.Lock()
@@ -85,7 +115,7 @@ func (m *Manager) AnnounceSnapshotHeight(height int64) {
 // The input height must be greater than 0, and the pruning strategy must not be set to pruning nothing.
 // If either of these conditions is not met, this function does nothing.
 func (m *Manager) HandleSnapshotHeight(height int64) {
-	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
+	if !m.pruningEnabled() || height <= 0 {
 		return
 	}
 
@@ -137,15 +167,28 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 
 // GetPruningHeight returns the height which can prune up to if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
-	if m.opts.GetPruningStrategy() == types.PruningNothing ||
-		m.opts.Interval <= 0 ||
-		height <= int64(m.opts.KeepRecent) ||
-		height%int64(m.opts.Interval) != 0 {
+	return m.getPruningHeight(m.opts, height)
+}
+
+// GetStorePruningHeight is like GetPruningHeight for a single store, it applies the strategy of
+// the store if it has one.
+func (m *Manager) GetStorePruningHeight(storeName string, height int64) int64 {
+	if opts, ok := m.storeOpts[storeName]; ok {
+		return m.getPruningHeight(opts, height)
+	}
+	return m.getPruningHeight(m.opts, height)
+}
+
+func (m *Manager) getPruningHeight(opts types.PruningOptions, height int64) int64 {
+	if opts.GetPruningStrategy() == types.PruningNothing ||
+		opts.Interval <= 0 ||
+		height <= int64(opts.KeepRecent) ||
+		height%int64(opts.Interval) != 0 {
 		return 0
 	}
 
 	// Consider the snapshot height
-	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	pruneHeight := height - 1 - int64(opts.KeepRecent) // we should keep the current height at least
 
 	// snapshotInterval is zero, indicating that all heights can be pruned
 	if m.snapshotInterval <= 0 {
@@ -175,7 +218,7 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	if !m.pruningEnabled() {
 		return nil
 	}
 
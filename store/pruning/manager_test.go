@@ -448,6 +448,32 @@ func TestLoadPruningSnapshotHeights(t *testing.T) {
 	}
 }
 
+func TestGetStorePruningHeight(t *testing.T) {
+	manager := NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewPruningOptions(types.PruningNothing))
+	manager.SetStoreOptions("ibc", types.NewCustomPruningOptions(10, 5))
+	manager.SetSnapshotInterval(50)
+
+	_, ok := manager.GetStoreOptions("bank")
+	require.False(t, ok)
+	opts, ok := manager.GetStoreOptions("ibc")
+	require.True(t, ok)
+	require.Equal(t, types.NewCustomPruningOptions(10, 5), opts)
+
+	// snapshot heights are tracked as long as a store is pruned
+	manager.AnnounceSnapshotHeight(50)
+	manager.HandleSnapshotHeight(50)
+
+	require.Equal(t, int64(0), manager.GetPruningHeight(100))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("bank", 100))
+	require.Equal(t, int64(89), manager.GetStorePruningHeight("ibc", 100))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("ibc", 101))
+
+	// an in-flight snapshot holds the pruning of the store
+	manager.AnnounceSnapshotHeight(100)
+	require.Equal(t, int64(99), manager.GetStorePruningHeight("ibc", 120))
+}
+
 func TestLoadSnapshotHeights_PruneNothing(t *testing.T) {
 	manager := NewManager(db.NewMemDB(), log.NewNopLogger())
 	require.NotNil(t, manager)
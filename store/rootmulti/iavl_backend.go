@@ -0,0 +1,69 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/iavl"
+	"github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+// IAVLStoreLoader loads the commit store of a StoreTypeIAVL store at the version of id, it allows
+// replacing the store/iavl backend. The database is the prefixed database of the store, a loader
+// may keep its data elsewhere.
+//
+// It is an alias so that callers can pass a plain function without depending on this package.
+type IAVLStoreLoader = func(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error)
+
+// VersionedStore is the interface of the stores returned by an IAVLStoreLoader, it provides the
+// version management of *iavl.Store used by the multistore for historical queries, pruning and
+// rollbacks. State sync snapshots and query proofs are only supported by *iavl.Store, Snapshot
+// and Restore fail on a VersionedStore.
+type VersionedStore interface {
+	types.CommitKVStore
+
+	// GetImmutableKVStore returns a read-only store at the given version.
+	GetImmutableKVStore(version int64) (types.KVStore, error)
+	// DeleteVersionsTo deletes the versions up to and including the given version.
+	DeleteVersionsTo(version int64) error
+	// LoadVersionForOverwriting loads the given version and deletes the later versions.
+	LoadVersionForOverwriting(version int64) error
+}
+
+func getImmutableIAVL(store types.CommitStore, version int64) (types.CacheWrapper, error) {
+	switch store := store.(type) {
+	case *iavl.Store:
+		immutable, err := store.GetImmutable(version)
+		if err != nil {
+			return nil, err
+		}
+		return immutable, nil
+	case VersionedStore:
+		return store.GetImmutableKVStore(version)
+	default:
+		return nil, fmt.Errorf("unexpected IAVL store type %T", store)
+	}
+}
+
+func deleteIAVLVersionsTo(store types.CommitStore, version int64) error {
+	switch store := store.(type) {
+	case *iavl.Store:
+		return store.DeleteVersionsTo(version)
+	case VersionedStore:
+		return store.DeleteVersionsTo(version)
+	default:
+		return fmt.Errorf("unexpected IAVL store type %T", store)
+	}
+}
+
+func loadIAVLVersionForOverwriting(store types.CommitStore, version int64) error {
+	switch store := store.(type) {
+	case *iavl.Store:
+		return store.LoadVersionForOverwriting(version)
+	case VersionedStore:
+		return store.LoadVersionForOverwriting(version)
+	default:
+		return fmt.Errorf("unexpected IAVL store type %T", store)
+	}
+}
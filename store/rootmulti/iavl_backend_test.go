@@ -0,0 +1,75 @@
+package rootmulti
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/iavl"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+// versionedStore wraps an *iavl.Store so that the multistore only sees a VersionedStore.
+type versionedStore struct {
+	*iavl.Store
+}
+
+func (s versionedStore) GetImmutableKVStore(version int64) (types.KVStore, error) {
+	return s.GetImmutable(version)
+}
+
+func newVersionedStoreLoader(loaded map[string]int) IAVLStoreLoader {
+	return func(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+		store, err := iavl.LoadStoreWithOpts(db, log.NewNopLogger(), key, id, initialVersion, iavl.DefaultIAVLCacheSize, false)
+		if err != nil {
+			return nil, err
+		}
+		loaded[key.Name()]++
+		return versionedStore{store.(*iavl.Store)}, nil
+	}
+}
+
+func TestMultiStore_IAVLStoreLoader(t *testing.T) {
+	db := dbm.NewMemDB()
+	loaded := make(map[string]int)
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetIAVLStoreLoader(newVersionedStoreLoader(loaded))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, map[string]int{"store1": 1, "store2": 1, "store3": 1}, loaded)
+
+	k := []byte("key")
+	for i := byte(1); i <= 10; i++ {
+		ms.GetStoreByName("store1").(types.KVStore).Set(k, []byte{i})
+		ms.Commit()
+	}
+
+	cms, err := ms.CacheMultiStoreWithVersion(9)
+	require.NoError(t, err)
+	require.Equal(t, []byte{9}, cms.GetKVStore(testStoreKey1).Get(k))
+
+	// the old versions are pruned through the loaded stores
+	require.Eventually(t, func() bool {
+		_, err := ms.CacheMultiStoreWithVersion(5)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, ms.RollbackToVersion(9))
+	require.Equal(t, int64(9), ms.LastCommitID().Version)
+	require.Equal(t, []byte{9}, ms.GetStoreByName("store1").(types.KVStore).Get(k))
+}
+
+func TestMultiStore_IAVLStoreLoaderSnapshot(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetIAVLStoreLoader(newVersionedStoreLoader(make(map[string]int)))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+
+	err := ms.Snapshot(1, nil)
+	require.ErrorIs(t, err, types.ErrLogic)
+	require.ErrorContains(t, err, "without snapshot support")
+}
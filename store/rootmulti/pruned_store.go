@@ -0,0 +1,56 @@
+package rootmulti
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+var _ types.KVStore = prunedStore{}
+
+// prunedStore stands in for a store queried at a height pruned by the pruning strategy of that
+// store in CacheMultiStoreWithVersion. It panics with ErrStorePruned on every access, so that the
+// other stores can still be queried at that height.
+type prunedStore struct {
+	name     string
+	height   int64
+	earliest int64
+}
+
+func (s prunedStore) err() error {
+	return errorsmod.Wrapf(types.ErrStorePruned, "store %s at height %d, the earliest height available for this store is %d",
+		s.name, s.height, s.earliest)
+}
+
+func (s prunedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+func (s prunedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s prunedStore) Get([]byte) []byte {
+	panic(s.err())
+}
+
+func (s prunedStore) Has([]byte) bool {
+	panic(s.err())
+}
+
+func (s prunedStore) Set([]byte, []byte) {
+	panic(s.err())
+}
+
+func (s prunedStore) Delete([]byte) {
+	panic(s.err())
+}
+
+func (s prunedStore) Iterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
+
+func (s prunedStore) ReverseIterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
@@ -35,6 +35,9 @@ const (
 	latestVersionKey   = "s/latest"
 	earliestVersionKey = "s/earliest"
 	commitInfoKeyFmt   = "s/%d" // s/<version>
+
+	// storeEarliestVersionKeyFmt holds the earliest version of the stores with their own pruning strategy.
+	storeEarliestVersionKeyFmt = "s/earliest/%s" // s/earliest/<store name>
 )
 
 const iavlDisablefastNodeDefault = false
@@ -66,6 +69,7 @@ type Store struct {
 	// The Prune command will automatically set this to true.
 	// This allows the prune command to wait for the pruning to finish before returning.
 	iavlSyncPruning bool
+	iavlLoader      IAVLStoreLoader
 	storesParams    map[types.StoreKey]storeParams
 	// CommitStore is a common interface to unify generic CommitKVStore of different value types
 	stores          map[types.StoreKey]types.CommitStore
@@ -115,6 +119,12 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetStorePruning sets the pruning strategy of a single store, overriding the strategy set with
+// SetPruning for that store. It must be called before LoadVersion or LoadLatestVersion.
+func (rs *Store) SetStorePruning(storeName string, pruningOpts pruningtypes.PruningOptions) {
+	rs.pruningManager.SetStoreOptions(storeName, pruningOpts)
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
@@ -140,6 +150,12 @@ func (rs *Store) SetIAVLSyncPruning(syncPruning bool) {
 	rs.iavlSyncPruning = syncPruning
 }
 
+// SetIAVLStoreLoader replaces the store/iavl backend of the StoreTypeIAVL stores, the stores it
+// returns must implement VersionedStore. It must be called before loading a version.
+func (rs *Store) SetIAVLStoreLoader(loader IAVLStoreLoader) {
+	rs.iavlLoader = loader
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -308,7 +324,27 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		return err
 	}
 
-	return nil
+	return rs.initStoreEarliestVersions()
+}
+
+// initStoreEarliestVersions records the earliest version of the stores which were given their own
+// pruning strategy since the last run, they were pruned along with the other stores until then.
+func (rs *Store) initStoreEarliestVersions() error {
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	earliest := GetEarliestVersion(rs.db)
+	for name := range rs.keysByName {
+		if _, ok := rs.pruningManager.GetStoreOptions(name); !ok {
+			continue
+		}
+		if _, ok := getStoreEarliestVersion(rs.db, name); ok {
+			continue
+		}
+		flushStoreEarliestVersion(batch, name, earliest)
+	}
+
+	return batch.WriteSync()
 }
 
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
@@ -418,6 +454,22 @@ func (rs *Store) EarliestVersion() int64 {
 	return GetEarliestVersion(rs.db)
 }
 
+// StoreEarliestVersion returns the earliest version available in the given store, which differs
+// from EarliestVersion for the stores with their own pruning strategy.
+func (rs *Store) StoreEarliestVersion(storeName string) int64 {
+	earliest := GetEarliestVersion(rs.db)
+	storeEarliest, ok := getStoreEarliestVersion(rs.db, storeName)
+	if !ok {
+		return earliest
+	}
+	if _, ok := rs.pruningManager.GetStoreOptions(storeName); ok {
+		return storeEarliest
+	}
+
+	// the store had its own pruning strategy before following the one of the root store
+	return max(earliest, storeEarliest)
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	info := rs.lastCommitInfo.Load()
@@ -553,16 +605,24 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
-// any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// any store cannot be loaded, unless the version was pruned from that store
+// while other stores still hold it, in which case accessing it panics with
+// ErrStorePruned. This should only be used for querying and iterating at past
+// heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
+	var (
+		iavlStores, prunedStores int
+		prunedErr                error
+	)
 	for key, store := range rs.stores {
 		var cacheStore types.CacheWrapper
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
+			iavlStores++
+
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.getCommitKVStore(key)
@@ -570,7 +630,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			var err error
-			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			cacheStore, err = getImmutableIAVL(store, version)
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -589,14 +649,22 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				}
 
 				// If the store existed at this version, it means there's actually an error
-				// getting the root store at this version.
-				if storeInfos[key.Name()] {
+				// getting the root store at this version, or that the version was pruned
+				// from the store.
+				switch {
+				case !storeInfos[key.Name()]:
+					// If the store doesn't exist at this version, create a dummy one to prevent
+					// nil pointer panic in newer query APIs.
+					cacheStore = dbadapter.Store{DB: dbm.NewMemDB()}
+
+				case rs.isPrunedStore(key.Name(), version):
+					cacheStore = prunedStore{name: key.Name(), height: version, earliest: rs.StoreEarliestVersion(key.Name())}
+					prunedStores++
+					prunedErr = err
+
+				default:
 					return nil, err
 				}
-
-				// If the store doesn't exist at this version, create a dummy one to prevent
-				// nil pointer panic in newer query APIs.
-				cacheStore = dbadapter.Store{DB: dbm.NewMemDB()}
 			}
 
 		default:
@@ -614,6 +682,11 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		cachedStores[key] = cacheStore
 	}
 
+	// the version was pruned from every store, there is nothing left to query
+	if iavlStores > 0 && prunedStores == iavlStores {
+		return nil, prunedErr
+	}
+
 	return cachemulti.NewStore(cachedStores), nil
 }
 
@@ -667,14 +740,69 @@ func (rs *Store) GetObjKVStore(key types.StoreKey) types.ObjKVStore {
 	return store
 }
 
+// isPrunedStore reports whether the version was pruned from the store, either by its own pruning
+// strategy or by the one of the root store.
+func (rs *Store) isPrunedStore(storeName string, version int64) bool {
+	return version < rs.StoreEarliestVersion(storeName)
+}
+
 func (rs *Store) handlePruning(version int64) error {
 	pruneHeight := rs.pruningManager.GetPruningHeight(version)
 	rs.logger.Debug("prune start", "height", version)
 	defer rs.logger.Debug("prune end", "height", version)
+	if err := rs.pruneStoresWithOwnOptions(version); err != nil {
+		return err
+	}
 	return rs.PruneStores(pruneHeight)
 }
 
+// pruneStoresWithOwnOptions prunes the stores with their own pruning strategy at the given version,
+// and records the earliest version kept by each of them.
+func (rs *Store) pruneStoresWithOwnOptions(version int64) error {
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		if _, ok := rs.pruningManager.GetStoreOptions(key.Name()); !ok {
+			continue
+		}
+
+		pruningHeight := rs.pruningManager.GetStorePruningHeight(key.Name(), version)
+		if pruningHeight <= 0 {
+			continue
+		}
+
+		rs.logger.Debug("pruning store", "key", key, "heights", pruningHeight)
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store = rs.getCommitKVStore(key)
+
+		if err := deleteIAVLVersionsTo(store, pruningHeight); err != nil {
+			if errors.Is(err, iavltree.ErrVersionDoesNotExist) {
+				return err
+			}
+
+			rs.logger.Error("failed to prune store", "key", key, "err", err)
+			continue
+		}
+
+		if newEarliest := pruningHeight + 1; newEarliest > rs.StoreEarliestVersion(key.Name()) {
+			batch := rs.db.NewBatch()
+			flushStoreEarliestVersion(batch, key.Name(), newEarliest)
+			if err := batch.WriteSync(); err != nil {
+				rs.logger.Error("failed to persist earliest version", "key", key, "err", err)
+			}
+			batch.Close()
+		}
+	}
+
+	return nil
+}
+
 // PruneStores prunes all history up to the specific height of the multi store.
+// The stores with their own pruning strategy, set with SetStorePruning, are
+// left out and pruned when committing according to that strategy.
 func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 	if pruningHeight <= 0 {
 		rs.logger.Debug("pruning skipped, height is less than or equal to 0")
@@ -691,10 +819,13 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 		if store.GetStoreType() != types.StoreTypeIAVL {
 			continue
 		}
+		if _, ok := rs.pruningManager.GetStoreOptions(key.Name()); ok {
+			continue
+		}
 
 		store = rs.getCommitKVStore(key)
 
-		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
+		err := deleteIAVLVersionsTo(store, pruningHeight)
 		if err == nil {
 			continue
 		}
@@ -855,6 +986,9 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		case *transient.Store, *mem.Store, *transient.ObjStore:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
+		case VersionedStore:
+			return errorsmod.Wrapf(types.ErrLogic,
+				"store %q uses an IAVL backend without snapshot support (%T), state sync snapshots must be disabled", key.Name(), store)
 		default:
 			return errorsmod.Wrapf(types.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
@@ -955,7 +1089,12 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			commitStore := rs.GetStoreByName(item.Store.Name)
+			if _, ok := commitStore.(VersionedStore); ok {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"store %q uses an IAVL backend without snapshot support (%T), state sync is not available", item.Store.Name, commitStore)
+			}
+			store, ok := commitStore.(*iavl.Store)
 			if !ok || store == nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
 			}
@@ -1027,7 +1166,15 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		store, err := iavl.LoadStoreWithOpts(db, rs.logger, key, id, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, iavltree.AsyncPruningOption(!rs.iavlSyncPruning))
+		var (
+			store types.CommitKVStore
+			err   error
+		)
+		if rs.iavlLoader != nil {
+			store, err = rs.iavlLoader(db, key, id, params.initialVersion)
+		} else {
+			store, err = iavl.LoadStoreWithOpts(db, rs.logger, key, id, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, iavltree.AsyncPruningOption(!rs.iavlSyncPruning))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1100,7 +1247,7 @@ func (rs *Store) RollbackToVersion(target int64) error {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.getCommitKVStore(key)
-			err := store.(*iavl.Store).LoadVersionForOverwriting(target)
+			err := loadIAVLVersionForOverwriting(store, target)
 			if err != nil {
 				return err
 			}
@@ -1222,6 +1369,36 @@ func flushEarliestVersion(batch dbm.Batch, version int64) {
 	}
 }
 
+// getStoreEarliestVersion returns the earliest version of a store with its own pruning strategy,
+// the second return value is false if none was recorded.
+func getStoreEarliestVersion(db dbm.DB, storeName string) (int64, bool) {
+	bz, err := db.Get([]byte(fmt.Sprintf(storeEarliestVersionKeyFmt, storeName)))
+	if err != nil {
+		panic(err)
+	} else if bz == nil {
+		return 0, false
+	}
+
+	var earliestVersion int64
+
+	if err := gogotypes.StdInt64Unmarshal(&earliestVersion, bz); err != nil {
+		panic(err)
+	}
+
+	return earliestVersion, true
+}
+
+func flushStoreEarliestVersion(batch dbm.Batch, storeName string, version int64) {
+	bz, err := gogotypes.StdInt64Marshal(version)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := batch.Set([]byte(fmt.Sprintf(storeEarliestVersionKeyFmt, storeName)), bz); err != nil {
+		panic(err)
+	}
+}
+
 // commitStores commits each store and returns a new commitInfo.
 func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
@@ -586,6 +586,73 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey2.Name(), pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := []byte("key")
+	for i := int64(1); i <= 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set(key, []byte(fmt.Sprint(i)))
+		ms.GetKVStore(testStoreKey2).Set(key, []byte(fmt.Sprint(i)))
+		ms.Commit()
+	}
+
+	require.Equal(t, int64(1), ms.EarliestVersion())
+	require.Equal(t, int64(1), ms.StoreEarliestVersion(testStoreKey1.Name()))
+	require.Equal(t, int64(8), ms.StoreEarliestVersion(testStoreKey2.Name()))
+
+	// Ensure async pruning is done
+	isPruned := func() (pruned bool) {
+		cms, err := ms.CacheMultiStoreWithVersion(3)
+		require.NoError(t, err)
+		defer func() { pruned = recover() != nil }()
+		cms.GetKVStore(testStoreKey2).Get(key)
+		return false
+	}
+	require.Eventually(t, isPruned, 1*time.Second, 10*time.Millisecond, "expected store2 to be pruned at height 3")
+
+	// the other stores can still be queried at the height pruned from store2
+	cms, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), cms.GetKVStore(testStoreKey1).Get(key))
+	require.PanicsWithError(t, "store store2 at height 3, the earliest height available for this store is 8: height pruned for this store", func() {
+		cms.GetKVStore(testStoreKey2).Get(key)
+	})
+
+	cms, err = ms.CacheMultiStoreWithVersion(8)
+	require.NoError(t, err)
+	require.Equal(t, []byte("8"), cms.GetKVStore(testStoreKey2).Get(key))
+
+	// "restart" with a root pruning strategy, the store pruning strategy is reversed
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetStorePruning(testStoreKey2.Name(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+
+	require.Equal(t, int64(13), ms.EarliestVersion())
+	require.Equal(t, int64(8), ms.StoreEarliestVersion(testStoreKey2.Name()))
+	isRootPruned := func() (pruned bool) {
+		cms, err := ms.CacheMultiStoreWithVersion(12)
+		require.NoError(t, err)
+		defer func() { pruned = recover() != nil }()
+		cms.GetKVStore(testStoreKey1).Get(key)
+		return false
+	}
+	require.Eventually(t, isRootPruned, 1*time.Second, 10*time.Millisecond, "expected store1 to be pruned at height 12")
+
+	// store2 still holds the height pruned from the stores following the root pruning strategy
+	cms, err = ms.CacheMultiStoreWithVersion(12)
+	require.NoError(t, err)
+	require.Equal(t, []byte("10"), cms.GetKVStore(testStoreKey2).Get(key))
+	require.PanicsWithError(t, "store store1 at height 12, the earliest height available for this store is 13: height pruned for this store", func() {
+		cms.GetKVStore(testStoreKey1).Get(key)
+	})
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
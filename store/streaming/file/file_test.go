@@ -0,0 +1,179 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+func writeBlock(t *testing.T, l *Listener, height int64) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, l.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{RetainHeight: height}, []*storetypes.StoreKVPair{{
+		StoreKey: "bank",
+		Key:      fmt.Appendf(nil, "key%d", height),
+		Value:    fmt.Appendf(nil, "value%d", height),
+	}}))
+}
+
+func readHeights(t *testing.T, dir string, fromHeight int64) []int64 {
+	t.Helper()
+	var heights []int64
+	err := Replay(dir, fromHeight, func(block *Block) error {
+		require.Equal(t, block.Height, block.FinalizeBlock.Req.Height)
+		require.Equal(t, block.Height, block.Commit.Res.RetainHeight)
+		require.Equal(t, fmt.Appendf(nil, "key%d", block.Height), block.Commit.ChangeSet[0].Key)
+		heights = append(heights, block.Height)
+		return nil
+	})
+	require.NoError(t, err)
+	return heights
+}
+
+func TestListenerReplay(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxSegmentSize: 100})
+	require.NoError(t, err)
+	for height := int64(1); height <= 5; height++ {
+		writeBlock(t, l, height)
+	}
+	require.NoError(t, l.Close())
+
+	segments, err := listSegments(dir)
+	require.NoError(t, err)
+	require.Greater(t, len(segments), 1)
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, readHeights(t, dir, 0))
+	require.Equal(t, []int64{3, 4, 5}, readHeights(t, dir, 3))
+	require.Empty(t, readHeights(t, dir, 6))
+}
+
+func TestListenerRecovery(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{})
+	require.NoError(t, err)
+	writeBlock(t, l, 1)
+	writeBlock(t, l, 2)
+
+	// the node stops after finalizing block 3, with a torn write
+	require.NoError(t, l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 3}, abci.ResponseFinalizeBlock{}))
+	_, err = l.log.Write([]byte{byte(recordCommit), 0, 0})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2}, readHeights(t, dir, 0))
+
+	l, err = NewListener(dir, Options{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), l.LastHeight())
+
+	// blocks replayed by the node are skipped
+	writeBlock(t, l, 2)
+	writeBlock(t, l, 3)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3}, readHeights(t, dir, 0))
+
+	entries, err := readIndex(dir, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+}
+
+func TestListenerGap(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{})
+	require.NoError(t, err)
+	writeBlock(t, l, 1)
+	writeBlock(t, l, 2)
+	require.NoError(t, l.Close())
+
+	// the node committed block 3 without the listener
+	l, err = NewListener(dir, Options{})
+	require.NoError(t, err)
+	defer l.Close()
+	err = l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 4}, abci.ResponseFinalizeBlock{})
+	require.ErrorIs(t, err, ErrGap)
+	require.NoError(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	require.Equal(t, []int64{1, 2}, readHeights(t, dir, 0))
+}
+
+func TestReaderGap(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxSegmentSize: 100})
+	require.NoError(t, err)
+	for height := int64(1); height <= 5; height++ {
+		writeBlock(t, l, height)
+	}
+	require.NoError(t, l.Close())
+
+	segments, err := listSegments(dir)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3, 5}, segments)
+
+	// blocks 3 and 4 are missing
+	require.NoError(t, removeSegment(dir, 3))
+	var heights []int64
+	err = Replay(dir, 0, func(block *Block) error {
+		heights = append(heights, block.Height)
+		return nil
+	})
+	require.ErrorIs(t, err, ErrGap)
+	require.Equal(t, []int64{1, 2}, heights)
+	require.Equal(t, []int64{5}, readHeights(t, dir, 5))
+
+	// blocks 1 to 4 are missing
+	require.NoError(t, removeSegment(dir, 1))
+	err = Replay(dir, 1, func(*Block) error { return nil })
+	require.ErrorIs(t, err, ErrGap)
+	require.Equal(t, []int64{5}, readHeights(t, dir, 0))
+}
+
+func TestReaderFollowsListener(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxSegmentSize: 100})
+	require.NoError(t, err)
+	defer l.Close()
+
+	r, err := NewReader(dir, 0)
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	for height := int64(1); height <= 4; height++ {
+		writeBlock(t, l, height)
+
+		// a finalized block isn't read until it is committed
+		require.NoError(t, l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height + 1}, abci.ResponseFinalizeBlock{}))
+		block, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, height, block.Height)
+		_, err = r.Next()
+		require.ErrorIs(t, err, io.EOF)
+		require.NoError(t, l.discardPending())
+	}
+}
+
+func TestReaderCorruption(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{})
+	require.NoError(t, err)
+	writeBlock(t, l, 1)
+	writeBlock(t, l, 2)
+	require.NoError(t, l.Close())
+
+	bz, err := os.ReadFile(logPath(dir, 1))
+	require.NoError(t, err)
+	bz[recordHeaderSize] ^= 0xff
+	require.NoError(t, os.WriteFile(logPath(dir, 1), bz, 0o600))
+
+	err = Replay(dir, 0, func(*Block) error { return nil })
+	require.ErrorIs(t, err, ErrCorrupted)
+}
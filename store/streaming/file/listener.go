@@ -0,0 +1,309 @@
+// Package file implements an in-process ABCIListener appending the streamed blocks to rotated,
+// checksummed segment files indexed by height, and a Reader replaying them from any height.
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	streamingabci "github.com/cosmos/cosmos-sdk/store/v2/streaming/abci"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+// DefaultMaxSegmentSize is the size above which the listener starts a new segment.
+const DefaultMaxSegmentSize = 128 << 20
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Options are the options of a Listener.
+type Options struct {
+	// MaxSegmentSize is the size above which a new segment is started at the next block,
+	// DefaultMaxSegmentSize if zero.
+	MaxSegmentSize int64
+	// DisableFsync skips syncing the files at each commit, a crash of the machine may then lose
+	// the last blocks.
+	DisableFsync bool
+}
+
+// Listener is an ABCIListener writing each block to the stream directory when it is committed.
+//
+// A block finalized but not committed when the node stops is discarded when the listener is
+// reopened, and the blocks replayed by the node up to the last written height are skipped, so
+// that every height is written once. A block not following the last written height is rejected
+// with ErrGap: the node committed blocks that were never written, because the listener wasn't
+// registered or the node stopped between committing the state and writing the block, which
+// baseapp avoids by writing the block before committing the state.
+type Listener struct {
+	mu   sync.Mutex
+	dir  string
+	opts Options
+
+	segment int64
+	log     *os.File
+	idx     *os.File
+	size    int64
+
+	// lastHeight is the height of the last block written.
+	lastHeight int64
+	// pendingHeight is the height of the block finalized but not committed yet, zero if none.
+	pendingHeight int64
+	blockStart    int64
+}
+
+// NewListener opens the stream directory, creating it if needed, and recovers its last segment
+// from an interrupted write.
+func NewListener(dir string, opts Options) (*Listener, error) {
+	if opts.MaxSegmentSize <= 0 {
+		opts.MaxSegmentSize = DefaultMaxSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	l := &Listener{dir: dir, opts: opts}
+	if err := l.recover(); err != nil {
+		return nil, fmt.Errorf("failed to recover streaming directory %s: %w", dir, err)
+	}
+	return l, nil
+}
+
+// LastHeight returns the height of the last block written.
+func (l *Listener) LastHeight() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastHeight
+}
+
+// recover truncates the last segment after its last complete block and rebuilds its index,
+// removing it if it has no complete block.
+func (l *Listener) recover() error {
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return err
+	}
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := segments[i]
+		end, entries, err := scanSegment(logPath(l.dir, segment))
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			if err := removeSegment(l.dir, segment); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.Truncate(logPath(l.dir, segment), end); err != nil {
+			return err
+		}
+		idx := make([]byte, 0, len(entries)*indexEntrySize)
+		for _, entry := range entries {
+			idx = append(idx, encodeIndexEntry(entry)...)
+		}
+		if err := os.WriteFile(idxPath(l.dir, segment), idx, 0o600); err != nil {
+			return err
+		}
+
+		if err := l.openSegment(segment, 0); err != nil {
+			return err
+		}
+		l.size = end
+		l.lastHeight = entries[len(entries)-1].height
+		return nil
+	}
+
+	return nil
+}
+
+// scanSegment returns the end offset of the last complete block of a segment and the index
+// entries of its complete blocks, stopping at the first incomplete or corrupted record.
+func scanSegment(path string) (int64, []indexEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	var (
+		offset, end int64
+		entries     []indexEntry
+		pending     *indexEntry
+	)
+	for {
+		kind, payload, err := readRecord(f, offset)
+		if errors.Is(err, errIncomplete) || errors.Is(err, ErrCorrupted) {
+			return end, entries, nil
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch kind {
+		case recordFinalizeBlock:
+			var req streamingabci.ListenFinalizeBlockRequest
+			if err := req.Unmarshal(payload); err != nil || req.Req == nil {
+				return end, entries, nil
+			}
+			pending = &indexEntry{height: req.Req.Height, offset: offset}
+		case recordCommit:
+			if pending == nil {
+				return end, entries, nil
+			}
+			entries = append(entries, *pending)
+			pending = nil
+		}
+
+		offset += recordHeaderSize + int64(len(payload))
+		if pending == nil {
+			end = offset
+		}
+	}
+}
+
+func removeSegment(dir string, segment int64) error {
+	if err := os.Remove(logPath(dir, segment)); err != nil {
+		return err
+	}
+	if err := os.Remove(idxPath(dir, segment)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// openSegment opens the files of a segment for appending, flag being added to their open flags.
+func (l *Listener) openSegment(segment int64, flag int) error {
+	log, err := os.OpenFile(logPath(l.dir, segment), os.O_CREATE|os.O_WRONLY|os.O_APPEND|flag, 0o600)
+	if err != nil {
+		return err
+	}
+	idx, err := os.OpenFile(idxPath(l.dir, segment), os.O_CREATE|os.O_WRONLY|os.O_APPEND|flag, 0o600)
+	if err != nil {
+		_ = log.Close()
+		return err
+	}
+
+	l.segment, l.log, l.idx, l.size = segment, log, idx, 0
+	return nil
+}
+
+func (l *Listener) closeSegment() error {
+	if l.log == nil {
+		return nil
+	}
+
+	var errs []error
+	if !l.opts.DisableFsync {
+		errs = append(errs, l.log.Sync(), l.idx.Sync())
+	}
+	errs = append(errs, l.log.Close(), l.idx.Close())
+	l.log, l.idx = nil, nil
+	return errors.Join(errs...)
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface, it writes the block to the current
+// segment, starting a new one if the current segment is full.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// a block finalized again is rewritten
+	if l.pendingHeight != 0 {
+		if err := l.discardPending(); err != nil {
+			return err
+		}
+	}
+	if req.Height <= l.lastHeight {
+		return nil
+	}
+	if l.lastHeight != 0 && req.Height != l.lastHeight+1 {
+		return fmt.Errorf("%w: block %d doesn't follow the last block written %d", ErrGap, req.Height, l.lastHeight)
+	}
+
+	if l.log == nil || l.size >= l.opts.MaxSegmentSize {
+		if err := l.closeSegment(); err != nil {
+			return err
+		}
+		if err := l.openSegment(req.Height, os.O_TRUNC); err != nil {
+			return err
+		}
+	}
+
+	payload, err := (&streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	l.blockStart = l.size
+	l.pendingHeight = req.Height
+	if err := l.write(recordFinalizeBlock, payload); err != nil {
+		return errors.Join(err, l.discardPending())
+	}
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface, it completes the block started by
+// ListenFinalizeBlock with the change set and indexes it.
+func (l *Listener) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.pendingHeight == 0 {
+		return nil
+	}
+
+	payload, err := (&streamingabci.ListenCommitRequest{
+		BlockHeight: l.pendingHeight,
+		Res:         &res,
+		ChangeSet:   changeSet,
+	}).Marshal()
+	if err != nil {
+		return errors.Join(err, l.discardPending())
+	}
+
+	if err := l.write(recordCommit, payload); err != nil {
+		return errors.Join(err, l.discardPending())
+	}
+	if _, err := l.idx.Write(encodeIndexEntry(indexEntry{height: l.pendingHeight, offset: l.blockStart})); err != nil {
+		return errors.Join(err, l.discardPending())
+	}
+	if !l.opts.DisableFsync {
+		if err := errors.Join(l.log.Sync(), l.idx.Sync()); err != nil {
+			return err
+		}
+	}
+
+	l.lastHeight = l.pendingHeight
+	l.pendingHeight = 0
+	return nil
+}
+
+func (l *Listener) write(kind recordKind, payload []byte) error {
+	n, err := l.log.Write(encodeRecord(kind, payload))
+	l.size += int64(n)
+	return err
+}
+
+// discardPending truncates the current segment to the start of the pending block.
+func (l *Listener) discardPending() error {
+	l.pendingHeight = 0
+	if err := l.log.Truncate(l.blockStart); err != nil {
+		return err
+	}
+	l.size = l.blockStart
+	return nil
+}
+
+// Close syncs and closes the current segment.
+func (l *Listener) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closeSegment()
+}
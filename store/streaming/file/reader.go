@@ -0,0 +1,204 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	streamingabci "github.com/cosmos/cosmos-sdk/store/v2/streaming/abci"
+)
+
+// Block is a block read from the stream directory.
+type Block struct {
+	Height        int64
+	FinalizeBlock *streamingabci.ListenFinalizeBlockRequest
+	Commit        *streamingabci.ListenCommitRequest
+}
+
+// Reader reads the blocks of a stream directory in height order, it can be used while a Listener
+// writes to the directory. A consumer needing at-least-once delivery records the height of the
+// last block it processed and opens a reader from the next height when it restarts.
+type Reader struct {
+	dir        string
+	fromHeight int64
+
+	segment int64
+	f       *os.File
+	offset  int64
+}
+
+// NewReader returns a reader of the blocks from the given height, or from the first block of
+// the directory if fromHeight is zero. Next returns ErrGap if the block at fromHeight or any
+// block after it is missing from the directory.
+func NewReader(dir string, fromHeight int64) (*Reader, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	return &Reader{dir: dir, fromHeight: fromHeight}, nil
+}
+
+// Next returns the next block, or io.EOF if the blocks written so far have all been read. Next
+// can be called again after io.EOF to read the blocks written since.
+func (r *Reader) Next() (*Block, error) {
+	for {
+		if r.f == nil {
+			found, err := r.seek()
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				return nil, io.EOF
+			}
+		}
+
+		block, size, err := r.readBlock()
+		if errors.Is(err, errIncomplete) {
+			moved, err := r.nextSegment()
+			if err != nil {
+				return nil, err
+			}
+			if !moved {
+				return nil, io.EOF
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("segment %s: %w", segmentName(r.segment), err)
+		}
+
+		r.offset += size
+		if r.fromHeight > 0 && block.Height > r.fromHeight {
+			return nil, fmt.Errorf("%w: expected height %d, found %d", ErrGap, r.fromHeight, block.Height)
+		}
+		if block.Height >= r.fromHeight {
+			r.fromHeight = block.Height + 1
+			return block, nil
+		}
+	}
+}
+
+// seek opens the segment holding fromHeight at the offset of the closest indexed block below it.
+func (r *Reader) seek() (bool, error) {
+	segments, err := listSegments(r.dir)
+	if err != nil || len(segments) == 0 {
+		return false, err
+	}
+
+	i := sort.Search(len(segments), func(i int) bool { return segments[i] > r.fromHeight })
+	segment := segments[max(i-1, 0)]
+
+	entries, err := readIndex(r.dir, segment)
+	if err != nil {
+		return false, err
+	}
+	var offset int64
+	j := sort.Search(len(entries), func(j int) bool { return entries[j].height > r.fromHeight })
+	if j > 0 {
+		offset = entries[j-1].offset
+	}
+
+	if err := r.open(segment); err != nil {
+		return false, err
+	}
+	r.offset = offset
+	return true, nil
+}
+
+// nextSegment moves to the segment following the current one, if any.
+func (r *Reader) nextSegment() (bool, error) {
+	segments, err := listSegments(r.dir)
+	if err != nil {
+		return false, err
+	}
+
+	i := sort.Search(len(segments), func(i int) bool { return segments[i] > r.segment })
+	if i == len(segments) {
+		return false, nil
+	}
+	if err := r.open(segments[i]); err != nil {
+		return false, err
+	}
+	r.offset = 0
+	return true, nil
+}
+
+func (r *Reader) open(segment int64) error {
+	f, err := os.Open(logPath(r.dir, segment))
+	if err != nil {
+		return err
+	}
+	if r.f != nil {
+		_ = r.f.Close()
+	}
+	r.f, r.segment = f, segment
+	return nil
+}
+
+// readBlock reads the block at the current offset, returning its size in the segment.
+func (r *Reader) readBlock() (*Block, int64, error) {
+	kind, finalizePayload, err := readRecord(r.f, r.offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if kind != recordFinalizeBlock {
+		return nil, 0, fmt.Errorf("%w: expected a FinalizeBlock record at offset %d", ErrCorrupted, r.offset)
+	}
+	commitOffset := r.offset + recordHeaderSize + int64(len(finalizePayload))
+	kind, commitPayload, err := readRecord(r.f, commitOffset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if kind != recordCommit {
+		return nil, 0, fmt.Errorf("%w: expected a Commit record at offset %d", ErrCorrupted, commitOffset)
+	}
+
+	block := &Block{
+		FinalizeBlock: &streamingabci.ListenFinalizeBlockRequest{},
+		Commit:        &streamingabci.ListenCommitRequest{},
+	}
+	if err := block.FinalizeBlock.Unmarshal(finalizePayload); err != nil {
+		return nil, 0, err
+	}
+	if err := block.Commit.Unmarshal(commitPayload); err != nil {
+		return nil, 0, err
+	}
+	block.Height = block.Commit.BlockHeight
+
+	size := commitOffset + recordHeaderSize + int64(len(commitPayload)) - r.offset
+	return block, size, nil
+}
+
+// Close closes the current segment.
+func (r *Reader) Close() error {
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+// Replay calls fn with the blocks of a stream directory from the given height, or from the
+// first block if fromHeight is zero, until the last block written.
+func Replay(dir string, fromHeight int64, fn func(*Block) error) error {
+	r, err := NewReader(dir, fromHeight)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		block, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+}
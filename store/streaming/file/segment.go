@@ -0,0 +1,166 @@
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A stream directory holds segments, each named after the height of its first block. A segment
+// is a .log file of records and an .idx file with the offset of each block written to it.
+//
+// A record is a header followed by a payload:
+//
+//	kind (1 byte) | payload length (4 bytes) | CRC-32C of kind and payload (4 bytes) | payload
+//
+// A block is a FinalizeBlock record followed by a Commit record, the payloads being the
+// ListenFinalizeBlockRequest and ListenCommitRequest of the gRPC plugin protocol. An index entry
+// is the height and the offset of a block, both big endian uint64.
+const (
+	logExt = ".log"
+	idxExt = ".idx"
+
+	recordHeaderSize = 9
+	indexEntrySize   = 16
+
+	// maxRecordSize bounds the payload length read from a record header.
+	maxRecordSize = 1 << 30
+)
+
+type recordKind byte
+
+const (
+	recordFinalizeBlock recordKind = iota + 1
+	recordCommit
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrCorrupted is returned when a record doesn't match its checksum.
+	ErrCorrupted = errors.New("corrupted streaming record")
+
+	// ErrGap is returned when the blocks of the stream directory don't follow each other.
+	ErrGap = errors.New("missing streamed blocks")
+
+	// errIncomplete is returned when a record is cut short by the end of the segment.
+	errIncomplete = errors.New("incomplete streaming record")
+)
+
+func segmentName(height int64) string {
+	return fmt.Sprintf("%020d", height)
+}
+
+// listSegments returns the first heights of the segments of the directory in increasing order.
+func listSegments(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []int64
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), logExt)
+		if !ok {
+			continue
+		}
+		height, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, height)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+func logPath(dir string, segment int64) string {
+	return filepath.Join(dir, segmentName(segment)+logExt)
+}
+
+func idxPath(dir string, segment int64) string {
+	return filepath.Join(dir, segmentName(segment)+idxExt)
+}
+
+func encodeRecord(kind recordKind, payload []byte) []byte {
+	bz := make([]byte, recordHeaderSize+len(payload))
+	bz[0] = byte(kind)
+	binary.BigEndian.PutUint32(bz[1:5], uint32(len(payload)))
+	copy(bz[recordHeaderSize:], payload)
+	crc := crc32.Update(crc32.Checksum(bz[:1], crcTable), crcTable, payload)
+	binary.BigEndian.PutUint32(bz[5:9], crc)
+	return bz
+}
+
+// readRecord reads the record at the offset, it returns errIncomplete if the segment ends before
+// the end of the record.
+func readRecord(f io.ReaderAt, offset int64) (recordKind, []byte, error) {
+	var header [recordHeaderSize]byte
+	if _, err := f.ReadAt(header[:], offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, errIncomplete
+		}
+		return 0, nil, err
+	}
+
+	kind := recordKind(header[0])
+	size := binary.BigEndian.Uint32(header[1:5])
+	if kind != recordFinalizeBlock && kind != recordCommit || size > maxRecordSize {
+		return 0, nil, fmt.Errorf("%w: invalid header at offset %d", ErrCorrupted, offset)
+	}
+
+	payload := make([]byte, size)
+	if _, err := f.ReadAt(payload, offset+recordHeaderSize); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, errIncomplete
+		}
+		return 0, nil, err
+	}
+
+	crc := crc32.Update(crc32.Checksum(header[:1], crcTable), crcTable, payload)
+	if crc != binary.BigEndian.Uint32(header[5:9]) {
+		return 0, nil, fmt.Errorf("%w: checksum mismatch at offset %d", ErrCorrupted, offset)
+	}
+
+	return kind, payload, nil
+}
+
+type indexEntry struct {
+	height int64
+	offset int64
+}
+
+func encodeIndexEntry(entry indexEntry) []byte {
+	bz := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(bz[:8], uint64(entry.height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(entry.offset))
+	return bz
+}
+
+// readIndex reads the index of a segment, ignoring a trailing partial entry.
+func readIndex(dir string, segment int64) ([]indexEntry, error) {
+	bz, err := os.ReadFile(idxPath(dir, segment))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]indexEntry, len(bz)/indexEntrySize)
+	for i := range entries {
+		entry := bz[i*indexEntrySize:]
+		entries[i] = indexEntry{
+			height: int64(binary.BigEndian.Uint64(entry[:8])),
+			offset: int64(binary.BigEndian.Uint64(entry[8:16])),
+		}
+	}
+	return entries, nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"google.golang.org/grpc/codes"
+
 	"cosmossdk.io/errors"
 )
 
@@ -25,4 +27,7 @@ var (
 	// ErrInvalidRequest defines an ABCI typed error where the request contains
 	// invalid data.
 	ErrInvalidRequest = errors.Register(StoreCodespace, 7, "invalid request")
+	// ErrStorePruned is returned when a store is accessed at a height that was pruned by the
+	// pruning strategy of that store, while the other stores still hold it.
+	ErrStorePruned = errors.RegisterWithGRPCCode(StoreCodespace, 8, codes.NotFound, "height pruned for this store")
 )
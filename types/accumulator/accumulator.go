@@ -0,0 +1,183 @@
+// Package accumulator implements deferred accumulators: per-transaction deltas kept in an
+// object store and merged at the end of the block.
+//
+// Writing a hot key in every transaction (e.g. the fee collector balance, or the total supply
+// of a fee-burn module) serializes the parallel execution of the block, an accumulator instead
+// writes each delta in a slot keyed by the transaction index, so transactions never read or
+// write the slots of each other, and the end blocker merges the slots and applies the sums
+// to the real state once.
+//
+// Since every slot is owned by a single transaction, block-stm treats the prefix of the
+// accumulator as commutative, see `STMRunner.SetCommutativeKeys` and `blockexec.WithCommutativeKeys`.
+package accumulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txIndexLen is the length of the big endian transaction index suffix of the slot keys.
+const txIndexLen = 8
+
+// MergeFunc merges two deltas of the same key, it must not mutate its arguments.
+type MergeFunc[V any] func(a, b V) V
+
+// Accumulator accumulates deltas of type V by key of type K in an object store, the slot
+// of each key and transaction is encoded as `prefix | key | uint64(txIndex)`.
+type Accumulator[K, V any] struct {
+	storeKey storetypes.StoreKey
+	prefix   []byte
+	keyCodec collcodec.KeyCodec[K]
+	merge    MergeFunc[V]
+}
+
+// New creates an accumulator in the object store of storeKey, the prefix must be unique
+// among the accumulators sharing the same store.
+func New[K, V any](storeKey storetypes.StoreKey, prefix collections.Prefix, keyCodec collcodec.KeyCodec[K], merge MergeFunc[V]) Accumulator[K, V] {
+	return Accumulator[K, V]{
+		storeKey: storeKey,
+		prefix:   prefix.Bytes(),
+		keyCodec: keyCodec,
+		merge:    merge,
+	}
+}
+
+// Prefix returns the key prefix of the accumulator slots in the object store.
+func (a Accumulator[K, V]) Prefix() []byte {
+	return a.prefix
+}
+
+// StoreKey returns the key of the object store of the accumulator.
+func (a Accumulator[K, V]) StoreKey() storetypes.StoreKey {
+	return a.storeKey
+}
+
+// Add merges delta into the slot of key for the current transaction.
+func (a Accumulator[K, V]) Add(ctx context.Context, key K, delta V) error {
+	pending, found, err := a.Pending(ctx, key)
+	if err != nil {
+		return err
+	}
+	if found {
+		delta = a.merge(pending, delta)
+	}
+	return a.SetPending(ctx, key, delta)
+}
+
+// Pending returns the delta accumulated for key by the current transaction, the deltas of the
+// other transactions are never visible during the execution of the block.
+func (a Accumulator[K, V]) Pending(ctx context.Context, key K) (V, bool, error) {
+	var empty V
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slot, err := a.slotKey(key, sdkCtx.TxIndex())
+	if err != nil {
+		return empty, false, err
+	}
+
+	value := sdkCtx.ObjectStore(a.storeKey).Get(slot)
+	if value == nil {
+		return empty, false, nil
+	}
+	return value.(V), true, nil
+}
+
+// SetPending overwrites the delta of key accumulated by the current transaction, it allows
+// callers to subtract from their own pending deltas.
+func (a Accumulator[K, V]) SetPending(ctx context.Context, key K, delta V) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slot, err := a.slotKey(key, sdkCtx.TxIndex())
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.ObjectStore(a.storeKey).Set(slot, delta)
+	return nil
+}
+
+// RemovePending removes the delta of key accumulated by the current transaction.
+func (a Accumulator[K, V]) RemovePending(ctx context.Context, key K) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slot, err := a.slotKey(key, sdkCtx.TxIndex())
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.ObjectStore(a.storeKey).Delete(slot)
+	return nil
+}
+
+// Flush merges the slots of each key in transaction order and calls apply with the sum,
+// keys are visited in the order of their encoding. It should be called in the end blocker,
+// the slots are discarded together with the object store on commit.
+func (a Accumulator[K, V]) Flush(ctx context.Context, apply func(key K, sum V) error) error {
+	store := sdk.UnwrapSDKContext(ctx).ObjectStore(a.storeKey)
+
+	var (
+		current []byte
+		sum     V
+		pending bool
+	)
+	flush := func() error {
+		if !pending {
+			return nil
+		}
+		pending = false
+
+		_, key, err := a.keyCodec.DecodeNonTerminal(current)
+		if err != nil {
+			return err
+		}
+		return apply(key, sum)
+	}
+
+	it := store.Iterator(a.prefix, storetypes.PrefixEndBytes(a.prefix))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		slot := it.Key()
+		if len(slot) < len(a.prefix)+txIndexLen {
+			return fmt.Errorf("unexpected accumulator slot key length: %X", slot)
+		}
+
+		encodedKey := slot[len(a.prefix) : len(slot)-txIndexLen]
+		if !pending || !bytes.Equal(current, encodedKey) {
+			if err := flush(); err != nil {
+				return err
+			}
+			current = encodedKey
+			sum = it.Value().(V)
+			pending = true
+			continue
+		}
+
+		sum = a.merge(sum, it.Value().(V))
+	}
+
+	return flush()
+}
+
+// slotKey encodes the key of the slot of key for the transaction.
+func (a Accumulator[K, V]) slotKey(key K, txIndex int) ([]byte, error) {
+	keySize := a.keyCodec.SizeNonTerminal(key)
+	slot := make([]byte, len(a.prefix)+keySize+txIndexLen)
+	copy(slot, a.prefix)
+
+	n, err := a.keyCodec.EncodeNonTerminal(slot[len(a.prefix):], key)
+	if err != nil {
+		return nil, err
+	}
+	if n != keySize {
+		return nil, fmt.Errorf("unexpected accumulator key size, expected %d, got %d", keySize, n)
+	}
+
+	binary.BigEndian.PutUint64(slot[len(a.prefix)+keySize:], uint64(txIndex))
+	return slot, nil
+}
@@ -0,0 +1,83 @@
+package accumulator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types/accumulator"
+)
+
+func addInts(a, b math.Int) math.Int { return a.Add(b) }
+
+func TestAccumulatorFlush(t *testing.T) {
+	okey := storetypes.NewObjectStoreKey("object:test")
+	testCtx := testutil.DefaultContextWithObjectStore(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"), okey)
+	ctx := testCtx.Ctx
+
+	acc := accumulator.New(okey, collections.NewPrefix(1), collections.StringKey, addInts)
+	// an accumulator sharing the store must not see the slots of the other one
+	other := accumulator.New(okey, collections.NewPrefix(2), collections.StringKey, addInts)
+
+	deltas := []struct {
+		txIndex int
+		key     string
+		amount  int64
+	}{
+		{0, "b", 1},
+		{0, "b", 2},
+		{1, "a", 10},
+		{2, "b", 4},
+		{3, "ab", 7},
+	}
+	for _, d := range deltas {
+		require.NoError(t, acc.Add(ctx.WithTxIndex(d.txIndex), d.key, math.NewInt(d.amount)))
+	}
+	require.NoError(t, other.Add(ctx.WithTxIndex(1), "a", math.NewInt(100)))
+
+	// only the slot of the current transaction is visible
+	pending, found, err := acc.Pending(ctx.WithTxIndex(0), "b")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(3), pending)
+	_, found, err = acc.Pending(ctx.WithTxIndex(1), "b")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	sums := make(map[string]math.Int)
+	var order []string
+	require.NoError(t, acc.Flush(ctx, func(key string, sum math.Int) error {
+		sums[key] = sum
+		order = append(order, key)
+		return nil
+	}))
+	require.Equal(t, []string{"a", "ab", "b"}, order)
+	require.Equal(t, math.NewInt(10), sums["a"])
+	require.Equal(t, math.NewInt(7), sums["ab"])
+	require.Equal(t, math.NewInt(7), sums["b"])
+}
+
+func TestAccumulatorSetPending(t *testing.T) {
+	okey := storetypes.NewObjectStoreKey("object:test")
+	testCtx := testutil.DefaultContextWithObjectStore(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"), okey)
+	ctx := testCtx.Ctx.WithTxIndex(5)
+
+	acc := accumulator.New(okey, collections.NewPrefix(1), collections.StringKey, addInts)
+	require.NoError(t, acc.Add(ctx, "a", math.NewInt(10)))
+	require.NoError(t, acc.SetPending(ctx, "a", math.NewInt(4)))
+	require.NoError(t, acc.Add(ctx, "b", math.NewInt(1)))
+	require.NoError(t, acc.RemovePending(ctx, "b"))
+
+	var keys []string
+	require.NoError(t, acc.Flush(ctx, func(key string, sum math.Int) error {
+		keys = append(keys, key)
+		require.Equal(t, math.NewInt(4), sum)
+		return nil
+	}))
+	require.Equal(t, []string{"a"}, keys)
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
 )
 
 var (
@@ -110,6 +111,24 @@ func (a genericAddressKey[T]) SizeNonTerminal(key T) int {
 	return collections.BytesKey.SizeNonTerminal(key)
 }
 
+// SchemaCodec implements collcodec.HasSchemaCodec, the key is indexed as an address.
+func (a genericAddressKey[T]) SchemaCodec() (collcodec.SchemaCodec[T], error) {
+	return collcodec.SchemaCodec[T]{
+		Fields: []schema.Field{{Kind: schema.AddressKind}},
+		ToSchemaType: func(key T) (any, error) {
+			return []byte(key), nil
+		},
+		FromSchemaType: func(value any) (T, error) {
+			bz, ok := value.([]byte)
+			if !ok {
+				var zero T
+				return zero, fmt.Errorf("expected []byte, got %T", value)
+			}
+			return T(bz), nil
+		},
+	}, nil
+}
+
 // Deprecated: lengthPrefixedAddressKey is a special key codec used to retain state backwards compatibility
 // when a generic address key (be: AccAddress, ValAddress, ConsAddress), is used as an index key.
 // More docs can be found in the LengthPrefixedAddressKey function.
@@ -182,6 +201,27 @@ func (i intValueCodec) ValueType() string {
 	return Int
 }
 
+// SchemaCodec implements collcodec.HasSchemaCodec, the value is indexed as an integer.
+func (i intValueCodec) SchemaCodec() (collcodec.SchemaCodec[math.Int], error) {
+	return collcodec.SchemaCodec[math.Int]{
+		Fields: []schema.Field{{Kind: schema.IntegerKind}},
+		ToSchemaType: func(value math.Int) (any, error) {
+			return value.String(), nil
+		},
+		FromSchemaType: func(value any) (math.Int, error) {
+			str, ok := value.(string)
+			if !ok {
+				return math.Int{}, fmt.Errorf("expected string, got %T", value)
+			}
+			v, ok := math.NewIntFromString(str)
+			if !ok {
+				return math.Int{}, fmt.Errorf("invalid integer %q", str)
+			}
+			return v, nil
+		},
+	}, nil
+}
+
 type uintValueCodec struct{}
 
 func (i uintValueCodec) Encode(value math.Uint) ([]byte, error) {
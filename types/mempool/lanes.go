@@ -0,0 +1,202 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ ExtMempool = (*LaneMempool)(nil)
+	_ Iterator   = (*laneIterator)(nil)
+)
+
+// Lane is a partition of the mempool holding the transactions that match a
+// given criterion, e.g. oracle, governance or IBC relayer transactions, which
+// is allotted its own share of the block space.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+
+	// Mempool stores the transactions of the lane and defines their order
+	// within the lane, e.g. a PriorityNonceMempool ordering them by gas price.
+	Mempool Mempool
+
+	// Match reports whether a transaction belongs to the lane. A transaction
+	// belongs to the first lane it matches, so the last lane usually matches
+	// every transaction. Match must be deterministic, as it is also used to
+	// verify proposals.
+	Match func(tx sdk.Tx) bool
+
+	// MaxBlockSpace is the maximum share of the block bytes and gas that the
+	// transactions of the lane can use, between 0 and 1. A nil or zero value
+	// lets the lane use the block space left by the previous lanes.
+	MaxBlockSpace math.LegacyDec
+}
+
+// MatchAll is a Lane Match function matching every transaction, to be used
+// by the default lane.
+func MatchAll(sdk.Tx) bool { return true }
+
+// MaxSpace returns the maximum amount of a block resource, such as bytes or
+// gas, that the lane can use given the block limit.
+func (l Lane) MaxSpace(blockLimit uint64) uint64 {
+	if l.MaxBlockSpace.IsNil() || l.MaxBlockSpace.IsZero() {
+		return blockLimit
+	}
+	return l.MaxBlockSpace.MulInt(math.NewIntFromUint64(blockLimit)).TruncateInt().Uint64()
+}
+
+// LaneMempool is a mempool made of multiple lanes, each one being a mempool
+// with a share of the block space. Transactions are inserted in the first lane
+// they match and selected lane after lane, in the order of the lanes, so that
+// a proposal is made of the transactions of the first lane, followed by the
+// transactions of the second lane, and so on.
+//
+// When the mempool of an application is a LaneMempool, the
+// DefaultProposalHandler enforces the block space share of every lane in
+// PrepareProposal and verifies the order and the limits of the lanes in
+// ProcessProposal.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a LaneMempool made of the provided lanes, in order of
+// precedence. It panics if a lane is misconfigured.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if len(lanes) == 0 {
+		panic("lane mempool requires at least one lane")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		switch {
+		case lane.Name == "":
+			panic("lane name cannot be empty")
+		case names[lane.Name]:
+			panic(fmt.Sprintf("duplicate lane %s", lane.Name))
+		case lane.Mempool == nil:
+			panic(fmt.Sprintf("lane %s has no mempool", lane.Name))
+		case lane.Match == nil:
+			panic(fmt.Sprintf("lane %s has no match function", lane.Name))
+		case !lane.MaxBlockSpace.IsNil() && (lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(math.LegacyOneDec())):
+			panic(fmt.Sprintf("lane %s max block space must be between 0 and 1, got %s", lane.Name, lane.MaxBlockSpace))
+		}
+		names[lane.Name] = true
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// Lanes returns the lanes of the mempool, in order of precedence.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane a transaction belongs to, or -1 if
+// the transaction matches no lane.
+func (mp *LaneMempool) LaneIndex(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert inserts a transaction in the first lane it matches.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx, option InsertOption) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return fmt.Errorf("tx matches no mempool lane")
+	}
+	return mp.lanes[i].Mempool.Insert(ctx, tx, option)
+}
+
+// Select returns an Iterator over the transactions of every lane, in the order
+// of the lanes.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{ctx: ctx, txs: txs, lanes: mp.lanes, lane: -1}
+	return iter.nextLane()
+}
+
+// SelectBy iterates over the transactions of every lane, in the order of the
+// lanes, until the callback returns false.
+func (mp *LaneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(PooledTx) bool) {
+	for _, lane := range mp.lanes {
+		stop := false
+		SelectBy(ctx, lane.Mempool, txs, func(tx PooledTx) bool {
+			stop = !callback(tx)
+			return !stop
+		})
+		if stop {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes a transaction from the lane it belongs to.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// RemoveWithReason removes a transaction from the lane it belongs to with the
+// provided reason.
+func (mp *LaneMempool) RemoveWithReason(ctx context.Context, tx sdk.Tx, reason RemoveReason) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+	return RemoveWithReason(ctx, mp.lanes[i].Mempool, tx, reason)
+}
+
+// laneIterator iterates over the transactions of the lanes, one lane after the
+// other.
+type laneIterator struct {
+	ctx     context.Context
+	txs     [][]byte
+	lanes   []Lane
+	lane    int
+	current Iterator
+}
+
+func (i *laneIterator) Next() Iterator {
+	i.current = i.current.Next()
+	if i.current == nil {
+		return i.nextLane()
+	}
+	return i
+}
+
+func (i *laneIterator) Tx() PooledTx {
+	return i.current.Tx()
+}
+
+// nextLane moves the iterator to the first transaction of the next non-empty
+// lane, returning nil once every lane was iterated.
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane+1 < len(i.lanes) {
+		i.lane++
+		i.current = i.lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+		if i.current != nil {
+			return i
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	relayer := accounts[0].Address
+	sa := accounts[1].Address
+	sb := accounts[2].Address
+
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "relayer",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			Match:         func(tx sdk.Tx) bool { return tx.(testTx).address.Equals(relayer) },
+			MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+		},
+		mempool.Lane{
+			Name:    "default",
+			Mempool: mempool.DefaultPriorityMempool(),
+			Match:   mempool.MatchAll,
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 1, nonce: 0, address: relayer},
+		{id: 2, priority: 20, nonce: 0, address: sb},
+		{id: 3, priority: 2, nonce: 1, address: relayer},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx, mempool.InsertOption{}))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 0, mp.LaneIndex(txs[1]))
+	require.Equal(t, 1, mp.LaneIndex(txs[0]))
+
+	// the relayer lane comes first regardless of the priorities
+	expected := []int{1, 3, 2, 0}
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 100)
+	require.Len(t, orderedTxs, len(expected))
+	for i, tx := range orderedTxs {
+		require.Equal(t, expected[i], tx.(testTx).id)
+	}
+
+	var selected []int
+	mempool.SelectBy(ctx, mp, nil, func(tx mempool.PooledTx) bool {
+		selected = append(selected, tx.Tx.(testTx).id)
+		return len(selected) < 3
+	})
+	require.Equal(t, expected[:3], selected)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.RemoveWithReason(ctx, txs[0], mempool.RemoveReason{Caller: mempool.CallerRunTxFinalize}))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+
+	require.Equal(t, uint64(200), mp.Lanes()[0].MaxSpace(1000))
+	require.Equal(t, uint64(1000), mp.Lanes()[1].MaxSpace(1000))
+}
+
+func TestLaneMempool_NoMatch(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	mp := mempool.NewLaneMempool(mempool.Lane{
+		Name:    "none",
+		Mempool: mempool.DefaultPriorityMempool(),
+		Match:   func(sdk.Tx) bool { return false },
+	})
+	tx := testTx{id: 0, priority: 10, nonce: 0, address: accounts[0].Address}
+	require.ErrorContains(t, mp.Insert(ctx, tx, mempool.InsertOption{}), "matches no mempool lane")
+	require.Nil(t, mp.Select(ctx, nil))
+}
+
+func TestNewLaneMempool_Invalid(t *testing.T) {
+	lane := mempool.Lane{Name: "default", Mempool: mempool.DefaultPriorityMempool(), Match: mempool.MatchAll}
+
+	require.Panics(t, func() { mempool.NewLaneMempool() })
+	require.Panics(t, func() { mempool.NewLaneMempool(lane, lane) })
+
+	invalid := lane
+	invalid.MaxBlockSpace = math.LegacyNewDec(2)
+	require.Panics(t, func() { mempool.NewLaneMempool(invalid) })
+
+	invalid = lane
+	invalid.Match = nil
+	require.Panics(t, func() { mempool.NewLaneMempool(invalid) })
+}
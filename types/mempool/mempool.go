@@ -65,6 +65,8 @@ const (
 	CallerRunTxRecheck                 RemovalCaller = "run_tx.recheck"
 	CallerRunTxFinalize                RemovalCaller = "run_tx.finalize"
 	CallerPrepareProposalRemoveInvalid RemovalCaller = "prepare_proposal.remove_invalid"
+	CallerMempoolEvict                 RemovalCaller = "mempool.evict"
+	CallerMempoolReplace               RemovalCaller = "mempool.replace"
 )
 
 // RemoveReason is the reason for removing a transaction from the mempool.
@@ -90,6 +92,7 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrMempoolTxEvicted     = errors.New("tx evicted from mempool by a higher priority tx")
 )
 
 // SelectBy is compatible with old interface to avoid breaking api.
@@ -40,6 +40,20 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictOnFull changes the behavior of a bounded mempool (MaxTx > 0) when
+		// it is full. Instead of rejecting every new transaction, Insert evicts the
+		// lowest priority sender chain, that is the lowest priority transaction in
+		// the mempool along with the transactions of the same sender with a
+		// higher nonce, to make room for a transaction with a higher priority. A
+		// transaction with a priority lower than or equal to the lowest priority
+		// in the mempool is still rejected with ErrMempoolTxMaxCapacity. Replacing
+		// a transaction is always possible when the mempool is full.
+		EvictOnFull bool
+
+		// OnRemove is a callback to be called when a tx is removed from the mempool
+		// with RemoveWithReason, or evicted or replaced during Insert.
+		OnRemove func(tx sdk.Tx, reason RemoveReason)
+
 		// SignerExtractor is an implementation which retrieves signer data from an sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -123,6 +137,22 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 	}
 }
 
+// NewPriorityBumpTxReplacement returns a TxReplacement rule implementing
+// replace-by-fee for int64 priorities, such as the default ones derived from
+// the fee. A tx replaces the tx with the same sender and nonce only if its
+// priority is higher and exceeds the old priority by at least minBumpPercent
+// percent.
+func NewPriorityBumpTxReplacement(minBumpPercent int64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		if np <= op {
+			return false
+		}
+		// computed in two steps to avoid overflowing for large priorities.
+		minBump := op/100*minBumpPercent + op%100*minBumpPercent/100
+		return np-op >= minBump
+	}
+}
+
 // skiplistComparable is a comparator for txKeys that first compares priority,
 // then weight, then sender, then nonce, uniquely identifying a transaction.
 //
@@ -206,10 +236,17 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
+//
+// When the mempool is full and EvictOnFull is set, inserting a tx evicts the
+// lowest priority sender chain if the tx has a higher priority.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx, option InsertOption) error {
+	// evicted and replaced txs are reported once the lock is released
+	var removed []removedTx
+	defer func() { mp.reportRemoved(removed) }()
+
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx && !mp.cfg.EvictOnFull {
 		return ErrMempoolTxMaxCapacity
 	} else if mp.cfg.MaxTx < 0 {
 		return nil
@@ -225,7 +262,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx, option
 	}
 
 	sig := sigs[0]
-	sender := sig.Signer.String()
+	sender := sig.Sender()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
 	nonce, err := ChooseNonce(sig.Sequence, tx)
 	if err != nil {
@@ -233,6 +270,17 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx, option
 	}
 
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
+
+	// a full mempool only accepts a new tx if it can evict a lower priority one.
+	if !txExists && mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		evicted, err := mp.evictSenderChain(sender, nonce, priority)
+		if err != nil {
+			return err
+		}
+		removed = append(removed, evicted...)
+	}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
@@ -251,17 +299,18 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx, option
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(PooledTx).Tx, tx) {
+	if txExists {
+		oldTx := senderIndex.Get(key).Value.(PooledTx).Tx
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
-				senderIndex.Get(key).Value.(PooledTx).Tx,
+				oldTx,
 				tx,
 			)
 		}
+		removed = append(removed, removedTx{tx: oldTx, reason: RemoveReason{Caller: CallerMempoolReplace}})
 
 		oldKey := txMeta[C]{nonce: nonce, sender: sender, priority: oldScore.priority, weight: oldScore.weight}
 		mp.priorityIndex.Remove(oldKey)
@@ -451,6 +500,55 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 	return mp.priorityIndex.Len()
 }
 
+// removedTx is a tx removed from the mempool along with the reason of its removal.
+type removedTx struct {
+	tx     sdk.Tx
+	reason RemoveReason
+}
+
+// reportRemoved calls the OnRemove callback for each removed tx. It must be
+// called without holding the mempool lock.
+func (mp *PriorityNonceMempool[C]) reportRemoved(removed []removedTx) {
+	if mp.cfg.OnRemove == nil {
+		return
+	}
+	for _, r := range removed {
+		mp.cfg.OnRemove(r.tx, r.reason)
+	}
+}
+
+// evictSenderChain makes room for a tx of the given sender, nonce and priority
+// by evicting the lowest priority tx in the mempool along with the txs of the
+// same sender with a higher nonce, which could not be executed anymore. It
+// returns ErrMempoolTxMaxCapacity if the lowest priority tx does not have a
+// lower priority than the new tx, or if evicting it would leave a nonce gap
+// before the new tx.
+func (mp *PriorityNonceMempool[C]) evictSenderChain(sender string, nonce uint64, priority C) ([]removedTx, error) {
+	lowest := mp.priorityIndex.Back().Key().(txMeta[C])
+	if mp.cfg.TxPriority.Compare(lowest.priority, priority) >= 0 {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+	if lowest.sender == sender && lowest.nonce < nonce {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+
+	var nonces []uint64
+	for e := mp.senderIndices[lowest.sender].Get(txMeta[C]{nonce: lowest.nonce}); e != nil; e = e.Next() {
+		nonces = append(nonces, e.Key().(txMeta[C]).nonce)
+	}
+
+	evicted := make([]removedTx, 0, len(nonces))
+	for _, n := range nonces {
+		tx, err := mp.remove(lowest.sender, n)
+		if err != nil {
+			return evicted, err
+		}
+		evicted = append(evicted, removedTx{tx: tx, reason: RemoveReason{Caller: CallerMempoolEvict, Error: ErrMempoolTxEvicted}})
+	}
+
+	return evicted, nil
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
@@ -463,7 +561,7 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	sig := sigs[0]
-	sender := sig.Signer.String()
+	sender := sig.Sender()
 	nonce, err := ChooseNonce(sig.Sequence, tx)
 	if err != nil {
 		return err
@@ -472,16 +570,28 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
+	_, err = mp.remove(sender, nonce)
+	return err
+}
+
+// remove removes the tx of the given sender and nonce from the mempool and
+// returns it. The caller must hold the mempool lock.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) (sdk.Tx, error) {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
-		return ErrTxNotFound
+		return nil, ErrTxNotFound
 	}
 	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
 
 	senderTxs, ok := mp.senderIndices[sender]
 	if !ok {
-		return fmt.Errorf("sender %s not found", sender)
+		return nil, fmt.Errorf("sender %s not found", sender)
+	}
+
+	senderElement := senderTxs.Get(tk)
+	if senderElement == nil {
+		return nil, ErrTxNotFound
 	}
 
 	mp.priorityIndex.Remove(tk)
@@ -489,12 +599,18 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
 
-	return nil
+	return senderElement.Value.(PooledTx).Tx, nil
 }
 
-// RemoveWithReason is a proxy to Remove for this mempool.
-func (mp *PriorityNonceMempool[C]) RemoveWithReason(_ context.Context, tx sdk.Tx, _ RemoveReason) error {
-	return mp.Remove(tx)
+// RemoveWithReason removes a transaction from the mempool and reports the
+// removal to the OnRemove callback, if any.
+func (mp *PriorityNonceMempool[C]) RemoveWithReason(_ context.Context, tx sdk.Tx, reason RemoveReason) error {
+	if err := mp.Remove(tx); err != nil {
+		return err
+	}
+
+	mp.reportRemoved([]removedTx{{tx: tx, reason: reason}})
+	return nil
 }
 
 func IsEmpty[C comparable](mempool Mempool) error {
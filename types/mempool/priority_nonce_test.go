@@ -1100,3 +1100,74 @@ func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
 		require.Equal(t, txs[i].id, tx.(testTx).id)
 	}
 }
+
+func TestPriorityNonceMempool_EvictOnFull(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	var removed []mempool.RemoveReason
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTx:           4,
+			EvictOnFull:     true,
+			TxReplacement:   mempool.NewPriorityBumpTxReplacement(10),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+			OnRemove: func(_ sdk.Tx, reason mempool.RemoveReason) {
+				removed = append(removed, reason)
+			},
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 1, address: sa},
+		{id: 1, priority: 5, nonce: 2, address: sa},
+		{id: 2, priority: 20, nonce: 3, address: sa},
+		{id: 3, priority: 8, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx, mempool.InsertOption{}))
+	}
+
+	// a tx with a priority not higher than the lowest one is rejected
+	tx := testTx{id: 4, priority: 5, nonce: 1, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx, mempool.InsertOption{}), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 4, mp.CountTx())
+
+	// replacement is possible when full
+	tx = testTx{id: 5, priority: 9, nonce: 1, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx, mempool.InsertOption{}))
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, []mempool.RemoveReason{{Caller: mempool.CallerMempoolReplace}}, removed)
+
+	// the lowest priority tx of sa is evicted along with the following nonce
+	tx = testTx{id: 6, priority: 6, nonce: 1, address: sc}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx, mempool.InsertOption{}))
+	require.Equal(t, 3, mp.CountTx())
+	require.Len(t, removed, 3)
+	for _, reason := range removed[1:] {
+		require.Equal(t, mempool.CallerMempoolEvict, reason.Caller)
+		require.ErrorIs(t, reason.Error, mempool.ErrMempoolTxEvicted)
+	}
+	require.Equal(t, txs[0], mp.NextSenderTx(sa.String()))
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 100)
+	require.Equal(t, []int{0, 5, 6}, []int{orderedTxs[0].(testTx).id, orderedTxs[1].(testTx).id, orderedTxs[2].(testTx).id})
+
+	// removals with a reason are reported too
+	require.NoError(t, mp.RemoveWithReason(ctx, tx, mempool.RemoveReason{Caller: mempool.CallerRunTxFinalize}))
+	require.Equal(t, mempool.CallerRunTxFinalize, removed[3].Caller)
+}
+
+func TestNewPriorityBumpTxReplacement(t *testing.T) {
+	replace := mempool.NewPriorityBumpTxReplacement(20)
+	require.False(t, replace(20, 15, nil, nil))
+	require.False(t, replace(20, 23, nil, nil))
+	require.True(t, replace(20, 24, nil, nil))
+	require.False(t, replace(0, 0, nil, nil))
+	require.True(t, replace(0, 1, nil, nil))
+	require.True(t, replace(math.MaxInt64/2, math.MaxInt64/2+math.MaxInt64/10, nil, nil))
+}
@@ -11,6 +11,9 @@ import (
 type SignerData struct {
 	Signer   sdk.AccAddress
 	Sequence uint64
+	// Lane is the sequence lane of Sequence, the transactions of each lane of a signer
+	// are ordered independently. The lane 0 is the sequence of the account.
+	Lane uint64
 }
 
 // NewSignerData returns a new SignerData instance.
@@ -21,6 +24,15 @@ func NewSignerData(signer sdk.AccAddress, sequence uint64) SignerData {
 	}
 }
 
+// Sender returns the key by which the transactions of the signer are nonce ordered, each
+// sequence lane of a signer being a sender of its own.
+func (s SignerData) Sender() string {
+	if s.Lane == 0 {
+		return s.Signer.String()
+	}
+	return fmt.Sprintf("%s/%d", s.Signer, s.Lane)
+}
+
 // String implements the fmt.Stringer interface.
 func (s SignerData) String() string {
 	return fmt.Sprintf("SignerData{Signer: %s, Sequence: %d}", s.Signer, s.Sequence)
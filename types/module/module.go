@@ -46,6 +46,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/genesis"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -924,6 +925,26 @@ func (m *Manager) GetVersionMap() VersionMap {
 	return vermap
 }
 
+// ModuleCodecs returns the codecs decoding the state of the modules implementing
+// schema.HasModuleCodec, by module name.
+func (m *Manager) ModuleCodecs() (map[string]schema.ModuleCodec, error) {
+	codecs := make(map[string]schema.ModuleCodec)
+	for name, mod := range m.Modules {
+		mod, ok := mod.(schema.HasModuleCodec)
+		if !ok {
+			continue
+		}
+
+		cdc, err := mod.ModuleCodec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the codec of module %s: %w", name, err)
+		}
+		codecs[name] = cdc
+	}
+
+	return codecs, nil
+}
+
 // ModuleNames returns list of all module names, without any particular order.
 func (m *Manager) ModuleNames() []string {
 	return slices.Collect(maps.Keys(m.Modules))
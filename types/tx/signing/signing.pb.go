@@ -47,6 +47,15 @@ const (
 	//
 	// Since: cosmos-sdk 0.46
 	SignMode_SIGN_MODE_DIRECT_AUX SignMode = 3
+	// SIGN_MODE_BLS_AGGREGATE specifies a signing mode for BLS12-381 signers
+	// which uses SignDoc without the account number, so that all the signers
+	// sign the same bytes and their signatures can be aggregated. The aggregated
+	// signature is carried by the first signer using this sign mode, the
+	// signatures of the other signers using it are left empty, and it is
+	// verified once for all of them.
+	//
+	// Since: cosmos-sdk 0.54
+	SignMode_SIGN_MODE_BLS_AGGREGATE SignMode = 4
 	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
 	// Amino JSON and will be removed in the future.
 	SignMode_SIGN_MODE_LEGACY_AMINO_JSON SignMode = 127
@@ -67,6 +76,7 @@ var SignMode_name = map[int32]string{
 	0:   "SIGN_MODE_UNSPECIFIED",
 	1:   "SIGN_MODE_DIRECT",
 	3:   "SIGN_MODE_DIRECT_AUX",
+	4:   "SIGN_MODE_BLS_AGGREGATE",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
 }
@@ -75,6 +85,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_UNSPECIFIED":       0,
 	"SIGN_MODE_DIRECT":            1,
 	"SIGN_MODE_DIRECT_AUX":        3,
+	"SIGN_MODE_BLS_AGGREGATE":     4,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
 }
@@ -206,7 +217,6 @@ type SignatureDescriptor_Data struct {
 	// sum is the oneof that specifies whether this represents single or multi-signature data
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*SignatureDescriptor_Data_Single_
 	//	*SignatureDescriptor_Data_Multi_
 	Sum isSignatureDescriptor_Data_Sum `protobuf_oneof:"sum"`
@@ -414,43 +424,44 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
+	// 588 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0xc4, 0xad, 0xd2, 0xe9, 0xa7, 0x4f, 0x66, 0x09, 0x28, 0x35, 0xc8, 0x54, 0xe5,
-	0x40, 0x55, 0xa9, 0x6b, 0xa5, 0x3d, 0xa0, 0x72, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x53,
-	0xa9, 0x70, 0xb1, 0x6c, 0x67, 0x6b, 0xac, 0xc6, 0x5e, 0xe3, 0x5d, 0xa3, 0xfa, 0xc4, 0x2b, 0xf0,
-	0x16, 0x88, 0xa7, 0xe0, 0xc0, 0x85, 0x63, 0x8f, 0x1c, 0x51, 0xfb, 0x0c, 0xdc, 0x51, 0xed, 0x38,
-	0x09, 0xa8, 0x08, 0xd1, 0x93, 0x35, 0x33, 0xff, 0xfd, 0xcd, 0x7f, 0x35, 0xb3, 0x86, 0x27, 0x3e,
-	0x65, 0x11, 0x65, 0x1a, 0x3f, 0xd7, 0x58, 0x18, 0xc4, 0x61, 0x1c, 0x68, 0xef, 0xda, 0x1e, 0xe1,
-	0x6e, 0xbb, 0x8a, 0x71, 0x92, 0x52, 0x4e, 0xd1, 0x4a, 0x29, 0xc4, 0xfc, 0x1c, 0x57, 0x85, 0x89,
-	0x50, 0xd9, 0x9c, 0x30, 0xfc, 0x34, 0x4f, 0x38, 0xd5, 0xa2, 0x6c, 0xcc, 0x43, 0x16, 0xce, 0x40,
-	0x55, 0xa2, 0x24, 0x29, 0x2b, 0x01, 0xa5, 0xc1, 0x98, 0x68, 0x45, 0xe4, 0x65, 0xa7, 0x9a, 0x1b,
-	0xe7, 0x65, 0x69, 0xed, 0x14, 0x9a, 0x76, 0x18, 0xc4, 0x2e, 0xcf, 0x52, 0xd2, 0x25, 0xcc, 0x4f,
-	0xc3, 0x84, 0xd3, 0x94, 0xa1, 0x01, 0x00, 0xab, 0xf2, 0xac, 0x25, 0xae, 0xd6, 0xd7, 0x97, 0xb7,
-	0x30, 0xfe, 0xa3, 0x23, 0x7c, 0x03, 0xc4, 0x9a, 0x23, 0xac, 0xfd, 0x90, 0xe0, 0xee, 0x0d, 0x1a,
-	0xb4, 0x0d, 0x90, 0x64, 0xde, 0x38, 0xf4, 0x9d, 0x33, 0x92, 0xb7, 0xc4, 0x55, 0x71, 0x7d, 0x79,
-	0xab, 0x89, 0x4b, 0xbf, 0xb8, 0xf2, 0x8b, 0xf5, 0x38, 0xb7, 0x96, 0x4a, 0xdd, 0x3e, 0xc9, 0x51,
-	0x0f, 0xa4, 0x91, 0xcb, 0xdd, 0x56, 0xad, 0x90, 0x6f, 0xff, 0x9b, 0x2d, 0xdc, 0x75, 0xb9, 0x6b,
-	0x15, 0x00, 0xa4, 0x40, 0x83, 0x91, 0xb7, 0x19, 0x89, 0x7d, 0xd2, 0xaa, 0xaf, 0x8a, 0xeb, 0x92,
-	0x35, 0x8d, 0x95, 0x2f, 0x75, 0x90, 0xae, 0xa5, 0x68, 0x08, 0x8b, 0x2c, 0x8c, 0x83, 0x31, 0x99,
-	0xd8, 0x7b, 0x76, 0x8b, 0x7e, 0xd8, 0x2e, 0x08, 0x7b, 0x82, 0x35, 0x61, 0xa1, 0x97, 0xb0, 0x50,
-	0x4c, 0x69, 0x72, 0x89, 0x9d, 0xdb, 0x40, 0xfb, 0xd7, 0x80, 0x3d, 0xc1, 0x2a, 0x49, 0x8a, 0x03,
-	0x8b, 0x65, 0x1b, 0xf4, 0x14, 0xa4, 0x88, 0x8e, 0x4a, 0xc3, 0xff, 0x6f, 0x3d, 0xfe, 0x0b, 0xbb,
-	0x4f, 0x47, 0xc4, 0x2a, 0x0e, 0xa0, 0x87, 0xb0, 0x34, 0x1d, 0x5a, 0xe1, 0xec, 0x3f, 0x6b, 0x96,
-	0x50, 0x3e, 0x89, 0xb0, 0x50, 0xf4, 0x44, 0xfb, 0xd0, 0xf0, 0x42, 0xee, 0xa6, 0xa9, 0x5b, 0x0d,
-	0x4d, 0xab, 0x9a, 0x94, 0x3b, 0x89, 0xa7, 0x2b, 0x58, 0x75, 0xea, 0xd0, 0x28, 0x71, 0x7d, 0xbe,
-	0x1b, 0x72, 0xfd, 0xfa, 0x98, 0x35, 0x05, 0x20, 0xfb, 0x97, 0x5d, 0xab, 0x15, 0xbb, 0x76, 0xab,
-	0xa1, 0xce, 0x61, 0x76, 0x17, 0xa0, 0xce, 0xb2, 0x68, 0xe3, 0xa3, 0x08, 0x8d, 0xea, 0x8e, 0x68,
-	0x05, 0xee, 0xd9, 0x66, 0x6f, 0xe0, 0xf4, 0x0f, 0xbb, 0x86, 0x73, 0x3c, 0xb0, 0x8f, 0x8c, 0x8e,
-	0xf9, 0xdc, 0x34, 0xba, 0xb2, 0x80, 0x9a, 0x20, 0xcf, 0x4a, 0x5d, 0xd3, 0x32, 0x3a, 0x43, 0x59,
-	0x44, 0x2d, 0x68, 0xfe, 0x9e, 0x75, 0xf4, 0xe3, 0x13, 0xb9, 0x8e, 0x1e, 0xc1, 0x83, 0x59, 0xe5,
-	0xc0, 0xe8, 0xe9, 0x9d, 0x57, 0x8e, 0xde, 0x37, 0x07, 0x87, 0xce, 0x0b, 0xfb, 0x70, 0x20, 0xbf,
-	0x47, 0xf7, 0xe1, 0xce, 0x4c, 0x60, 0x98, 0x47, 0x4e, 0x7b, 0xa7, 0x2d, 0x7f, 0x16, 0xd7, 0xa4,
-	0x46, 0x4d, 0xae, 0x6d, 0xcc, 0xd5, 0x86, 0xc6, 0xc9, 0xf0, 0x58, 0x3f, 0xd8, 0xed, 0x7d, 0xbd,
-	0x54, 0xc5, 0x8b, 0x4b, 0x55, 0xfc, 0x7e, 0xa9, 0x8a, 0x1f, 0xae, 0x54, 0xe1, 0xe2, 0x4a, 0x15,
-	0xbe, 0x5d, 0xa9, 0xc2, 0xeb, 0xcd, 0x20, 0xe4, 0x6f, 0x32, 0x0f, 0xfb, 0x34, 0xd2, 0xaa, 0x87,
-	0x5f, 0x7c, 0x36, 0xd9, 0xe8, 0x4c, 0xe3, 0x79, 0x42, 0xe6, 0xff, 0x26, 0xde, 0x62, 0xf1, 0x6c,
-	0xb6, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x53, 0x86, 0x47, 0x69, 0x04, 0x00, 0x00,
+	0x14, 0x85, 0xe3, 0xc4, 0xad, 0xd2, 0xdb, 0x5f, 0xbf, 0xcc, 0x10, 0x20, 0x4d, 0x51, 0x88, 0xca,
+	0x82, 0xaa, 0x52, 0xc7, 0x4a, 0xbb, 0x40, 0x65, 0xe7, 0x24, 0xc6, 0x0d, 0x6d, 0xd2, 0x62, 0xa7,
+	0x52, 0x61, 0x63, 0xd9, 0xce, 0xd4, 0x58, 0x8d, 0x3d, 0xc6, 0x33, 0x46, 0xf5, 0x8a, 0x57, 0xe0,
+	0x35, 0x78, 0x0a, 0x16, 0xb0, 0x60, 0xd9, 0x25, 0x4b, 0xd4, 0x3e, 0x03, 0x7b, 0x54, 0x3b, 0x8e,
+	0x03, 0x2a, 0x42, 0x64, 0x65, 0xcd, 0x3d, 0x67, 0xbe, 0x7b, 0x46, 0x77, 0x3c, 0xf0, 0xc4, 0xa1,
+	0xcc, 0xa7, 0x4c, 0xe6, 0x17, 0x32, 0xf3, 0xdc, 0xc0, 0x0b, 0x5c, 0xf9, 0x5d, 0xdb, 0x26, 0xdc,
+	0x6a, 0xe7, 0x6b, 0x1c, 0x46, 0x94, 0x53, 0xb4, 0x96, 0x19, 0x31, 0xbf, 0xc0, 0xb9, 0x30, 0x35,
+	0x36, 0xb6, 0xa7, 0x0c, 0x27, 0x4a, 0x42, 0x4e, 0x65, 0x3f, 0x9e, 0x70, 0x8f, 0x79, 0x05, 0x28,
+	0x2f, 0x64, 0xa4, 0xc6, 0x9a, 0x4b, 0xa9, 0x3b, 0x21, 0x72, 0xba, 0xb2, 0xe3, 0x33, 0xd9, 0x0a,
+	0x92, 0x4c, 0xda, 0x38, 0x83, 0x9a, 0xe1, 0xb9, 0x81, 0xc5, 0xe3, 0x88, 0xf4, 0x08, 0x73, 0x22,
+	0x2f, 0xe4, 0x34, 0x62, 0x68, 0x08, 0xc0, 0xf2, 0x3a, 0xab, 0x0b, 0xad, 0xca, 0xe6, 0xea, 0x0e,
+	0xc6, 0x7f, 0x4c, 0x84, 0x6f, 0x81, 0xe8, 0x73, 0x84, 0x8d, 0x1f, 0x22, 0xdc, 0xbd, 0xc5, 0x83,
+	0x76, 0x01, 0xc2, 0xd8, 0x9e, 0x78, 0x8e, 0x79, 0x4e, 0x92, 0xba, 0xd0, 0x12, 0x36, 0x57, 0x77,
+	0x6a, 0x38, 0xcb, 0x8b, 0xf3, 0xbc, 0x58, 0x09, 0x12, 0x7d, 0x25, 0xf3, 0x1d, 0x90, 0x04, 0x69,
+	0x20, 0x8e, 0x2d, 0x6e, 0xd5, 0xcb, 0xa9, 0x7d, 0xf7, 0xdf, 0x62, 0xe1, 0x9e, 0xc5, 0x2d, 0x3d,
+	0x05, 0xa0, 0x06, 0x54, 0x19, 0x79, 0x1b, 0x93, 0xc0, 0x21, 0xf5, 0x4a, 0x4b, 0xd8, 0x14, 0xf5,
+	0xd9, 0xba, 0xf1, 0xb9, 0x02, 0xe2, 0x8d, 0x15, 0x8d, 0x60, 0x99, 0x79, 0x81, 0x3b, 0x21, 0xd3,
+	0x78, 0xcf, 0x16, 0xe8, 0x87, 0x8d, 0x94, 0xb0, 0x5f, 0xd2, 0xa7, 0x2c, 0xf4, 0x12, 0x96, 0xd2,
+	0x29, 0x4d, 0x0f, 0xb1, 0xb7, 0x08, 0x74, 0x70, 0x03, 0xd8, 0x2f, 0xe9, 0x19, 0xa9, 0x61, 0xc2,
+	0x72, 0xd6, 0x06, 0x3d, 0x05, 0xd1, 0xa7, 0xe3, 0x2c, 0xf0, 0xff, 0x3b, 0x8f, 0xff, 0xc2, 0x1e,
+	0xd0, 0x31, 0xd1, 0xd3, 0x0d, 0xe8, 0x21, 0xac, 0xcc, 0x86, 0x96, 0x26, 0xfb, 0x4f, 0x2f, 0x0a,
+	0x8d, 0x8f, 0x02, 0x2c, 0xa5, 0x3d, 0xd1, 0x01, 0x54, 0x6d, 0x8f, 0x5b, 0x51, 0x64, 0xe5, 0x43,
+	0x93, 0xf3, 0x26, 0xd9, 0x9d, 0xc4, 0xb3, 0x2b, 0x98, 0x77, 0xea, 0x52, 0x3f, 0xb4, 0x1c, 0xde,
+	0xf1, 0xb8, 0x72, 0xb3, 0x4d, 0x9f, 0x01, 0x90, 0xf1, 0xcb, 0x5d, 0x2b, 0xb7, 0x2a, 0x8b, 0x0e,
+	0x75, 0x0e, 0xd3, 0x59, 0x82, 0x0a, 0x8b, 0xfd, 0xad, 0x2f, 0x02, 0x54, 0xf3, 0x33, 0xa2, 0x35,
+	0xb8, 0x67, 0xf4, 0xb5, 0xa1, 0x39, 0x38, 0xea, 0xa9, 0xe6, 0xc9, 0xd0, 0x38, 0x56, 0xbb, 0xfd,
+	0xe7, 0x7d, 0xb5, 0x27, 0x95, 0x50, 0x0d, 0xa4, 0x42, 0xea, 0xf5, 0x75, 0xb5, 0x3b, 0x92, 0x04,
+	0x54, 0x87, 0xda, 0xef, 0x55, 0x53, 0x39, 0x39, 0x95, 0x2a, 0x68, 0x1d, 0x1e, 0x14, 0x4a, 0xe7,
+	0xd0, 0x30, 0x15, 0x4d, 0xd3, 0x55, 0x4d, 0x19, 0xa9, 0x92, 0x88, 0x1e, 0xc1, 0x7a, 0x21, 0x1e,
+	0xaa, 0x9a, 0xd2, 0x7d, 0x65, 0x2a, 0x83, 0xfe, 0xf0, 0xc8, 0x7c, 0x61, 0x1c, 0x0d, 0xa5, 0xf7,
+	0xe8, 0x3e, 0xdc, 0x29, 0x0c, 0x6a, 0xff, 0xd8, 0x6c, 0xef, 0xb5, 0xa5, 0x4f, 0xc2, 0x86, 0x58,
+	0x2d, 0x4b, 0xe5, 0xad, 0x39, 0x6d, 0xa4, 0x9e, 0x8e, 0x4e, 0x94, 0xc3, 0x8e, 0xf6, 0xf5, 0xaa,
+	0x29, 0x5c, 0x5e, 0x35, 0x85, 0xef, 0x57, 0x4d, 0xe1, 0xc3, 0x75, 0xb3, 0x74, 0x79, 0xdd, 0x2c,
+	0x7d, 0xbb, 0x6e, 0x96, 0x5e, 0x6f, 0xbb, 0x1e, 0x7f, 0x13, 0xdb, 0xd8, 0xa1, 0xbe, 0x9c, 0xbf,
+	0x0a, 0xe9, 0x67, 0x9b, 0x8d, 0xcf, 0x65, 0x9e, 0x84, 0x64, 0xfe, 0xa9, 0xb1, 0x97, 0xd3, 0x7f,
+	0x6a, 0xf7, 0xe7, 0x00, 0xe2, 0xfa, 0x60, 0xad, 0x86, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,57 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultAccessListPrefixGas is the gas charged for each prefix declared in an access list.
+const DefaultAccessListPrefixGas uint64 = 100
+
+// AccessListDecorator validates the access list declared by the transaction, see
+// `types.ExtensionOptionAccessList`, and charges gas for each declared prefix. The
+// declaration is enforced after the execution of the messages by the access list
+// post decorator.
+type AccessListDecorator struct {
+	prefixGas uint64
+}
+
+func NewAccessListDecorator(prefixGas uint64) AccessListDecorator {
+	return AccessListDecorator{prefixGas: prefixGas}
+}
+
+var _ sdk.AnteDecorator = AccessListDecorator{}
+
+func (ald AccessListDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	accessList, err := types.AccessListFromTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if accessList == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := accessList.Validate(); err != nil {
+		return ctx, err
+	}
+
+	ctx.GasMeter().ConsumeGas(ald.prefixGas*uint64(accessList.NumPrefixes()), "access list")
+
+	return next(ctx, tx, simulate)
+}
+
+// AcceptAccessListExtensionOption wraps checker to also accept the access list extension
+// option, so it can be declared in the critical extension options.
+func AcceptAccessListExtensionOption(checker ExtensionOptionChecker) ExtensionOptionChecker {
+	if checker == nil {
+		checker = rejectExtensionOption
+	}
+
+	return func(opt *codectypes.Any) bool {
+		if _, ok := opt.GetCachedValue().(*types.ExtensionOptionAccessList); ok {
+			return true
+		}
+		return checker(opt)
+	}
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestAccessListDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	antehandler := sdk.ChainAnteDecorators(ante.NewAccessListDecorator(10))
+
+	testCases := []struct {
+		msg        string
+		accessList *types.ExtensionOptionAccessList
+		expGas     uint64
+		expErr     string
+	}{
+		{"no access list", nil, 0, ""},
+		{
+			"charged per prefix",
+			&types.ExtensionOptionAccessList{AccessList: []types.AccessTuple{
+				{StoreKey: "bank", Prefixes: [][]byte{{0x02, 0x01}, {0x02, 0x02}}},
+				{StoreKey: "acc", Prefixes: [][]byte{{0x01}}},
+			}},
+			30, "",
+		},
+		{
+			"empty store key",
+			&types.ExtensionOptionAccessList{AccessList: []types.AccessTuple{{Prefixes: [][]byte{{0x01}}}}},
+			0, "access list store key cannot be empty",
+		},
+		{
+			"empty prefix",
+			&types.ExtensionOptionAccessList{AccessList: []types.AccessTuple{{StoreKey: "bank", Prefixes: [][]byte{{}}}}},
+			0, "empty access list prefix for store: bank",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			if tc.accessList != nil {
+				any, err := codectypes.NewAnyWithValue(tc.accessList)
+				require.NoError(t, err)
+				txBuilder.(tx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(any)
+			}
+
+			ctx := suite.ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+			_, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestAcceptAccessListExtensionOption(t *testing.T) {
+	checker := ante.AcceptAccessListExtensionOption(nil)
+
+	accessList, err := codectypes.NewAnyWithValue(&types.ExtensionOptionAccessList{})
+	require.NoError(t, err)
+	require.True(t, checker(accessList))
+
+	other, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	require.False(t, checker(other))
+}
@@ -24,6 +24,8 @@ type HandlerOptions struct {
 	// This allows for modification of signature verification behavior, such as how long an unordered transaction can
 	// be valid, or how much gas to charge for unordered transactions.
 	SigVerifyOptions []SigVerificationDecoratorOption
+	// DeferFees sends the fees to the virtual account of the fee collector, see DeductFeeDecorator.WithDeferredFees.
+	DeferFees bool
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -42,6 +44,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	deductFeeDecorator := NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+	if options.DeferFees {
+		if _, ok := options.BankKeeper.(VirtualBankKeeper); !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper must support virtual accounts to defer fees")
+		}
+		deductFeeDecorator = deductFeeDecorator.WithDeferredFees()
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -49,7 +59,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewAccessListDecorator(DefaultAccessListPrefixGas),
+		deductFeeDecorator,
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
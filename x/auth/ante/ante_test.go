@@ -1249,6 +1249,9 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey(),
 	})
+	weightedMultiKey := kmultisig.NewWeightedThresholdPubKey(3, []cryptotypes.PubKey{
+		singleLevelMultiKey, secp256k1.GenPrivKey().PubKey(),
+	}, []uint32{2, 1})
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -1260,6 +1263,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"weighted multikey", args{weightedMultiKey}, 6},
 		{"nil key", args{nil}, 0},
 	}
 	for _, tc := range testCases {
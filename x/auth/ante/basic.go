@@ -117,7 +117,16 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			return sdk.Context{}, err
 		}
 
+		blsAggregated := false
 		for i, signer := range signers {
+			// the signatures of a BLS aggregate are carried by its first signer only
+			if i < n && isBLSAggregate(sigs[i].Data) {
+				if blsAggregated {
+					continue
+				}
+				blsAggregated = true
+			}
+
 			// if signature is already filled in, no need to simulate gas cost
 			if i < n && !isIncompleteSignature(sigs[i].Data) {
 				continue
@@ -134,6 +143,14 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 				pubkey = acc.GetPubKey()
 			}
 
+			// the weighted multisig pubkey has no amino encoding, we estimate from its
+			// protobuf encoding and a signature of each of its members.
+			if weighted, ok := pubkey.(*multisig.WeightedThresholdPubKey); ok {
+				cost := storetypes.Gas(len(weighted.Bytes()) + len(weighted.PubKeys)*(len(simSecp256k1Sig)+6))
+				ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
+				continue
+			}
+
 			// use stdsignature to mock the size of a full signature
 			simSig := legacytx.StdSignature{ //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
 				Signature: simSecp256k1Sig[:],
@@ -0,0 +1,82 @@
+package ante
+
+import (
+	"fmt"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// blsAggregateSigners collects the signers of a tx signing with SIGN_MODE_BLS_AGGREGATE.
+// Their signatures are aggregated into the signature of the first of them, the signatures
+// of the others are empty.
+type blsAggregateSigners struct {
+	pubKeys   []*bls12_381.PubKey
+	signature []byte
+}
+
+// isBLSAggregate returns whether the signature data is a SIGN_MODE_BLS_AGGREGATE signature.
+func isBLSAggregate(data signing.SignatureData) bool {
+	single, ok := data.(*signing.SingleSignatureData)
+	return ok && single.SignMode == signing.SignMode_SIGN_MODE_BLS_AGGREGATE
+}
+
+// add adds a signer to the aggregate.
+func (s *blsAggregateSigners) add(pubKey cryptotypes.PubKey, data signing.SignatureData, simulate bool) error {
+	blsPubKey, ok := pubKey.(*bls12_381.PubKey)
+	if !ok && !simulate {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "%s requires a bls12_381 pubkey, got %T",
+			signing.SignMode_SIGN_MODE_BLS_AGGREGATE, pubKey)
+	}
+
+	signature := data.(*signing.SingleSignatureData).Signature
+	if len(s.pubKeys) == 0 {
+		s.signature = signature
+	} else if len(signature) != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"only the first %s signer carries the aggregated signature", signing.SignMode_SIGN_MODE_BLS_AGGREGATE)
+	}
+	s.pubKeys = append(s.pubKeys, blsPubKey)
+	return nil
+}
+
+// verifyBLSAggregate verifies the aggregated signature of the SIGN_MODE_BLS_AGGREGATE signers
+// of the tx, once for all of them.
+func (svd SigVerificationDecorator) verifyBLSAggregate(ctx sdk.Context, tx sdk.Tx, signers blsAggregateSigners, simulate bool) error {
+	if len(signers.pubKeys) == 0 {
+		return nil
+	}
+
+	ctx.GasMeter().ConsumeGas(svd.ak.GetParams(ctx).SigVerifyCostBLS12381(), "ante verify: bls12_381 aggregate")
+
+	// no need to verify signatures on recheck tx
+	if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return nil
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	// the sign bytes don't depend on the signer, only on the chain-id
+	signBytes, err := svd.signModeHandler.GetSignBytes(ctx, signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE,
+		txsigning.SignerData{ChainID: ctx.ChainID()}, adaptableTx.GetSigningTxData())
+	if err != nil {
+		return err
+	}
+
+	if !bls12_381.VerifyAggregateSignature(signers.pubKeys, signBytes, signers.signature) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"aggregated signature verification failed; please verify the sequences of the %d signers and chain-id (%s)",
+			len(signers.pubKeys), ctx.ChainID())
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestBLSAggregate(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	params := types.DefaultParams()
+
+	const numSigners = 5
+	privs := make([]cryptotypes.PrivKey, numSigners)
+	accNums := make([]uint64, numSigners)
+	for i := range privs {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privs[i] = &priv
+
+		// the pubkeys are set by previous individually signed txs
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(priv.PubKey().Address()))
+		require.NoError(t, acc.SetAccountNumber(uint64(i+1000)))
+		require.NoError(t, acc.SetPubKey(priv.PubKey()))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	aggregate := func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+		aggSigs, err := tx.AggregateBLSSignatures(sigs)
+		require.NoError(t, err)
+		return aggSigs
+	}
+
+	testCases := []struct {
+		name     string
+		accSeqs  []uint64
+		malleate func([]signing.SignatureV2) []signing.SignatureV2
+		expErr   string
+	}{
+		{
+			name:     "aggregated signature",
+			accSeqs:  []uint64{0, 0, 0, 0, 0},
+			malleate: aggregate,
+		},
+		{
+			name:    "signed over another sequence",
+			accSeqs: []uint64{0, 0, 0, 0, 1},
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				// the last signer signed over sequence 1, the tx carries the expected sequence 0
+				aggSigs := aggregate(sigs)
+				aggSigs[4].Sequence = 0
+				return aggSigs
+			},
+			expErr: "aggregated signature verification failed",
+		},
+		{
+			name:    "missing signature",
+			accSeqs: []uint64{0, 0, 0, 0, 0},
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				// the first signer carries the aggregated signature of the others only
+				aggSigs := append([]signing.SignatureV2{sigs[0]}, aggregate(sigs[1:])...)
+				aggSigs[0].Data = aggSigs[1].Data
+				aggSigs[1].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_BLS_AGGREGATE}
+				return aggSigs
+			},
+			expErr: "aggregated signature verification failed",
+		},
+		{
+			name:    "individual signatures",
+			accSeqs: []uint64{0, 0, 0, 0, 0},
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				return sigs
+			},
+			expErr: "only the first SIGN_MODE_BLS_AGGREGATE signer carries the aggregated signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkTx := suite.createBLSAggregateTx(t, privs, accNums, tc.accSeqs)
+			sigs, err := sdkTx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.NoError(t, suite.txBuilder.SetSignatures(tc.malleate(sigs)...))
+
+			ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, err = antehandler(ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the aggregated signature is verified once, each signer adds the cost of its pubkey
+			sigGas := params.SigVerifyCostBLS12381() + numSigners*params.SigVerifyCostBLS12381AggregateSigner()
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), sigGas)
+			require.Less(t, ctx.GasMeter().GasConsumed(), numSigners*params.SigVerifyCostBLS12381())
+		})
+	}
+}
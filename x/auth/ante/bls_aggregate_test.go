@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// enableBLSAggregate enables SIGN_MODE_BLS_AGGREGATE, which is only enabled by
+// default with the bls12381 build tag.
+func (suite *AnteTestSuite) enableBLSAggregate(t *testing.T) {
+	t.Helper()
+
+	var err error
+	suite.clientCtx.TxConfig, err = authtx.NewTxConfigWithOptions(
+		codec.NewProtoCodec(suite.encCfg.InterfaceRegistry),
+		authtx.ConfigOptions{
+			EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_BLS_AGGREGATE},
+		},
+	)
+	require.NoError(t, err)
+}
+
+func (suite *AnteTestSuite) createBLSAggregateTx(t *testing.T, privs []cryptotypes.PrivKey, accNums, accSeqs []uint64) xauthsigning.Tx {
+	t.Helper()
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	msgs := make([]sdk.Msg, len(privs))
+	for i, priv := range privs {
+		msgs[i] = testdata.NewTestMsg(sdk.AccAddress(priv.PubKey().Address()))
+	}
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	sdkTx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_BLS_AGGREGATE)
+	require.NoError(t, err)
+	return sdkTx
+}
+
+func TestBLSAggregate_PubKeyNotSet(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.enableBLSAggregate(t)
+	accs := suite.CreateTestAccounts(2)
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	privs := []cryptotypes.PrivKey{accs[0].priv, accs[1].priv}
+	accNums := []uint64{accs[0].acc.GetAccountNumber(), accs[1].acc.GetAccountNumber()}
+	sdkTx := suite.createBLSAggregateTx(t, privs, accNums, []uint64{0, 0})
+
+	// the aggregate doesn't prove the possession of the keys, which must be set beforehand
+	_, err := antehandler(suite.ctx, sdkTx, false)
+	require.ErrorContains(t, err, "must be set before signing with SIGN_MODE_BLS_AGGREGATE")
+}
+
+func TestBLSAggregate_RequiresBLSPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.enableBLSAggregate(t)
+	accs := suite.CreateTestAccounts(2)
+	for _, acc := range accs {
+		require.NoError(t, acc.acc.SetPubKey(acc.priv.PubKey()))
+		suite.accountKeeper.SetAccount(suite.ctx, acc.acc)
+	}
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	privs := []cryptotypes.PrivKey{accs[0].priv, accs[1].priv}
+	accNums := []uint64{accs[0].acc.GetAccountNumber(), accs[1].acc.GetAccountNumber()}
+	sdkTx := suite.createBLSAggregateTx(t, privs, accNums, []uint64{0, 0})
+
+	_, err := antehandler(suite.ctx, sdkTx, false)
+	require.ErrorContains(t, err, "SIGN_MODE_BLS_AGGREGATE requires a bls12_381 pubkey")
+}
@@ -21,9 +21,17 @@ type AccountKeeper interface {
 	UnorderedTransactionsEnabled() bool
 	RemoveExpiredUnorderedNonces(ctx sdk.Context) error
 	TryAddUnorderedNonce(ctx sdk.Context, sender []byte, timestamp time.Time) error
+	GetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint64) (uint64, error)
+	SetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, sequence uint64) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// VirtualBankKeeper defines the expected bank keeper of the deferred fee deduction, the coins
+// sent to a virtual module account are credited at the end of block.
+type VirtualBankKeeper interface {
+	SendCoinsFromAccountToModuleVirtual(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
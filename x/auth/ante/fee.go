@@ -31,6 +31,7 @@ type DeductFeeDecorator struct {
 	feegrantKeeper     FeegrantKeeper
 	txFeeChecker       TxFeeChecker
 	feeRecipientModule string
+	deferFees          bool
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
@@ -61,6 +62,19 @@ func (dfd DeductFeeDecorator) WithFeeRecipientModule(moduleName string) DeductFe
 	return dfd
 }
 
+// WithDeferredFees sends the deducted fees to the virtual account of the fee recipient module,
+// the fees are credited at the end of block instead of writing the recipient balance in every
+// transaction, which serializes parallel execution. The bank keeper must implement
+// VirtualBankKeeper and have its object store key set, and the fees are not spendable by
+// the recipient until the end of block.
+func (dfd DeductFeeDecorator) WithDeferredFees() DeductFeeDecorator {
+	if _, ok := dfd.bankKeeper.(VirtualBankKeeper); !ok {
+		panic("bank keeper does not support virtual accounts")
+	}
+	dfd.deferFees = true
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -130,8 +144,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	// deduct the fees
 	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
-		if err != nil {
+		deductFees := DeductFees
+		if dfd.deferFees {
+			deductFees = DeductFeesVirtual
+		}
+		if err := deductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee); err != nil {
 			return err
 		}
 	}
@@ -162,3 +179,23 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc sdk.AccountI,
 
 	return nil
 }
+
+// DeductFeesVirtual is like DeductFees, but sends the fees to the virtual account of the
+// module configured via FeeRecipientModule, bankKeeper must implement VirtualBankKeeper.
+func DeductFeesVirtual(bankKeeper types.BankKeeper, ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins) error {
+	if !fees.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+
+	virtualKeeper, ok := bankKeeper.(VirtualBankKeeper)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper does not support virtual accounts")
+	}
+
+	err := virtualKeeper.SendCoinsFromAccountToModuleVirtual(ctx, acc.GetAddress(), FeeRecipientModule, fees)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s", err.Error())
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -196,3 +197,48 @@ func TestDeductFees_WithFeeRecipientModule(t *testing.T) {
 		})
 	}
 }
+
+// virtualBankKeeper records the fees sent to virtual module accounts.
+type virtualBankKeeper struct {
+	authtypes.BankKeeper
+	recipients []string
+	sent       sdk.Coins
+}
+
+func (k *virtualBankKeeper) SendCoinsFromAccountToModuleVirtual(_ context.Context, _ sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	k.recipients = append(k.recipients, recipientModule)
+	k.sent = k.sent.Add(amt...)
+	return nil
+}
+
+func TestDeductFees_WithDeferredFees(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// a bank keeper without virtual accounts can't defer fees
+	require.Panics(t, func() {
+		ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil).WithDeferredFees()
+	})
+
+	// the mock would fail the test if the fees were sent to the real module account
+	bk := &virtualBankKeeper{BankKeeper: s.bankKeeper}
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, bk, nil, nil).WithDeferredFees()
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{authtypes.FeeCollectorName}, bk.recipients)
+	require.Equal(t, feeAmount, bk.sent)
+}
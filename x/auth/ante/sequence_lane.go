@@ -0,0 +1,59 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AcceptSequenceLaneExtensionOption wraps checker to also accept the sequence lane extension
+// option, so it can be selected in the critical extension options.
+func AcceptSequenceLaneExtensionOption(checker ExtensionOptionChecker) ExtensionOptionChecker {
+	if checker == nil {
+		checker = rejectExtensionOption
+	}
+
+	return func(opt *codectypes.Any) bool {
+		if _, ok := opt.GetCachedValue().(*types.ExtensionOptionSequenceLane); ok {
+			return true
+		}
+		return checker(opt)
+	}
+}
+
+var _ mempool.SignerExtractionAdapter = SequenceLaneSignerExtractionAdapter{}
+
+// SequenceLaneSignerExtractionAdapter wraps a mempool.SignerExtractionAdapter to set the sequence
+// lane selected by the transaction on its signers, so the mempool and the proposal handler order
+// the transactions of each lane independently.
+type SequenceLaneSignerExtractionAdapter struct {
+	adapter mempool.SignerExtractionAdapter
+}
+
+// NewSequenceLaneSignerExtractionAdapter returns a SequenceLaneSignerExtractionAdapter wrapping
+// adapter, or the default adapter if nil.
+func NewSequenceLaneSignerExtractionAdapter(adapter mempool.SignerExtractionAdapter) SequenceLaneSignerExtractionAdapter {
+	if adapter == nil {
+		adapter = mempool.NewDefaultSignerExtractionAdapter()
+	}
+	return SequenceLaneSignerExtractionAdapter{adapter: adapter}
+}
+
+// GetSigners implements the mempool.SignerExtractionAdapter interface.
+func (a SequenceLaneSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]mempool.SignerData, error) {
+	signers, err := a.adapter.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	lane, err := types.SequenceLaneFromTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range signers {
+		signers[i].Lane = lane
+	}
+
+	return signers, nil
+}
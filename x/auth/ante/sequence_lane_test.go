@@ -0,0 +1,130 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *AnteTestSuite) createSequenceLaneTx(t *testing.T, priv cryptotypes.PrivKey, accNum, lane, sequence uint64) xauthsigning.Tx {
+	t.Helper()
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(priv.PubKey().Address()))))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	if lane > 0 {
+		opt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionSequenceLane{Lane: lane})
+		require.NoError(t, err)
+		suite.txBuilder.(tx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+	}
+
+	sdkTx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{sequence}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	return sdkTx
+}
+
+func TestSequenceLanes(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	priv, addr, accNum := accs[0].priv, accs[0].acc.GetAddress(), accs[0].acc.GetAccountNumber()
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+
+	testCases := []struct {
+		msg      string
+		lane     uint64
+		sequence uint64
+		expErr   string
+	}{
+		{"account sequence", 0, 0, ""},
+		{"first tx of lane 1", 1, 0, ""},
+		{"first tx of lane 2", 2, 0, ""},
+		{"second tx of lane 1", 1, 1, ""},
+		{"replayed tx of lane 1", 1, 1, "account sequence mismatch on lane 1, expected 2, got 1"},
+		{"sequence of another lane", 2, 2, "account sequence mismatch on lane 2, expected 1, got 2"},
+		{"second tx of account sequence", 0, 1, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := antehandler(suite.ctx, suite.createSequenceLaneTx(t, priv, accNum, tc.lane, tc.sequence), false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	sequence, err := suite.accountKeeper.GetSequence(suite.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sequence)
+	for lane, expected := range []uint64{2, 2, 1} {
+		sequence, err := suite.accountKeeper.GetLaneSequence(suite.ctx, addr, uint64(lane))
+		require.NoError(t, err)
+		require.Equal(t, expected, sequence)
+	}
+}
+
+func TestSequenceLanes_Unordered(t *testing.T) {
+	suite := SetupTestSuiteWithUnordered(t, false, true)
+	accs := suite.CreateTestAccounts(1)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+
+	opt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionSequenceLane{Lane: 1})
+	require.NoError(t, err)
+	suite.txBuilder.(tx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+
+	unorderedTx, err := suite.CreateTestUnorderedTx(suite.ctx, []cryptotypes.PrivKey{accs[0].priv}, []uint64{accs[0].acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT, true, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	_, err = sdk.ChainAnteDecorators(svd)(suite.ctx, unorderedTx, false)
+	require.ErrorContains(t, err, "sequence lanes are not allowed for unordered transactions")
+}
+
+func TestAcceptSequenceLaneExtensionOption(t *testing.T) {
+	checker := ante.AcceptSequenceLaneExtensionOption(nil)
+
+	sequenceLane, err := codectypes.NewAnyWithValue(&types.ExtensionOptionSequenceLane{Lane: 1})
+	require.NoError(t, err)
+	require.True(t, checker(sequenceLane))
+
+	other, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	require.False(t, checker(other))
+}
+
+func TestSequenceLaneSignerExtractionAdapter(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	accs := suite.CreateTestAccounts(1)
+	adapter := ante.NewSequenceLaneSignerExtractionAdapter(nil)
+
+	signers, err := adapter.GetSigners(suite.createSequenceLaneTx(t, accs[0].priv, accs[0].acc.GetAccountNumber(), 0, 3))
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, uint64(0), signers[0].Lane)
+	require.Equal(t, accs[0].acc.GetAddress().String(), signers[0].Sender())
+
+	signers, err = adapter.GetSigners(suite.createSequenceLaneTx(t, accs[0].priv, accs[0].acc.GetAccountNumber(), 2, 3))
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, uint64(2), signers[0].Lane)
+	require.Equal(t, uint64(3), signers[0].Sequence)
+	require.Equal(t, accs[0].acc.GetAddress().String()+"/2", signers[0].Sender())
+}
@@ -12,9 +12,9 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -76,6 +76,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return sdk.Context{}, err
 	}
 
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
 	signerStrs := make([]string, len(signers))
 	for i, pk := range pubkeys {
 		var err error
@@ -91,20 +96,33 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
-			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
-		}
-
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set, no need to reset
-		if acc.GetPubKey() != nil {
+		// account already has pubkey set, no need to reset. As the pubkey may have been
+		// rotated, see types.MsgRotatePubKey, it's matched against the account pubkey
+		// instead of the signer address.
+		if accPubKey := acc.GetPubKey(); accPubKey != nil {
+			if !simulate && !accPubKey.Equals(pk) && ctx.IsSigverifyTx() {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubkey of signer %s with signer index: %d", signerStrs[i], i)
+			}
 			continue
 		}
+
+		// SIGN_MODE_BLS_AGGREGATE doesn't prove the possession of the private keys of the signers,
+		// so their pubkey must have been set by a previous transaction to prevent rogue key attacks.
+		if i < len(sigs) && isBLSAggregate(sigs[i].Data) && ctx.IsSigverifyTx() {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubkey of signer %s must be set before signing with %s", signerStrs[i], signing.SignMode_SIGN_MODE_BLS_AGGREGATE)
+		}
+
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -116,11 +134,6 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// indices:
 	// - signature (via `tx.signature='<sig_as_base64>'`),
 	// - concat(address,"/",sequence) (via `tx.acc_seq='cosmos1abc...def/42'`).
-	sigs, err := sigTx.GetSignaturesV2()
-	if err != nil {
-		return ctx, err
-	}
-
 	isUnordered := false
 	utx, ok := tx.(sdk.TxWithUnordered)
 	if ok && utx.GetUnordered() {
@@ -230,6 +243,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 // As of Cosmos SDK v0.53.0, the SigVerificationDecorator will also verify the validity of unordered transactions.
 // This involves ensuring the TTL is valid, and that the unordered nonce has not been used previously.
 //
+// The signers using SIGN_MODE_BLS_AGGREGATE share a single aggregated signature, carried by the first
+// of them, which is verified once for all of them.
+//
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
@@ -318,6 +334,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not enabled")
 	}
 
+	lane, err := types.SequenceLaneFromTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if isUnordered && lane > 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sequence lanes are not allowed for unordered transactions")
+	}
+
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
 	sigs, err := sigTx.GetSignaturesV2()
@@ -344,6 +368,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	var blsSigners blsAggregateSigners
 	for i, sig := range sigs {
 		if sig.Sequence > 0 && isUnordered {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence is not allowed for unordered transactions")
@@ -359,12 +384,25 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
+		// Check account sequence number, or the sequence of the selected sequence lane.
 		if !isUnordered {
-			if sig.Sequence != acc.GetSequence() {
+			sequence := acc.GetSequence()
+			if lane > 0 {
+				sequence, err = svd.ak.GetLaneSequence(ctx, acc.GetAddress(), lane)
+				if err != nil {
+					return ctx, err
+				}
+			}
+			if sig.Sequence != sequence {
+				if lane > 0 {
+					return ctx, errorsmod.Wrapf(
+						sdkerrors.ErrWrongSequence,
+						"account sequence mismatch on lane %d, expected %d, got %d", lane, sequence, sig.Sequence,
+					)
+				}
 				return ctx, errorsmod.Wrapf(
 					sdkerrors.ErrWrongSequence,
-					"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+					"account sequence mismatch, expected %d, got %d", sequence, sig.Sequence,
 				)
 			}
 		}
@@ -377,6 +415,15 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			accNum = acc.GetAccountNumber()
 		}
 
+		// SIGN_MODE_BLS_AGGREGATE signatures are aggregated, they are verified once for all the
+		// signers, see verifyBLSAggregate.
+		if isBLSAggregate(sig.Data) {
+			if err := blsSigners.add(pubKey, sig.Data, simulate); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
 			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
@@ -411,6 +458,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	if err := svd.verifyBLSAggregate(ctx, tx, blsSigners, simulate); err != nil {
+		return ctx, err
+	}
+
 	return next(ctx, tx, simulate)
 }
 
@@ -477,7 +528,8 @@ func extractSignersBytes(tx sdk.Tx) ([][]byte, error) {
 	return sigTx.GetSigners()
 }
 
-// IncrementSequenceDecorator handles incrementing sequences of all signers.
+// IncrementSequenceDecorator handles incrementing sequences of all signers, or the
+// sequences of the sequence lane selected by the tx, see types.ExtensionOptionSequenceLane.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
 // BaseApp.Commit() will set the check state based on the latest header.
@@ -514,7 +566,23 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return sdk.Context{}, err
 	}
 
+	lane, err := types.SequenceLaneFromTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	for _, signer := range signers {
+		if lane > 0 {
+			sequence, err := isd.ak.GetLaneSequence(ctx, signer, lane)
+			if err != nil {
+				return ctx, err
+			}
+			if err := isd.ak.SetLaneSequence(ctx, signer, lane, sequence+1); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		acc := isd.ak.GetAccount(ctx, signer)
 		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
 			panic(err)
@@ -588,6 +656,15 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostMlDsa65, "ante verify: ml_dsa_65")
 		return nil
 
+	case *bls12_381.PubKey:
+		// the aggregated signature is verified once, see SigVerificationDecorator
+		if isBLSAggregate(sig.Data) {
+			meter.ConsumeGas(params.SigVerifyCostBLS12381AggregateSigner(), "ante verify: bls12_381 aggregate signer")
+			return nil
+		}
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -604,20 +681,28 @@ func DefaultSigVerificationGasConsumer(
 	}
 }
 
-// ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
+// ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature.
+// Each signer consumes the gas of its own key type, so the members of a WeightedThresholdPubKey can mix key types.
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
 	params types.Params, accSeq uint64,
 ) error {
 	size := sig.BitArray.Count()
+	pubKeys := pubkey.GetPubKeys()
+	if size > len(pubKeys) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
 	sigIndex := 0
 
 	for i := range size {
 		if !sig.BitArray.GetIndex(i) {
 			continue
 		}
+		if sigIndex >= len(sig.Signatures) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature size is incorrect %d", len(sig.Signatures))
+		}
 		sigV2 := signing.SignatureV2{
-			PubKey:   pubkey.GetPubKeys()[i],
+			PubKey:   pubKeys[i],
 			Data:     sig.Signatures[sigIndex],
 			Sequence: accSeq,
 		}
@@ -648,7 +733,7 @@ func CountSubKeys(pub cryptotypes.PubKey) int {
 	if pub == nil {
 		return 0
 	}
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
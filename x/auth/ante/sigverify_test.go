@@ -9,6 +9,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -109,6 +110,8 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	skR1, _ := secp256r1.GenPrivKey()
 	skMlDsa65, err := mldsa65.GenPrivKey()
 	require.NoError(t, err)
+	blsPubKey := &bls12_381.PubKey{Key: make([]byte, 96)}
+	blsAggregateSig := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_BLS_AGGREGATE}
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
@@ -121,6 +124,15 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// the secp256r1 and ml-dsa-65 members sign, each consumes the gas of its key type
+	weightedKey := kmultisig.NewWeightedThresholdPubKey(2,
+		[]cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), skR1.PubKey(), skMlDsa65.PubKey()}, []uint32{1, 1, 2})
+	weightedSignature := multisig.NewMultisig(3)
+	multisig.AddSignature(weightedSignature, &signing.SingleSignatureData{}, 1)
+	multisig.AddSignature(weightedSignature, &signing.SingleSignatureData{}, 2)
+	oversizedSignature := multisig.NewMultisig(4)
+	multisig.AddSignature(oversizedSignature, &signing.SingleSignatureData{}, 3)
+
 	type args struct {
 		meter  storetypes.GasMeter
 		sig    signing.SignatureData
@@ -137,7 +149,11 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
 		{"PubKeyMlDsa65", args{storetypes.NewInfiniteGasMeter(), nil, skMlDsa65.PubKey(), params}, p.SigVerifyCostMlDsa65, false},
+		{"PubKeyBLS12381", args{storetypes.NewInfiniteGasMeter(), nil, blsPubKey, params}, p.SigVerifyCostBLS12381(), false},
+		{"PubKeyBLS12381 aggregate signer", args{storetypes.NewInfiniteGasMeter(), blsAggregateSig, blsPubKey, params}, p.SigVerifyCostBLS12381AggregateSigner(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"weighted multisig", args{storetypes.NewInfiniteGasMeter(), weightedSignature, weightedKey, params}, p.SigVerifyCostSecp256r1() + p.SigVerifyCostMlDsa65, false},
+		{"weighted multisig oversized bit array", args{storetypes.NewInfiniteGasMeter(), oversizedSignature, weightedKey, params}, 0, true},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -275,6 +291,52 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerification_RotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	oldPriv, addr, accNum := accs[0].priv, accs[0].acc.GetAddress(), accs[0].acc.GetAccountNumber()
+
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(oldPriv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	newPriv, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	require.NoError(t, suite.accountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey()))
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	testCases := []struct {
+		name   string
+		priv   cryptotypes.PrivKey
+		expErr string
+	}{
+		{"signed with the old pubkey", oldPriv, "pubKey does not match the pubkey of signer"},
+		{"signed with the rotated pubkey", &newPriv, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{tc.priv}, []uint64{accNum}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
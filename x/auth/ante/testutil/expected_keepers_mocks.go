@@ -72,6 +72,21 @@ func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
 }
 
+// GetLaneSequence mocks base method.
+func (m *MockAccountKeeper) GetLaneSequence(ctx context.Context, addr types.AccAddress, lane uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLaneSequence", ctx, addr, lane)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLaneSequence indicates an expected call of GetLaneSequence.
+func (mr *MockAccountKeeperMockRecorder) GetLaneSequence(ctx, addr, lane any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaneSequence", reflect.TypeOf((*MockAccountKeeper)(nil).GetLaneSequence), ctx, addr, lane)
+}
+
 // GetModuleAddress mocks base method.
 func (m *MockAccountKeeper) GetModuleAddress(moduleName string) types.AccAddress {
 	m.ctrl.T.Helper()
@@ -126,6 +141,20 @@ func (mr *MockAccountKeeperMockRecorder) SetAccount(ctx, acc any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).SetAccount), ctx, acc)
 }
 
+// SetLaneSequence mocks base method.
+func (m *MockAccountKeeper) SetLaneSequence(ctx context.Context, addr types.AccAddress, lane, sequence uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLaneSequence", ctx, addr, lane, sequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLaneSequence indicates an expected call of SetLaneSequence.
+func (mr *MockAccountKeeperMockRecorder) SetLaneSequence(ctx, addr, lane, sequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLaneSequence", reflect.TypeOf((*MockAccountKeeper)(nil).SetLaneSequence), ctx, addr, lane, sequence)
+}
+
 // TryAddUnorderedNonce mocks base method.
 func (m *MockAccountKeeper) TryAddUnorderedNonce(ctx types.Context, sender []byte, timestamp time.Time) error {
 	m.ctrl.T.Helper()
@@ -191,3 +220,41 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
+
+// MockVirtualBankKeeper is a mock of VirtualBankKeeper interface.
+type MockVirtualBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualBankKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualBankKeeperMockRecorder is the mock recorder for MockVirtualBankKeeper.
+type MockVirtualBankKeeperMockRecorder struct {
+	mock *MockVirtualBankKeeper
+}
+
+// NewMockVirtualBankKeeper creates a new mock instance.
+func NewMockVirtualBankKeeper(ctrl *gomock.Controller) *MockVirtualBankKeeper {
+	mock := &MockVirtualBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockVirtualBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualBankKeeper) EXPECT() *MockVirtualBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendCoinsFromAccountToModuleVirtual mocks base method.
+func (m *MockVirtualBankKeeper) SendCoinsFromAccountToModuleVirtual(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModuleVirtual", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModuleVirtual indicates an expected call of SendCoinsFromAccountToModuleVirtual.
+func (mr *MockVirtualBankKeeperMockRecorder) SendCoinsFromAccountToModuleVirtual(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModuleVirtual", reflect.TypeOf((*MockVirtualBankKeeper)(nil).SendCoinsFromAccountToModuleVirtual), ctx, senderAddr, recipientModule, amt)
+}
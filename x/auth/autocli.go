@@ -34,6 +34,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query account info which is common to all account types.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "SequenceLane",
+					Use:            "sequence-lane [address] [lane]",
+					Short:          "Query the sequence of a sequence lane of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "lane"}},
+				},
+				{
+					RpcMethod:      "PubKeyHistory",
+					Use:            "pubkey-history [address]",
+					Short:          "Query the public key rotations of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				{
 					RpcMethod:      "AccountAddressByID",
 					Use:            "address-by-acc-num [acc-num]",
@@ -87,6 +99,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pubkey [new-pubkey]",
+					Short:          "Replace the public key of the --from account, keeping its address. The account must sign with its current key.",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pubkey '{"@type":"/cosmos.crypto.mldsa65.PubKey","key":"..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}},
+				},
 			},
 		},
 	}
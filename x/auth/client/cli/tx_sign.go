@@ -9,8 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
@@ -283,16 +283,19 @@ func multisigSign(clientCtx client.Context, txBuilder client.TxBuilder, txFactor
 // isMultisigSigner checks if the given pubkey is a signer in the multisig or in
 // any of the nested multisig signers.
 func isMultisigSigner(clientCtx client.Context, multisigPubKey, fromPubKey cryptotypes.PubKey) (bool, error) {
-	multisigLegacyPub := multisigPubKey.(*kmultisig.LegacyAminoPubKey)
+	multisigPub, ok := multisigPubKey.(multisigtypes.PubKey)
+	if !ok {
+		return false, fmt.Errorf("expected a multisig pubkey, got %T", multisigPubKey)
+	}
 
 	var found bool
-	for _, pubkey := range multisigLegacyPub.GetPubKeys() {
+	for _, pubkey := range multisigPub.GetPubKeys() {
 		if pubkey.Equals(fromPubKey) {
 			found = true
 			break
 		}
 
-		if nestedMultisig, ok := pubkey.(*kmultisig.LegacyAminoPubKey); ok {
+		if nestedMultisig, ok := pubkey.(multisigtypes.PubKey); ok {
 			var err error
 			found, err = isMultisigSigner(clientCtx, nestedMultisig, fromPubKey)
 			if err != nil {
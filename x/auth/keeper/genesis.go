@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -25,6 +27,26 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, sl := range data.SequenceLanes {
+		addr, err := ak.addressCodec.StringToBytes(sl.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.SequenceLanes.Set(ctx, collections.Join(sdk.AccAddress(addr), sl.Lane), sl.Sequence); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rotation := range data.PubKeyRotations {
+		addr, err := ak.addressCodec.StringToBytes(rotation.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.addPubKeyRotation(ctx, addr, rotation); err != nil {
+			panic(err)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -39,5 +61,30 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	err := ak.SequenceLanes.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], sequence uint64) (bool, error) {
+		addr, err := ak.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genState.SequenceLanes = append(genState.SequenceLanes, types.SequenceLane{
+			Address:  addr,
+			Lane:     key.K2(),
+			Sequence: sequence,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = ak.PubKeyRotations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], rotation types.PubKeyRotation) (bool, error) {
+		genState.PubKeyRotations = append(genState.PubKeyRotations, rotation)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
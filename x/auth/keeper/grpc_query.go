@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -245,3 +247,61 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// SequenceLane implements the Query/SequenceLane gRPC method
+func (s queryServer) SequenceLane(ctx context.Context, req *types.QuerySequenceLaneRequest) (*types.QuerySequenceLaneResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.k.HasAccount(ctx, addr) {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	sequence, err := s.k.GetLaneSequence(ctx, addr, req.Lane)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &types.QuerySequenceLaneResponse{Sequence: sequence}, nil
+}
+
+// PubKeyHistory implements the Query/PubKeyHistory gRPC method
+func (s queryServer) PubKeyHistory(ctx context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rotations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyRotations,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], rotation types.PubKeyRotation) (types.PubKeyRotation, error) {
+			return rotation, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
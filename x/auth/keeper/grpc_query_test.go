@@ -535,3 +535,28 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQuerySequenceLane() {
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetSequence(10))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(suite.accountKeeper.SetLaneSequence(suite.ctx, addr, 1, 4))
+
+	for lane, expected := range []uint64{10, 4, 0} {
+		res, err := suite.queryClient.SequenceLane(context.Background(), &types.QuerySequenceLaneRequest{
+			Address: addr.String(),
+			Lane:    uint64(lane),
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, res.Sequence)
+	}
+
+	_, err := suite.queryClient.SequenceLane(context.Background(), &types.QuerySequenceLaneRequest{})
+	suite.Require().ErrorContains(err, "address cannot be empty")
+
+	_, err = suite.queryClient.SequenceLane(context.Background(), &types.QuerySequenceLaneRequest{
+		Address: sdk.AccAddress("unknown").String(),
+		Lane:    1,
+	})
+	suite.Require().ErrorContains(err, "not found")
+}
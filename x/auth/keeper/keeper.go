@@ -14,6 +14,8 @@ import (
 	"cosmossdk.io/log/v2"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -108,6 +110,11 @@ type AccountKeeper struct {
 	AccountNumber   collections.Sequence
 	Accounts        *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
 	UnorderedNonces collections.KeySet[collections.Pair[int64, []byte]]
+	// SequenceLanes stores the sequences of the sequence lanes of the accounts, except the lane 0
+	// which is the sequence of the account.
+	SequenceLanes collections.Map[collections.Pair[sdk.AccAddress, uint64], uint64]
+	// PubKeyRotations stores the public key rotations of the accounts by rotation number.
+	PubKeyRotations collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyRotation]
 }
 
 type InitOption func(*AccountKeeper)
@@ -152,6 +159,8 @@ func NewAccountKeeper(
 		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"), //nolint:staticcheck // kept in place for the migration
 		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
 		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		SequenceLanes:   collections.NewMap(sb, types.SequenceLanesKey, "sequence_lanes", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), collections.Uint64Value),
+		PubKeyRotations: collections.NewMap(sb, types.PubKeyRotationsKey, "pub_key_rotations", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyRotation](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -345,3 +354,125 @@ func (ak AccountKeeper) RemoveExpiredUnorderedNonces(ctx sdk.Context) error {
 
 	return nil
 }
+
+// -------------------------------------
+// Sequence lane management methods
+// -------------------------------------
+
+// GetLaneSequence returns the sequence of the sequence lane of the account at address, the
+// lane 0 is the sequence of the account.
+func (ak AccountKeeper) GetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint64) (uint64, error) {
+	if lane == 0 {
+		return ak.GetSequence(ctx, addr)
+	}
+
+	sequence, err := ak.SequenceLanes.Get(ctx, collections.Join(addr, lane))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// SetLaneSequence sets the sequence of the sequence lane of the account at address, the lane 0
+// is the sequence of the account.
+func (ak AccountKeeper) SetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, sequence uint64) error {
+	if lane == 0 {
+		acc := ak.GetAccount(ctx, addr)
+		if acc == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+		}
+		if err := acc.SetSequence(sequence); err != nil {
+			return err
+		}
+		ak.SetAccount(ctx, acc)
+		return nil
+	}
+
+	return ak.SequenceLanes.Set(ctx, collections.Join(addr, lane), sequence)
+}
+
+// -------------------------------------
+// Public key rotation methods
+// -------------------------------------
+
+// RotatePubKey replaces the public key of the account at address by pubKey, the address of
+// the account is kept and the rotation is recorded in its public key history.
+//
+// Rotating to a BLS12-381 public key is rejected: the rotation doesn't prove the possession
+// of the new key, which SIGN_MODE_BLS_AGGREGATE relies upon against rogue key attacks.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot rotate the pubkey of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey of account %s is not set", addr)
+	}
+	if oldPubKey.Equals(pubKey) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "new pubkey is the current pubkey of account %s", addr)
+	}
+	if _, ok := pubKey.(*bls12_381.PubKey); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "cannot rotate to a bls12_381 pubkey")
+	}
+
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ak.addPubKeyRotation(ctx, addr, types.PubKeyRotation{
+		Address:   addrStr,
+		OldPubKey: oldPkAny,
+		NewPubKey: newPkAny,
+		Height:    sdkCtx.BlockHeight(),
+		Time:      sdkCtx.BlockTime(),
+	}); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRotatePubKey,
+		sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+		sdk.NewAttribute(types.AttributeKeyPubKeyType, newPkAny.TypeUrl),
+	))
+
+	return nil
+}
+
+// addPubKeyRotation records the rotation after the previous rotations of the account.
+func (ak AccountKeeper) addPubKeyRotation(ctx context.Context, addr sdk.AccAddress, rotation types.PubKeyRotation) error {
+	it, err := ak.PubKeyRotations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr).Descending())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var next uint64
+	if it.Valid() {
+		key, err := it.Key()
+		if err != nil {
+			return err
+		}
+		next = key.K2() + 1
+	}
+
+	return ak.PubKeyRotations.Set(ctx, collections.Join(addr, next), rotation)
+}
@@ -222,3 +222,40 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().NotNil(feeCollector)
 	suite.Require().NotZero(feeCollector.GetAccountNumber()&(uint64(1)<<63), "fee_collector should have hash-based ID")
 }
+
+func (suite *KeeperTestSuite) TestLaneSequences() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	suite.Require().NoError(suite.accountKeeper.SetLaneSequence(ctx, addr, 0, 3))
+	suite.Require().NoError(suite.accountKeeper.SetLaneSequence(ctx, addr, 7, 5))
+
+	sequence, err := suite.accountKeeper.GetSequence(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), sequence)
+	sequence, err = suite.accountKeeper.GetLaneSequence(ctx, addr, 7)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), sequence)
+	sequence, err = suite.accountKeeper.GetLaneSequence(ctx, addr, 8)
+	suite.Require().NoError(err)
+	suite.Require().Zero(sequence)
+
+	// the lanes are exported and imported with the accounts
+	genState := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.SequenceLane{{Address: addr.String(), Lane: 7, Sequence: 5}}, genState.SequenceLanes)
+
+	suite.SetupTest() // reset
+	suite.accountKeeper.InitGenesis(suite.ctx, *genState)
+	sequence, err = suite.accountKeeper.GetLaneSequence(suite.ctx, addr, 7)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), sequence)
+	sequence, err = suite.accountKeeper.GetLaneSequence(suite.ctx, addr, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), sequence)
+
+	unknown := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.Require().Error(suite.accountKeeper.SetLaneSequence(suite.ctx, unknown, 0, 1))
+}
@@ -3,6 +3,10 @@ package keeper_test
 import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -168,3 +172,101 @@ func (s *KeeperTestSuite) TestUpdateParamsAuthority() {
 		s.Require().Contains(err.Error(), "invalid authority")
 	})
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(oldPubKey))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	mldsaPriv, err := mldsa65.GenPrivKey()
+	s.Require().NoError(err)
+	newPubKey := mldsaPriv.PubKey()
+
+	s.accountKeeper.GetModuleAccount(s.ctx, types.FeeCollectorName)
+	noPubKeyAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, noPubKeyAddr))
+
+	newMsg := func(addr sdk.AccAddress, pubKey cryptotypes.PubKey) *types.MsgRotatePubKey {
+		msg, err := types.NewMsgRotatePubKey(addr.String(), pubKey)
+		s.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			req:       &types.MsgRotatePubKey{Address: "foo", NewPubKey: newMsg(addr, newPubKey).NewPubKey},
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "empty pubkey",
+			req:       &types.MsgRotatePubKey{Address: addr.String()},
+			expErrMsg: "new pubkey cannot be empty",
+		},
+		{
+			name:      "unknown account",
+			req:       newMsg(sdk.AccAddress("unknown"), newPubKey),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "pubkey not set",
+			req:       newMsg(noPubKeyAddr, newPubKey),
+			expErrMsg: "is not set",
+		},
+		{
+			name:      "same pubkey",
+			req:       newMsg(addr, oldPubKey),
+			expErrMsg: "new pubkey is the current pubkey",
+		},
+		{
+			name:      "module account",
+			req:       newMsg(types.NewModuleAddress(types.FeeCollectorName), newPubKey),
+			expErrMsg: "cannot rotate the pubkey of module account",
+		},
+		{
+			name:      "bls12_381 pubkey",
+			req:       newMsg(addr, &bls12_381.PubKey{Key: make([]byte, 96)}),
+			expErrMsg: "cannot rotate to a bls12_381 pubkey",
+		},
+		{
+			name: "rotate to a post-quantum pubkey",
+			req:  newMsg(addr, newPubKey),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RotatePubKey(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	// the address is kept, the pubkey is rotated
+	acc = s.accountKeeper.GetAccount(s.ctx, addr)
+	s.Require().True(newPubKey.Equals(acc.GetPubKey()))
+
+	res, err := s.queryClient.PubKeyHistory(s.ctx, &types.QueryPubKeyHistoryRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 1)
+	s.Require().Equal(addr.String(), res.Rotations[0].Address)
+	s.Require().Equal(s.ctx.BlockHeight(), res.Rotations[0].Height)
+	s.Require().True(oldPubKey.Equals(res.Rotations[0].OldPubKey.GetCachedValue().(cryptotypes.PubKey)))
+	s.Require().True(newPubKey.Equals(res.Rotations[0].NewPubKey.GetCachedValue().(cryptotypes.PubKey)))
+
+	// rotating back is recorded after the first rotation, and the history is exported
+	_, err = s.msgServer.RotatePubKey(s.ctx, newMsg(addr, oldPubKey))
+	s.Require().NoError(err)
+	genState := s.accountKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.PubKeyRotations, 2)
+	s.Require().Equal(res.Rotations[0].NewPubKey.Value, genState.PubKeyRotations[1].OldPubKey.Value)
+}
@@ -0,0 +1,72 @@
+package posthandler
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultUndeclaredWriteGas is the gas charged for each write outside of the declared access list.
+const DefaultUndeclaredWriteGas uint64 = 1000
+
+// AccessListDecorator enforces the access list declared by the transaction, see
+// `types.ExtensionOptionAccessList`. Every key written by the messages outside of the
+// declaration is charged undeclaredWriteGas, if undeclaredWriteGas is zero the transaction
+// is rejected instead.
+//
+// Only the writes of the messages are enforced, the ante handler writes (fee deduction,
+// sequence increment, ...) and the post handler writes are not tracked and don't need to
+// be declared. The writes are only visible with write tracking enabled on the BaseApp, see
+// `baseapp.SetTrackTxWrites`, otherwise the decorator is a no-op.
+type AccessListDecorator struct {
+	undeclaredWriteGas uint64
+}
+
+func NewAccessListDecorator(undeclaredWriteGas uint64) AccessListDecorator {
+	return AccessListDecorator{undeclaredWriteGas: undeclaredWriteGas}
+}
+
+var _ sdk.PostDecorator = AccessListDecorator{}
+
+func (ald AccessListDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// the writes of failed messages are discarded
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	accessList, err := types.AccessListFromTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if accessList == nil {
+		return next(ctx, tx, simulate, success)
+	}
+
+	written, ok := baseapp.WrittenKeys(ctx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	var undeclared uint64
+	for _, storeKey := range slices.Sorted(maps.Keys(written)) {
+		for _, key := range written[storeKey] {
+			if accessList.Covers(storeKey, key) {
+				continue
+			}
+			if ald.undeclaredWriteGas == 0 {
+				return ctx, sdkerrors.ErrUnauthorized.Wrapf("write to key %X of store %s is not declared in the access list", key, storeKey)
+			}
+			undeclared++
+		}
+	}
+
+	if undeclared > 0 {
+		ctx.GasMeter().ConsumeGas(ald.undeclaredWriteGas*undeclared, "undeclared access list writes")
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -5,11 +5,21 @@ import (
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// EnforceAccessLists enables the enforcement of the access lists declared by
+	// transactions, it requires write tracking on the BaseApp, see AccessListDecorator.
+	EnforceAccessLists bool
+	// UndeclaredWriteGas is the gas charged for each write outside of the declared
+	// access list, zero rejects the transaction instead.
+	UndeclaredWriteGas uint64
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, empty unless enabled by the options.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
+	if options.EnforceAccessLists {
+		postDecorators = append(postDecorators, NewAccessListDecorator(options.UndeclaredWriteGas))
+	}
 
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -0,0 +1,8 @@
+//go:build bls12381
+
+package tx
+
+import signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+// blsSignModes are the default sign modes verifying bls12_381 signatures.
+var blsSignModes = []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_BLS_AGGREGATE}
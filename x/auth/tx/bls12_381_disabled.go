@@ -0,0 +1,9 @@
+//go:build !bls12381
+
+package tx
+
+import signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+// blsSignModes is empty, bls12_381 signatures can only be verified by binaries
+// built with the bls12381 build tag.
+var blsSignModes []signingtypes.SignMode
@@ -10,6 +10,7 @@ import (
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/blsaggregate"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/direct"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/directaux"
 )
@@ -52,11 +53,12 @@ type ConfigOptions struct {
 }
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
-var DefaultSignModes = []signingtypes.SignMode{
+// SIGN_MODE_BLS_AGGREGATE is only enabled by default with the bls12381 build tag.
+var DefaultSignModes = append([]signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
-}
+}, blsSignModes...)
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
@@ -134,6 +136,8 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				FileResolver: signingOpts.FileResolver,
 				TypeResolver: signingOpts.TypeResolver,
 			})
+		case signingtypes.SignMode_SIGN_MODE_BLS_AGGREGATE:
+			handlers[i] = &blsaggregate.SignModeHandler{}
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
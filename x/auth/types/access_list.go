@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAccessListPrefixes bounds the number of prefixes declared by a transaction.
+const MaxAccessListPrefixes = 256
+
+// Validate performs a stateless validation of the declaration.
+func (m *ExtensionOptionAccessList) Validate() error {
+	if n := m.NumPrefixes(); n > MaxAccessListPrefixes {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many access list prefixes, got: %d, max: %d", n, MaxAccessListPrefixes)
+	}
+
+	seen := make(map[string]struct{}, len(m.AccessList))
+	for _, tuple := range m.AccessList {
+		if tuple.StoreKey == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "access list store key cannot be empty")
+		}
+		if _, ok := seen[tuple.StoreKey]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate access list store key: %s", tuple.StoreKey)
+		}
+		seen[tuple.StoreKey] = struct{}{}
+
+		for _, prefix := range tuple.Prefixes {
+			if len(prefix) == 0 {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty access list prefix for store: %s", tuple.StoreKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// NumPrefixes returns the number of declared prefixes across all stores.
+func (m *ExtensionOptionAccessList) NumPrefixes() int {
+	var n int
+	for _, tuple := range m.AccessList {
+		n += len(tuple.Prefixes)
+	}
+	return n
+}
+
+// Covers returns true if the key of the store starts with one of the declared prefixes.
+func (m *ExtensionOptionAccessList) Covers(storeKey string, key []byte) bool {
+	for _, tuple := range m.AccessList {
+		if tuple.StoreKey != storeKey {
+			continue
+		}
+		for _, prefix := range tuple.Prefixes {
+			if bytes.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DeclaredWrites returns the declared prefixes by store name, block-stm uses them as the
+// write estimates of the transaction.
+func (m *ExtensionOptionAccessList) DeclaredWrites() map[string][][]byte {
+	writes := make(map[string][][]byte, len(m.AccessList))
+	for _, tuple := range m.AccessList {
+		writes[tuple.StoreKey] = append(writes[tuple.StoreKey], tuple.Prefixes...)
+	}
+	return writes
+}
+
+// AccessListFromTx returns the access list declared by the transaction, in either the critical
+// or the non-critical extension options. It returns an error if more than one is declared.
+func AccessListFromTx(tx sdk.Tx) (*ExtensionOptionAccessList, error) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+		GetNonCriticalExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	var accessList *ExtensionOptionAccessList
+	for _, opts := range [][]*codectypes.Any{extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()} {
+		for _, opt := range opts {
+			declared, ok := opt.GetCachedValue().(*ExtensionOptionAccessList)
+			if !ok {
+				continue
+			}
+			if accessList != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multiple access lists declared")
+			}
+			accessList = declared
+		}
+	}
+
+	return accessList, nil
+}
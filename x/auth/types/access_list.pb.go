@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/access_list.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionAccessList is a tx extension option declaring the store keys
+// written by the messages of the transaction. It's used by block-stm as the
+// write estimate of the transaction, and the transaction is charged extra gas
+// or rejected when its messages write keys outside of the declaration. The
+// writes of the ante and post handlers are not enforced.
+//
+// It's recommended to set it as a non-critical extension option, so chains
+// that don't support access lists ignore it.
+type ExtensionOptionAccessList struct {
+	AccessList []AccessTuple `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3" json:"access_list"`
+}
+
+func (m *ExtensionOptionAccessList) Reset()         { *m = ExtensionOptionAccessList{} }
+func (m *ExtensionOptionAccessList) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionAccessList) ProtoMessage()    {}
+func (*ExtensionOptionAccessList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14c7c1e2193d72bd, []int{0}
+}
+func (m *ExtensionOptionAccessList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionAccessList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionAccessList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionAccessList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionAccessList.Merge(m, src)
+}
+func (m *ExtensionOptionAccessList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionAccessList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionAccessList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionAccessList proto.InternalMessageInfo
+
+func (m *ExtensionOptionAccessList) GetAccessList() []AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+// AccessTuple declares the keys of a single store.
+type AccessTuple struct {
+	// store_key is the name of the store, e.g. "bank".
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// prefixes covers every key starting with one of them, a full key
+	// declares exactly that key, which gives the best scheduling.
+	Prefixes [][]byte `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
+func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
+func (*AccessTuple) ProtoMessage()    {}
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14c7c1e2193d72bd, []int{1}
+}
+func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessTuple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTuple.Merge(m, src)
+}
+func (m *AccessTuple) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
+
+func (m *AccessTuple) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *AccessTuple) GetPrefixes() [][]byte {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExtensionOptionAccessList)(nil), "cosmos.auth.v1beta1.ExtensionOptionAccessList")
+	proto.RegisterType((*AccessTuple)(nil), "cosmos.auth.v1beta1.AccessTuple")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/access_list.proto", fileDescriptor_14c7c1e2193d72bd)
+}
+
+var fileDescriptor_14c7c1e2193d72bd = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4d, 0x4a, 0xc3, 0x40,
+	0x1c, 0xc5, 0x33, 0x56, 0xa4, 0x9d, 0x0a, 0x42, 0xea, 0xa2, 0xad, 0x30, 0x86, 0xa2, 0x50, 0x17,
+	0x9d, 0xb1, 0x7e, 0x6c, 0xea, 0xca, 0x8a, 0x88, 0x28, 0x08, 0xa5, 0x2b, 0x5d, 0x84, 0x24, 0x8e,
+	0xe9, 0xd0, 0x36, 0x13, 0x32, 0x13, 0x49, 0x6e, 0xe1, 0x15, 0xbc, 0x43, 0x0f, 0x51, 0x5c, 0x15,
+	0x57, 0xae, 0x44, 0x92, 0x8b, 0x48, 0x33, 0x21, 0x16, 0xe9, 0x6e, 0xfe, 0x8f, 0xdf, 0x7b, 0x0f,
+	0xde, 0xc0, 0x43, 0x87, 0x8b, 0x29, 0x17, 0xc4, 0x0a, 0xe5, 0x88, 0xbc, 0x76, 0x6d, 0x2a, 0xad,
+	0x2e, 0xb1, 0x1c, 0x87, 0x0a, 0x61, 0x4e, 0x98, 0x90, 0xd8, 0x0f, 0xb8, 0xe4, 0x7a, 0x4d, 0x61,
+	0x78, 0x89, 0xe1, 0x1c, 0x6b, 0x36, 0x94, 0x68, 0x66, 0x08, 0xc9, 0x89, 0xec, 0x68, 0xee, 0xba,
+	0xdc, 0xe5, 0x4a, 0x5f, 0xbe, 0x94, 0xda, 0x7a, 0x07, 0xb0, 0x71, 0x1d, 0x49, 0xea, 0x09, 0xc6,
+	0xbd, 0x07, 0x5f, 0x32, 0xee, 0x5d, 0x66, 0x55, 0xf7, 0x4c, 0x48, 0xfd, 0x06, 0x56, 0x57, 0x8a,
+	0xeb, 0xc0, 0x28, 0xb5, 0xab, 0x27, 0x06, 0x5e, 0xd3, 0x8c, 0x95, 0x6b, 0x18, 0xfa, 0x13, 0xda,
+	0xdf, 0x9c, 0x7f, 0xef, 0x6b, 0x03, 0x68, 0x15, 0x41, 0xbd, 0x8b, 0x8f, 0x59, 0xe7, 0x20, 0xb7,
+	0xc9, 0xa8, 0x30, 0x0d, 0xa3, 0x7f, 0xdd, 0xb7, 0x9f, 0xb3, 0xce, 0x8e, 0xe2, 0x3a, 0xe2, 0x79,
+	0x6c, 0x1c, 0xe3, 0xf3, 0xb3, 0xd6, 0x13, 0xac, 0xae, 0xa4, 0xeb, 0x7b, 0xb0, 0x22, 0x24, 0x0f,
+	0xa8, 0x39, 0xa6, 0x71, 0x1d, 0x18, 0xa0, 0x5d, 0x19, 0x94, 0x33, 0xe1, 0x8e, 0xc6, 0x7a, 0x13,
+	0x96, 0xfd, 0x80, 0xbe, 0xb0, 0x88, 0x8a, 0xfa, 0x86, 0x51, 0x6a, 0x6f, 0x0f, 0x8a, 0xbb, 0x57,
+	0x5b, 0x13, 0xde, 0xbf, 0x9a, 0x27, 0x08, 0x2c, 0x12, 0x04, 0x7e, 0x12, 0x04, 0xde, 0x52, 0xa4,
+	0x2d, 0x52, 0xa4, 0x7d, 0xa5, 0x48, 0x7b, 0x3c, 0x72, 0x99, 0x1c, 0x85, 0x36, 0x76, 0xf8, 0x34,
+	0x5f, 0x92, 0xfc, 0x99, 0x49, 0xa4, 0xfe, 0x47, 0xc6, 0x3e, 0x15, 0xf6, 0x56, 0x36, 0xe6, 0xe9,
+	0xef, 0x00, 0x5e, 0x68, 0x3b, 0x7b, 0xbb, 0x01, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionAccessList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionAccessList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionAccessList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccessList(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintAccessList(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintAccessList(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccessList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccessList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionAccessList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovAccessList(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovAccessList(uint64(l))
+	}
+	if len(m.Prefixes) > 0 {
+		for _, b := range m.Prefixes {
+			l = len(b)
+			n += 1 + l + sovAccessList(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccessList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccessList(x uint64) (n int) {
+	return sovAccessList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionAccessList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionAccessList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionAccessList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
+			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccessList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccessList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccessList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccessList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccessList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccessList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccessList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccessList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccessList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccessList = fmt.Errorf("proto: unexpected end of group")
+)
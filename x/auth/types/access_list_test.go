@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestExtensionOptionAccessListCovers(t *testing.T) {
+	accessList := types.ExtensionOptionAccessList{AccessList: []types.AccessTuple{
+		{StoreKey: "bank", Prefixes: [][]byte{{0x02, 0x01}}},
+		{StoreKey: "acc", Prefixes: [][]byte{{0x01, 0xAA}}},
+	}}
+
+	require.True(t, accessList.Covers("bank", []byte{0x02, 0x01}))
+	require.True(t, accessList.Covers("bank", []byte{0x02, 0x01, 0xFF}))
+	require.False(t, accessList.Covers("bank", []byte{0x02}))
+	require.False(t, accessList.Covers("bank", []byte{0x01, 0xAA}))
+	require.False(t, accessList.Covers("staking", []byte{0x02, 0x01}))
+
+	require.Equal(t, 2, accessList.NumPrefixes())
+	require.Equal(t, map[string][][]byte{
+		"bank": {{0x02, 0x01}},
+		"acc":  {{0x01, 0xAA}},
+	}, accessList.DeclaredWrites())
+}
+
+func TestExtensionOptionAccessListValidate(t *testing.T) {
+	tooMany := make([][]byte, types.MaxAccessListPrefixes+1)
+	for i := range tooMany {
+		tooMany[i] = []byte{0x01}
+	}
+
+	testCases := []struct {
+		name   string
+		tuples []types.AccessTuple
+		expErr string
+	}{
+		{"valid", []types.AccessTuple{{StoreKey: "bank", Prefixes: [][]byte{{0x02}}}}, ""},
+		{"empty", nil, ""},
+		{"duplicate store", []types.AccessTuple{{StoreKey: "bank"}, {StoreKey: "bank"}}, "duplicate access list store key: bank"},
+		{"too many prefixes", []types.AccessTuple{{StoreKey: "bank", Prefixes: tooMany}}, "too many access list prefixes"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&types.ExtensionOptionAccessList{AccessList: tc.tuples}).Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+type mockExtTx struct {
+	sdk.Tx
+	critical, nonCritical []*codectypes.Any
+}
+
+func (m mockExtTx) GetExtensionOptions() []*codectypes.Any {
+	return m.critical
+}
+
+func (m mockExtTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return m.nonCritical
+}
+
+func TestAccessListFromTx(t *testing.T) {
+	accessList := &types.ExtensionOptionAccessList{AccessList: []types.AccessTuple{{StoreKey: "bank"}}}
+	any, err := codectypes.NewAnyWithValue(accessList)
+	require.NoError(t, err)
+
+	declared, err := types.AccessListFromTx(mockExtTx{nonCritical: []*codectypes.Any{any}})
+	require.NoError(t, err)
+	require.Equal(t, accessList, declared)
+
+	declared, err = types.AccessListFromTx(mockExtTx{})
+	require.NoError(t, err)
+	require.Nil(t, declared)
+
+	_, err = types.AccessListFromTx(mockExtTx{critical: []*codectypes.Any{any}, nonCritical: []*codectypes.Any{any}})
+	require.ErrorContains(t, err, "multiple access lists declared")
+}
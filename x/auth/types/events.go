@@ -0,0 +1,9 @@
+package types
+
+// auth module event types
+const (
+	EventTypeRotatePubKey = "rotate_pub_key"
+
+	AttributeKeyAddress    = "address"
+	AttributeKeyPubKeyType = "pub_key_type"
+)
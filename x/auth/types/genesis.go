@@ -40,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -72,7 +77,15 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	if err := ValidateSequenceLanes(data.SequenceLanes); err != nil {
+		return err
+	}
+
+	return ValidatePubKeyRotations(data.PubKeyRotations)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +32,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// sequence_lanes are the sequences of the sequence lanes of the accounts,
+	// except the lane 0 which is the sequence of the account.
+	SequenceLanes []SequenceLane `protobuf:"bytes,3,rep,name=sequence_lanes,json=sequenceLanes,proto3" json:"sequence_lanes"`
+	// pub_key_rotations are the public key rotations of the accounts.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,4,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +86,20 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetSequenceLanes() []SequenceLane {
+	if m != nil {
+		return m.SequenceLanes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +107,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4f, 0xe2, 0x40,
+	0x18, 0xc6, 0x5b, 0xd8, 0x90, 0xdd, 0xb2, 0xbb, 0x84, 0x2e, 0xc9, 0x16, 0x36, 0xe9, 0x82, 0x1e,
+	0x44, 0x12, 0x66, 0x00, 0xf5, 0x6a, 0x22, 0x1e, 0x3c, 0xe8, 0xc1, 0x94, 0x9b, 0x97, 0x66, 0x5a,
+	0xc7, 0xd2, 0x40, 0x67, 0x2a, 0x33, 0x35, 0xf6, 0x5b, 0xf8, 0x31, 0x3c, 0x7a, 0xf0, 0x43, 0x70,
+	0x31, 0xe1, 0x68, 0x3c, 0x18, 0x03, 0x07, 0xbf, 0x86, 0x61, 0x66, 0xfc, 0x83, 0xa9, 0x97, 0x66,
+	0xf2, 0xbe, 0xbf, 0xf7, 0x7d, 0xfa, 0x3c, 0xaf, 0xd1, 0xf0, 0x29, 0x8b, 0x28, 0x83, 0x28, 0xe1,
+	0x43, 0x78, 0xd1, 0xf5, 0x30, 0x47, 0x5d, 0x18, 0x60, 0x82, 0x59, 0xc8, 0x40, 0x3c, 0xa1, 0x9c,
+	0x9a, 0x7f, 0x24, 0x02, 0x96, 0x08, 0x50, 0x48, 0xad, 0x1a, 0x50, 0x1a, 0x8c, 0x31, 0x14, 0x88,
+	0x97, 0x9c, 0x41, 0x44, 0x52, 0xc9, 0xd7, 0x2a, 0x01, 0x0d, 0xa8, 0x78, 0xc2, 0xe5, 0x4b, 0x55,
+	0xed, 0x2c, 0x21, 0xb1, 0x52, 0xf6, 0x37, 0xb2, 0xfa, 0x0c, 0x9f, 0x27, 0x98, 0xf8, 0xd8, 0x1d,
+	0x23, 0x82, 0x15, 0xd8, 0xca, 0x02, 0xe3, 0xc4, 0x73, 0x47, 0x38, 0x75, 0x27, 0x94, 0x23, 0x1e,
+	0x52, 0xa2, 0xd8, 0xaa, 0x64, 0x5d, 0xf9, 0x37, 0xca, 0x87, 0x6c, 0x95, 0x51, 0x14, 0x12, 0x0a,
+	0xc5, 0x57, 0x96, 0xd6, 0xee, 0x72, 0xc6, 0xcf, 0x03, 0x69, 0x7d, 0xc0, 0x11, 0xc7, 0xe6, 0xae,
+	0x51, 0x88, 0xd1, 0x04, 0x45, 0xcc, 0xd2, 0xeb, 0x7a, 0xb3, 0xd8, 0xfb, 0x07, 0x32, 0xa2, 0x00,
+	0xc7, 0x02, 0xe9, 0xff, 0x98, 0x3e, 0xfe, 0xd7, 0xae, 0x9f, 0x6f, 0x5a, 0xba, 0xa3, 0xa6, 0xcc,
+	0x8e, 0xf1, 0x1d, 0xf9, 0x3e, 0x4d, 0x08, 0x67, 0x56, 0xae, 0x9e, 0x6f, 0x16, 0x7b, 0x15, 0x20,
+	0x73, 0x03, 0xaf, 0xb9, 0x81, 0x3d, 0x92, 0x3a, 0x6f, 0x94, 0x89, 0x8c, 0xdf, 0x2b, 0x9e, 0x99,
+	0x95, 0x17, 0x73, 0x8d, 0x4c, 0xe5, 0x81, 0x42, 0x8f, 0x10, 0xc1, 0xfd, 0xbf, 0x4b, 0xfd, 0x87,
+	0xdb, 0x76, 0x49, 0x92, 0x6d, 0x76, 0x3a, 0xaa, 0x77, 0xc0, 0xce, 0xb6, 0xf3, 0x8b, 0x7d, 0xc0,
+	0x98, 0x39, 0x34, 0xca, 0x9f, 0xd3, 0x62, 0xd6, 0x37, 0xa1, 0xb2, 0x9e, 0xed, 0x2f, 0xf1, 0x0e,
+	0x71, 0xea, 0x28, 0xf6, 0x6b, 0x9d, 0x52, 0xbc, 0x02, 0xb2, 0xfe, 0xfe, 0x74, 0x6e, 0xeb, 0xb3,
+	0xb9, 0xad, 0x3f, 0xcd, 0x6d, 0xfd, 0x6a, 0x61, 0x6b, 0xb3, 0x85, 0xad, 0xdd, 0x2f, 0x6c, 0xed,
+	0x64, 0x33, 0x08, 0xf9, 0x30, 0xf1, 0x80, 0x4f, 0x23, 0x75, 0x15, 0xf8, 0xbe, 0x0d, 0x5e, 0xca,
+	0xdb, 0xf2, 0x34, 0xc6, 0xcc, 0x2b, 0x88, 0xa4, 0xb6, 0x5e, 0x06, 0x00, 0x2f, 0x34, 0x56, 0x82,
+	0xa9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +155,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SequenceLanes) > 0 {
+		for iNdEx := len(m.SequenceLanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SequenceLanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +235,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SequenceLanes) > 0 {
+		for _, e := range m.SequenceLanes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +352,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceLanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceLanes = append(m.SequenceLanes, SequenceLane{})
+			if err := m.SequenceLanes[len(m.SequenceLanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
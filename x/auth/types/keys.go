@@ -32,6 +32,12 @@ var (
 	// UnorderedNoncesKey prefix for the unordered sequence storage.
 	UnorderedNoncesKey = collections.NewPrefix(90)
 
+	// SequenceLanesKey prefix for the sequence lanes storage.
+	SequenceLanesKey = collections.NewPrefix(91)
+
+	// PubKeyRotationsKey prefix for the public key rotations storage.
+	PubKeyRotationsKey = collections.NewPrefix(92)
+
 	// LegacyGlobalAccountNumberKey is the legacy param key for global account number
 	LegacyGlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg                       = &MsgRotatePubKey{}
+	_ types.UnpackInterfacesMessage = MsgRotatePubKey{}
+)
+
+// NewMsgRotatePubKey returns a MsgRotatePubKey replacing the public key of the account by newPubKey.
+func NewMsgRotatePubKey(address string, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	pkAny, err := types.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:   address,
+		NewPubKey: pkAny,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
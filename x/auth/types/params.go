@@ -59,6 +59,20 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBLS12381 returns gas fee of a bls12_381 signature verification, which computes
+// two pairings and is several times slower than a secp256k1 verification. It is also the fee of
+// the verification of a SIGN_MODE_BLS_AGGREGATE signature, which is verified once for all the
+// signers of the aggregate.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 5
+}
+
+// SigVerifyCostBLS12381AggregateSigner returns gas fee of each signer of a SIGN_MODE_BLS_AGGREGATE
+// signature, whose public key is validated and added to the aggregated public key.
+func (p Params) SigVerifyCostBLS12381AggregateSigner() uint64 {
+	return p.SigVerifyCostSecp256k1 / 4
+}
+
 func validateTxSigLimit(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = PubKeyRotation{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var oldPubKey, newPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &oldPubKey); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewPubKey, &newPubKey)
+}
+
+// ValidatePubKeyRotations validates the public key rotations of the genesis state.
+func ValidatePubKeyRotations(rotations []PubKeyRotation) error {
+	for _, rotation := range rotations {
+		if _, err := sdk.AccAddressFromBech32(rotation.Address); err != nil {
+			return errorsmod.Wrapf(err, "invalid pubkey rotation address: %s", rotation.Address)
+		}
+		if rotation.OldPubKey == nil || rotation.NewPubKey == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "missing pubkey in rotation of %s at height %d", rotation.Address, rotation.Height)
+		}
+	}
+
+	return nil
+}
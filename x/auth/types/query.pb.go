@@ -932,6 +932,217 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QuerySequenceLaneRequest is the request type for the Query/SequenceLane RPC method.
+type QuerySequenceLaneRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// lane is the sequence lane.
+	Lane uint64 `protobuf:"varint,2,opt,name=lane,proto3" json:"lane,omitempty"`
+}
+
+func (m *QuerySequenceLaneRequest) Reset()         { *m = QuerySequenceLaneRequest{} }
+func (m *QuerySequenceLaneRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySequenceLaneRequest) ProtoMessage()    {}
+func (*QuerySequenceLaneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QuerySequenceLaneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySequenceLaneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySequenceLaneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySequenceLaneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySequenceLaneRequest.Merge(m, src)
+}
+func (m *QuerySequenceLaneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySequenceLaneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySequenceLaneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySequenceLaneRequest proto.InternalMessageInfo
+
+func (m *QuerySequenceLaneRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySequenceLaneRequest) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+// QuerySequenceLaneResponse is the response type for the Query/SequenceLane RPC method.
+type QuerySequenceLaneResponse struct {
+	// sequence is the sequence of the sequence lane.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QuerySequenceLaneResponse) Reset()         { *m = QuerySequenceLaneResponse{} }
+func (m *QuerySequenceLaneResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySequenceLaneResponse) ProtoMessage()    {}
+func (*QuerySequenceLaneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QuerySequenceLaneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySequenceLaneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySequenceLaneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySequenceLaneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySequenceLaneResponse.Merge(m, src)
+}
+func (m *QuerySequenceLaneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySequenceLaneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySequenceLaneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySequenceLaneResponse proto.InternalMessageInfo
+
+func (m *QuerySequenceLaneResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryPubKeyHistoryRequest is the request type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryResponse is the response type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryResponse struct {
+	// rotations are the public key rotations of the account.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1164,101 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QuerySequenceLaneRequest)(nil), "cosmos.auth.v1beta1.QuerySequenceLaneRequest")
+	proto.RegisterType((*QuerySequenceLaneResponse)(nil), "cosmos.auth.v1beta1.QuerySequenceLaneResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0x21, 0x49, 0x5f, 0x3e, 0x2a, 0xc6, 0xae, 0x08, 0x9b, 0xd4, 0xb6, 0xb6, 0x90,
-	0x2f, 0xea, 0xdd, 0xda, 0x71, 0x13, 0x88, 0xc4, 0x21, 0x4b, 0xd5, 0x2a, 0x42, 0x20, 0x77, 0x5b,
-	0x41, 0xe9, 0xc5, 0x5a, 0xdb, 0x6b, 0x67, 0x45, 0xbd, 0xeb, 0x7a, 0xd7, 0xa8, 0x26, 0xb2, 0x90,
-	0x90, 0x90, 0x7a, 0xe0, 0x80, 0x04, 0x37, 0x2e, 0xf9, 0x13, 0x38, 0x58, 0x42, 0x48, 0xdc, 0xe0,
-	0x50, 0x72, 0xaa, 0xc2, 0x05, 0x71, 0x40, 0x28, 0x41, 0x82, 0xbf, 0x02, 0x55, 0x9e, 0x79, 0xfb,
-	0xe1, 0x78, 0x6c, 0x6f, 0xda, 0x9b, 0xbd, 0xf3, 0xde, 0xef, 0xe3, 0xcd, 0x9b, 0x79, 0x03, 0xa9,
-	0xb2, 0xed, 0xd4, 0x6d, 0x47, 0xd1, 0x5b, 0xee, 0xbe, 0xf2, 0x59, 0xb6, 0x64, 0xb8, 0x7a, 0x56,
-	0x79, 0xd4, 0x32, 0x9a, 0x6d, 0xb9, 0xd1, 0xb4, 0x5d, 0x9b, 0xc4, 0x59, 0x80, 0xdc, 0x0b, 0x90,
-	0x31, 0x40, 0xdc, 0xc0, 0xac, 0x92, 0xee, 0x18, 0x2c, 0xda, 0xcf, 0x6d, 0xe8, 0x35, 0xd3, 0xd2,
-	0x5d, 0xd3, 0xb6, 0x18, 0x80, 0x98, 0xa8, 0xd9, 0x35, 0x9b, 0xfe, 0x54, 0x7a, 0xbf, 0xf0, 0xeb,
-	0xeb, 0x35, 0xdb, 0xae, 0x3d, 0x34, 0x14, 0xfa, 0xaf, 0xd4, 0xaa, 0x2a, 0xba, 0x85, 0x8c, 0xe2,
-	0x32, 0x2e, 0xe9, 0x0d, 0x53, 0xd1, 0x2d, 0xcb, 0x76, 0x29, 0x9a, 0x83, 0xab, 0x49, 0x9e, 0x60,
-	0x2a, 0x0e, 0x81, 0xd9, 0x7a, 0x91, 0x31, 0xa2, 0x78, 0xb6, 0xb4, 0x84, 0xa9, 0x9e, 0xe0, 0xb0,
-	0x4f, 0xc9, 0x81, 0xc4, 0x9d, 0xde, 0xdf, 0xdd, 0x72, 0xd9, 0x6e, 0x59, 0xae, 0xa3, 0x19, 0x8f,
-	0x5a, 0x86, 0xe3, 0x92, 0x5b, 0x00, 0x81, 0xa5, 0x45, 0x21, 0x2d, 0xac, 0xcd, 0xe6, 0x56, 0x64,
-	0xc4, 0xed, 0xf9, 0x97, 0x19, 0x0a, 0x4a, 0x91, 0x0b, 0x7a, 0xcd, 0xc0, 0x5c, 0x2d, 0x94, 0xb9,
-	0x13, 0x3f, 0xee, 0x66, 0x2e, 0xb1, 0xb4, 0x8c, 0x53, 0xf9, 0x34, 0x7d, 0x5d, 0xce, 0x6f, 0x4a,
-	0xbf, 0x09, 0x70, 0xf9, 0x0c, 0xab, 0xd3, 0xb0, 0x2d, 0xc7, 0x20, 0x1a, 0xcc, 0xe8, 0xf8, 0x6d,
-	0x51, 0x48, 0x5f, 0x58, 0x9b, 0xcd, 0x25, 0x64, 0x56, 0x17, 0xd9, 0x2b, 0x99, 0xbc, 0x6b, 0xb5,
-	0xd5, 0xf4, 0x51, 0x37, 0xb3, 0xcc, 0xd9, 0x22, 0x19, 0x11, 0xf7, 0x34, 0x1f, 0x87, 0xdc, 0xee,
-	0xb3, 0x32, 0x41, 0xad, 0xac, 0x8e, 0xb5, 0xc2, 0x04, 0x8d, 0xf7, 0x72, 0x17, 0xe2, 0x61, 0x2b,
-	0x5e, 0xfd, 0x72, 0x30, 0xad, 0x57, 0x2a, 0x4d, 0xc3, 0x71, 0x68, 0xf1, 0x2e, 0xaa, 0x8b, 0xc7,
-	0xdd, 0x4c, 0x02, 0x49, 0x77, 0xd9, 0xca, 0x5d, 0xb7, 0x69, 0x5a, 0x35, 0xcd, 0x0b, 0xdc, 0x99,
-	0x79, 0x72, 0x98, 0x8a, 0xfd, 0x77, 0x98, 0x8a, 0x49, 0xfb, 0xfd, 0xbb, 0xe2, 0x97, 0xa7, 0x00,
-	0xd3, 0x68, 0x0b, 0xb7, 0xe4, 0x45, 0xab, 0xe3, 0xc1, 0x48, 0x09, 0x20, 0x94, 0xa9, 0xa0, 0x37,
-	0xf5, 0xba, 0xb7, 0xfb, 0x52, 0x01, 0x4d, 0x79, 0x5f, 0x91, 0xfe, 0x1d, 0x98, 0x6a, 0xd0, 0x2f,
-	0xc8, 0xbe, 0x24, 0xf3, 0x48, 0x58, 0x92, 0x3a, 0xf9, 0xf4, 0xaf, 0x54, 0x4c, 0xc3, 0x04, 0x29,
-	0x0b, 0x22, 0x45, 0xfc, 0xc0, 0xae, 0xb4, 0x1e, 0x1a, 0x67, 0xba, 0x8d, 0x57, 0xd9, 0x2d, 0xe9,
-	0x6b, 0x01, 0x96, 0xb8, 0x39, 0xa8, 0xe6, 0x7e, 0xc4, 0x5e, 0x59, 0x39, 0xea, 0x66, 0x24, 0x9e,
-	0xd0, 0x3e, 0xdc, 0x50, 0xc7, 0xf0, 0xe5, 0xdc, 0x80, 0xd4, 0xa0, 0x1a, 0xb5, 0xfd, 0xa1, 0x5e,
-	0xf7, 0x1a, 0x9f, 0x10, 0x98, 0xb4, 0xf4, 0xba, 0xc1, 0x76, 0x5c, 0xa3, 0xbf, 0xa5, 0xcf, 0x21,
-	0x3d, 0x3c, 0x0d, 0x9d, 0x7c, 0x14, 0x6d, 0x5b, 0xa3, 0x1a, 0xf1, 0x37, 0x77, 0x03, 0xe2, 0xaa,
-	0x51, 0xde, 0xdf, 0xcc, 0x15, 0x9a, 0x46, 0xd5, 0x7c, 0x3c, 0xb2, 0xda, 0x05, 0x48, 0xf4, 0xc7,
-	0xa2, 0xb6, 0xab, 0x30, 0x5f, 0xa2, 0xdf, 0x8b, 0x0d, 0xba, 0x80, 0xe6, 0xe6, 0x4a, 0xa1, 0x60,
-	0x3e, 0xe2, 0xc7, 0xb0, 0x84, 0x8d, 0xae, 0xb6, 0x5d, 0xc3, 0xb9, 0x67, 0x63, 0xbf, 0x63, 0xb1,
-	0xae, 0xc2, 0x3c, 0x36, 0x7e, 0xb1, 0xd4, 0x5b, 0xa7, 0xc0, 0x73, 0xda, 0x9c, 0x1e, 0xca, 0xe1,
-	0x03, 0x3f, 0x80, 0x65, 0x3e, 0x30, 0x4a, 0x7e, 0x13, 0x16, 0x3c, 0x64, 0x87, 0xae, 0xa0, 0x66,
-	0x8f, 0x8f, 0x85, 0xf3, 0xb1, 0x3f, 0xf1, 0x45, 0xb3, 0xa8, 0x7b, 0x36, 0xe5, 0xf0, 0x44, 0xbf,
-	0x0c, 0xf4, 0x7d, 0x5f, 0xf6, 0x19, 0xe8, 0xa0, 0xd2, 0x2f, 0x58, 0x90, 0x2f, 0x20, 0x19, 0xbe,
-	0x2e, 0xfc, 0xe2, 0xec, 0xdd, 0x0c, 0x3a, 0x73, 0xc2, 0xac, 0x50, 0xc0, 0x0b, 0xea, 0xc4, 0xa2,
-	0xa0, 0x4d, 0x98, 0x15, 0x92, 0x03, 0xc0, 0x46, 0x29, 0x9a, 0x15, 0x7a, 0x2f, 0x4e, 0xaa, 0xf1,
-	0x3f, 0x07, 0xe0, 0xb7, 0xb5, 0x8b, 0x18, 0xb6, 0x57, 0xd9, 0xb9, 0x7c, 0xdc, 0xcd, 0xbc, 0x7a,
-	0x86, 0x5e, 0xce, 0x49, 0x07, 0x78, 0x36, 0x78, 0x02, 0xd0, 0xdd, 0x2e, 0x5c, 0xf2, 0xd8, 0xa2,
-	0x5e, 0x8c, 0x0b, 0x7a, 0x1f, 0xdc, 0x30, 0xf2, 0x12, 0xbc, 0x16, 0x26, 0xdf, 0xb3, 0xaa, 0xf6,
-	0xcb, 0xdc, 0xc2, 0x9c, 0x0a, 0x6f, 0x4b, 0x06, 0x2c, 0x0e, 0x72, 0xa0, 0xb3, 0x3c, 0x4c, 0x9a,
-	0x56, 0xd5, 0xc6, 0xa3, 0x9b, 0xe6, 0xde, 0x89, 0xaa, 0xee, 0x78, 0xe7, 0x53, 0xa3, 0xd1, 0x5c,
-	0x9a, 0xdc, 0xff, 0xf3, 0xf0, 0x0a, 0xe5, 0x21, 0x87, 0x02, 0xcc, 0x78, 0x37, 0x1e, 0x59, 0xe7,
-	0x62, 0xf2, 0xe6, 0xb6, 0xb8, 0x11, 0x25, 0x94, 0x09, 0x97, 0xde, 0x3d, 0x1a, 0x9c, 0x67, 0x4f,
-	0xfe, 0xfd, 0x61, 0x43, 0xf8, 0xf2, 0xf7, 0x7f, 0xbe, 0x9d, 0x48, 0x91, 0x2b, 0x0a, 0xf7, 0xd1,
-	0xe1, 0xa9, 0xfa, 0x4e, 0x80, 0x69, 0xc4, 0x24, 0x6b, 0x63, 0x69, 0x3d, 0x81, 0xeb, 0x11, 0x22,
-	0x51, 0x5f, 0x3e, 0x10, 0xb3, 0x4e, 0x56, 0x47, 0x8a, 0x51, 0x0e, 0x70, 0xfb, 0x3a, 0xe4, 0x58,
-	0x00, 0x32, 0xd8, 0x87, 0x64, 0x73, 0x2c, 0xef, 0xe0, 0xb1, 0x11, 0xf3, 0xe7, 0x4b, 0x42, 0xdd,
-	0x77, 0x8e, 0x78, 0x7d, 0x1a, 0x98, 0xc9, 0x12, 0x85, 0x6f, 0xc6, 0x3f, 0xfd, 0x45, 0xb3, 0xa2,
-	0x1c, 0x04, 0x87, 0xb3, 0x43, 0xbe, 0x12, 0x60, 0x8a, 0xcd, 0x55, 0xb2, 0x3a, 0x5c, 0x53, 0xdf,
-	0x10, 0x17, 0xd7, 0xc6, 0x07, 0xa2, 0xe0, 0xb5, 0x40, 0xdb, 0x15, 0xb2, 0xc4, 0xd5, 0xc6, 0xc6,
-	0x38, 0xf9, 0x49, 0x80, 0x85, 0xfe, 0x71, 0x4c, 0x94, 0xe1, 0x34, 0xdc, 0x61, 0x2f, 0x5e, 0x8f,
-	0x9e, 0x80, 0xfa, 0x6e, 0x0e, 0x36, 0xea, 0x56, 0x20, 0x79, 0x85, 0xbc, 0xc1, 0x95, 0x5c, 0xa7,
-	0x60, 0x45, 0xbf, 0x5f, 0x7f, 0x16, 0x20, 0xce, 0x99, 0xc2, 0x24, 0x1f, 0x51, 0x4f, 0xdf, 0xac,
-	0x17, 0x6f, 0x9c, 0x33, 0x0b, 0xad, 0xbc, 0x1d, 0xe8, 0xce, 0x90, 0xb7, 0xa2, 0xe8, 0x56, 0x0e,
-	0x7a, 0xef, 0x88, 0x0e, 0xf9, 0x5e, 0x80, 0xb9, 0xf0, 0x84, 0x1e, 0x72, 0xe6, 0x38, 0x03, 0x7f,
-	0xc8, 0x99, 0xe3, 0x8d, 0x7b, 0x69, 0x8b, 0x53, 0xea, 0x91, 0x8d, 0xc1, 0xde, 0x01, 0xe4, 0x17,
-	0x01, 0x12, 0xbc, 0xa1, 0x4c, 0xf8, 0xbb, 0x3d, 0xe2, 0x61, 0x20, 0x66, 0xcf, 0x91, 0x81, 0xaa,
-	0x6f, 0x0d, 0x53, 0x3d, 0xac, 0xc6, 0x4c, 0xb5, 0x7f, 0x6b, 0xb0, 0x81, 0xdb, 0x21, 0xbf, 0x06,
-	0x2e, 0xfa, 0x66, 0xf4, 0x68, 0x17, 0xbc, 0x97, 0xc2, 0x68, 0x17, 0xdc, 0x07, 0x80, 0x74, 0x7b,
-	0x98, 0x0b, 0x99, 0x5c, 0x8b, 0xe4, 0x82, 0x3d, 0x49, 0x3a, 0xe4, 0x47, 0x01, 0x66, 0x43, 0x93,
-	0x8a, 0x5c, 0x1b, 0x7b, 0x8d, 0x85, 0x86, 0xa6, 0x98, 0x89, 0x18, 0x8d, 0xaa, 0xdf, 0x1f, 0x54,
-	0xbd, 0x3d, 0xbe, 0xc9, 0xfd, 0xcb, 0xcd, 0xaa, 0xda, 0xc1, 0xe5, 0xad, 0xbe, 0xf7, 0xf4, 0x24,
-	0x29, 0x3c, 0x3b, 0x49, 0x0a, 0x7f, 0x9f, 0x24, 0x85, 0x6f, 0x4e, 0x93, 0xb1, 0x67, 0xa7, 0xc9,
-	0xd8, 0x1f, 0xa7, 0xc9, 0xd8, 0x83, 0xf5, 0x9a, 0xe9, 0xee, 0xb7, 0x4a, 0x72, 0xd9, 0xae, 0x7b,
-	0x80, 0x01, 0xa9, 0xf2, 0x98, 0xa1, 0xbb, 0xed, 0x86, 0xe1, 0x94, 0xa6, 0xe8, 0xab, 0x79, 0xf3,
-	0x79, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x3c, 0x5a, 0xe7, 0xe5, 0x0f, 0x00, 0x00,
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0xa6, 0xf9, 0xb5, 0xe9, 0x6b, 0xda, 0xaa, 0x63, 0x57, 0xbf, 0x74, 0xd3, 0xda, 0xd6,
+	0x16, 0xda, 0x24, 0xd4, 0xbb, 0xb5, 0xe3, 0x24, 0x10, 0x89, 0x43, 0x4c, 0x95, 0x10, 0xb5, 0x54,
+	0xee, 0x26, 0x82, 0xd2, 0x8b, 0xb5, 0xb6, 0x37, 0xce, 0xaa, 0xf1, 0xae, 0xeb, 0x5d, 0x43, 0x8d,
+	0x65, 0x21, 0x21, 0x21, 0x7a, 0xe0, 0x80, 0x04, 0x37, 0x2e, 0xf9, 0x03, 0x38, 0x70, 0x88, 0x84,
+	0x90, 0x38, 0x20, 0x85, 0x43, 0xc9, 0xa9, 0x0a, 0x17, 0xc4, 0x01, 0xa1, 0x04, 0x09, 0xfe, 0x0c,
+	0xe4, 0x99, 0xb7, 0xde, 0xdd, 0x78, 0x6c, 0x6f, 0x1a, 0xb8, 0xd9, 0x33, 0xef, 0x7d, 0xef, 0xfb,
+	0xde, 0x3c, 0xcf, 0x7c, 0x09, 0x24, 0x4a, 0x96, 0x5d, 0xb5, 0x6c, 0x45, 0x6b, 0x38, 0x9b, 0xca,
+	0x07, 0xe9, 0xa2, 0xee, 0x68, 0x69, 0xe5, 0x49, 0x43, 0xaf, 0x37, 0xe5, 0x5a, 0xdd, 0x72, 0x2c,
+	0x12, 0x65, 0x01, 0x72, 0x27, 0x40, 0xc6, 0x00, 0x71, 0x06, 0xb3, 0x8a, 0x9a, 0xad, 0xb3, 0xe8,
+	0x6e, 0x6e, 0x4d, 0xab, 0x18, 0xa6, 0xe6, 0x18, 0x96, 0xc9, 0x00, 0xc4, 0x58, 0xc5, 0xaa, 0x58,
+	0xf4, 0xa3, 0xd2, 0xf9, 0x84, 0xab, 0x57, 0x2a, 0x96, 0x55, 0xd9, 0xd2, 0x15, 0xfa, 0xad, 0xd8,
+	0xd8, 0x50, 0x34, 0x13, 0x2b, 0x8a, 0x57, 0x71, 0x4b, 0xab, 0x19, 0x8a, 0x66, 0x9a, 0x96, 0x43,
+	0xd1, 0x6c, 0xdc, 0x8d, 0xf3, 0x08, 0x53, 0x72, 0x6c, 0x7f, 0x86, 0xb7, 0x5f, 0x6b, 0x14, 0x0b,
+	0x8f, 0xf5, 0x66, 0xa1, 0x8e, 0x60, 0x2e, 0x09, 0x16, 0x5b, 0x60, 0xec, 0x50, 0x28, 0xdb, 0x9a,
+	0x44, 0x18, 0x57, 0x9c, 0xbf, 0x27, 0x92, 0x0d, 0xb1, 0x07, 0x9d, 0xaf, 0x4b, 0xa5, 0x92, 0xd5,
+	0x30, 0x1d, 0x5b, 0xd5, 0x9f, 0x34, 0x74, 0xdb, 0x21, 0xcb, 0x00, 0x9e, 0xfc, 0x09, 0x21, 0x29,
+	0x4c, 0x9d, 0xcb, 0xdc, 0x90, 0x11, 0xb7, 0xd3, 0x2b, 0x99, 0xa1, 0x20, 0x2d, 0x39, 0xaf, 0x55,
+	0x74, 0xcc, 0x55, 0x7d, 0x99, 0x8b, 0xd1, 0xfd, 0x9d, 0xd4, 0x45, 0x96, 0x96, 0xb2, 0xcb, 0x8f,
+	0x93, 0xb7, 0xe5, 0xec, 0xac, 0xf4, 0xb3, 0x00, 0x97, 0x8f, 0x54, 0xb5, 0x6b, 0x96, 0x69, 0xeb,
+	0x44, 0x85, 0x31, 0x0d, 0xd7, 0x26, 0x84, 0xe4, 0xa9, 0xa9, 0x73, 0x99, 0x98, 0xcc, 0x7a, 0x28,
+	0xbb, 0xed, 0x95, 0x97, 0xcc, 0x66, 0x2e, 0xb9, 0xb7, 0x93, 0xba, 0xca, 0x39, 0x4e, 0x19, 0x11,
+	0x57, 0xd5, 0x2e, 0x0e, 0x59, 0x09, 0x48, 0x19, 0xa1, 0x52, 0x6e, 0x0e, 0x95, 0xc2, 0x08, 0x0d,
+	0xd7, 0xb2, 0x06, 0x51, 0xbf, 0x14, 0xb7, 0x7f, 0x19, 0x38, 0xa3, 0x95, 0xcb, 0x75, 0xdd, 0xb6,
+	0x69, 0xf3, 0xce, 0xe6, 0x26, 0xf6, 0x77, 0x52, 0x31, 0x2c, 0xba, 0xc4, 0x76, 0xd6, 0x9c, 0xba,
+	0x61, 0x56, 0x54, 0x37, 0x70, 0x71, 0xec, 0xd9, 0x76, 0x22, 0xf2, 0xf7, 0x76, 0x22, 0x22, 0x6d,
+	0x06, 0x4f, 0xa5, 0xdb, 0x9e, 0x3c, 0x9c, 0x41, 0x59, 0x78, 0x24, 0x2f, 0xdb, 0x1d, 0x17, 0x46,
+	0x8a, 0x01, 0xa1, 0x95, 0xf2, 0x5a, 0x5d, 0xab, 0xba, 0xa7, 0x2f, 0xe5, 0x21, 0x1a, 0x58, 0xc5,
+	0xf2, 0x6f, 0xc0, 0xe9, 0x1a, 0x5d, 0xc1, 0xea, 0x93, 0x32, 0xaf, 0x08, 0x4b, 0xca, 0x8d, 0x3e,
+	0xff, 0x3d, 0x11, 0x51, 0x31, 0x41, 0x4a, 0x83, 0x48, 0x11, 0xdf, 0xb1, 0xca, 0x8d, 0x2d, 0xfd,
+	0xc8, 0xb4, 0xf1, 0x3a, 0x3b, 0x2f, 0x7d, 0x2e, 0xc0, 0x24, 0x37, 0x07, 0xd9, 0x3c, 0x0c, 0x39,
+	0x2b, 0x37, 0xf6, 0x76, 0x52, 0x12, 0x8f, 0x68, 0x00, 0xd7, 0x37, 0x31, 0x7c, 0x3a, 0x73, 0x90,
+	0xe8, 0x65, 0x93, 0x6b, 0xde, 0xd7, 0xaa, 0xee, 0xe0, 0x13, 0x02, 0xa3, 0xa6, 0x56, 0xd5, 0xd9,
+	0x89, 0xab, 0xf4, 0xb3, 0xf4, 0x11, 0x24, 0xfb, 0xa7, 0xa1, 0x92, 0x77, 0xc3, 0x1d, 0x6b, 0x58,
+	0x21, 0xdd, 0xc3, 0x9d, 0x81, 0x68, 0x4e, 0x2f, 0x6d, 0xce, 0x66, 0xf2, 0x75, 0x7d, 0xc3, 0x78,
+	0x3a, 0xb0, 0xdb, 0x79, 0x88, 0x05, 0x63, 0x91, 0xdb, 0x75, 0x38, 0x5f, 0xa4, 0xeb, 0x85, 0x1a,
+	0xdd, 0x40, 0x71, 0xe3, 0x45, 0x5f, 0x30, 0x1f, 0xf1, 0x3d, 0x98, 0xc4, 0x41, 0xcf, 0x35, 0x1d,
+	0xdd, 0x5e, 0xb7, 0x70, 0xde, 0xb1, 0x59, 0xd7, 0xe1, 0x3c, 0x0e, 0x7e, 0xa1, 0xd8, 0xd9, 0xa7,
+	0xc0, 0xe3, 0xea, 0xb8, 0xe6, 0xcb, 0xe1, 0x03, 0x3f, 0x82, 0xab, 0x7c, 0x60, 0xa4, 0xfc, 0x2a,
+	0x5c, 0x70, 0x91, 0x6d, 0xba, 0x83, 0x9c, 0xdd, 0x7a, 0x2c, 0x9c, 0x8f, 0xfd, 0x7e, 0x97, 0x34,
+	0x8b, 0x5a, 0xb7, 0x68, 0x0d, 0x97, 0xf4, 0x49, 0xa0, 0x1f, 0x76, 0x69, 0x1f, 0x81, 0xf6, 0x3a,
+	0xfd, 0x92, 0x0d, 0xf9, 0x18, 0xe2, 0xfe, 0xeb, 0xa2, 0xdb, 0x9c, 0xd5, 0x3b, 0xde, 0x64, 0x8e,
+	0x18, 0x65, 0x0a, 0x78, 0x2a, 0x37, 0x32, 0x21, 0xa8, 0x23, 0x46, 0x99, 0x64, 0x00, 0x70, 0x50,
+	0x0a, 0x46, 0x99, 0xde, 0x8b, 0xa3, 0xb9, 0xe8, 0x6f, 0x3d, 0xf0, 0x0b, 0xea, 0x59, 0x0c, 0x5b,
+	0x2d, 0x2f, 0x5e, 0xde, 0xdf, 0x49, 0x5d, 0x3a, 0x52, 0x5e, 0xce, 0x48, 0x2d, 0x48, 0xf4, 0x25,
+	0x80, 0xea, 0x96, 0xe0, 0xa2, 0x5b, 0x2d, 0xec, 0xc5, 0x78, 0x41, 0x0b, 0xc0, 0xf5, 0x2b, 0x5e,
+	0x84, 0xff, 0xfb, 0x8b, 0xaf, 0x9a, 0x1b, 0xd6, 0x49, 0x6e, 0x61, 0x4e, 0x87, 0x17, 0x24, 0x1d,
+	0x26, 0x7a, 0x6b, 0xa0, 0xb2, 0x2c, 0x8c, 0x1a, 0xe6, 0x86, 0x85, 0x3f, 0xdd, 0x24, 0xf7, 0x4e,
+	0xcc, 0x69, 0xb6, 0xfb, 0xfb, 0x54, 0x69, 0x34, 0xbf, 0xcc, 0x87, 0x58, 0x66, 0xad, 0xc3, 0xdf,
+	0x2c, 0xe9, 0xf7, 0x34, 0x53, 0x3f, 0x81, 0x96, 0xce, 0x85, 0xb4, 0xa5, 0x99, 0x3a, 0x3b, 0x5c,
+	0x95, 0x7e, 0xe6, 0x14, 0x9e, 0xcb, 0x4a, 0xf7, 0xe0, 0x0a, 0xa7, 0x30, 0x0a, 0x14, 0x61, 0xcc,
+	0xc6, 0x75, 0x5a, 0x7a, 0x54, 0xed, 0x7e, 0xe7, 0xa3, 0x7d, 0x23, 0x20, 0x5c, 0xbe, 0x51, 0xbc,
+	0xab, 0x37, 0xdf, 0x36, 0x6c, 0xc7, 0xaa, 0x37, 0x4f, 0x22, 0x64, 0x99, 0xf3, 0x86, 0xff, 0x3b,
+	0x76, 0x64, 0x2e, 0x2b, 0xed, 0x0a, 0x20, 0xf2, 0xe8, 0xa2, 0xfc, 0x15, 0x38, 0xeb, 0x9a, 0x2d,
+	0xf7, 0xa1, 0xb9, 0xce, 0x7f, 0xf8, 0x68, 0xba, 0x8a, 0xb1, 0xf8, 0x00, 0x7a, 0xb9, 0xff, 0xa5,
+	0x11, 0x99, 0xcb, 0x66, 0x3e, 0xbb, 0x04, 0xff, 0xa3, 0x2a, 0xc8, 0xb6, 0x00, 0x63, 0xee, 0x6b,
+	0x49, 0xa6, 0xb9, 0x54, 0x79, 0x9e, 0x4f, 0x9c, 0x09, 0x13, 0xca, 0xe8, 0x48, 0x6f, 0xee, 0xf5,
+	0x7a, 0xa1, 0x67, 0x7f, 0x7d, 0x3b, 0x23, 0x7c, 0xf2, 0xcb, 0x9f, 0x5f, 0x8e, 0x24, 0xc8, 0x35,
+	0x85, 0x6b, 0x6e, 0x5d, 0x56, 0x5f, 0x09, 0x70, 0x06, 0x31, 0xc9, 0xd4, 0xd0, 0xb2, 0x2e, 0xc1,
+	0xe9, 0x10, 0x91, 0xc8, 0x2f, 0xeb, 0x91, 0x99, 0x26, 0x37, 0x07, 0x92, 0x51, 0x5a, 0x38, 0x65,
+	0x6d, 0xb2, 0x2f, 0x00, 0xe9, 0xbd, 0xc3, 0xc8, 0xec, 0xd0, 0xba, 0xbd, 0x57, 0xae, 0x98, 0x3d,
+	0x5e, 0x12, 0xf2, 0x7e, 0xb0, 0xc7, 0xbb, 0xe3, 0x3c, 0x31, 0x69, 0xa2, 0xf0, 0xc5, 0x74, 0x5f,
+	0x8e, 0x82, 0x51, 0x56, 0x5a, 0xde, 0xc5, 0xde, 0x26, 0x9f, 0x0a, 0x70, 0x9a, 0x79, 0x32, 0x72,
+	0xb3, 0x3f, 0xa7, 0x80, 0x01, 0x14, 0xa7, 0x86, 0x07, 0x22, 0xe1, 0x29, 0x8f, 0xdb, 0x35, 0x32,
+	0xc9, 0xe5, 0xc6, 0x2c, 0x20, 0xf9, 0x5e, 0x80, 0x0b, 0x41, 0x2b, 0x47, 0x94, 0xfe, 0x65, 0xb8,
+	0x46, 0x51, 0xbc, 0x1d, 0x3e, 0x01, 0xf9, 0xdd, 0xe9, 0x1d, 0xd4, 0x79, 0x8f, 0xf2, 0x0d, 0xf2,
+	0x0a, 0x97, 0x72, 0x95, 0x82, 0x15, 0xba, 0xf3, 0xfa, 0x83, 0x00, 0x51, 0x8e, 0x83, 0x23, 0xd9,
+	0x90, 0x7c, 0x02, 0x3e, 0x51, 0x9c, 0x3b, 0x66, 0x16, 0x4a, 0x79, 0xdd, 0xe3, 0x9d, 0x22, 0xaf,
+	0x85, 0xe1, 0xad, 0xb4, 0x3a, 0x1e, 0xb4, 0x4d, 0xbe, 0x16, 0x60, 0xdc, 0xef, 0xee, 0xfa, 0xfc,
+	0xe6, 0x38, 0x66, 0x51, 0x9c, 0x0e, 0x11, 0x89, 0xfc, 0xe6, 0x39, 0xad, 0x1e, 0x38, 0x18, 0xcc,
+	0x43, 0x92, 0x5d, 0x01, 0x62, 0x3c, 0x43, 0x47, 0xf8, 0xa7, 0x3d, 0xc0, 0x54, 0x8a, 0xe9, 0x63,
+	0x64, 0x20, 0xeb, 0xe5, 0x7e, 0xac, 0xfb, 0xf5, 0x98, 0xb1, 0x56, 0x5a, 0x01, 0xb3, 0xd6, 0x26,
+	0x3f, 0x79, 0x2a, 0x02, 0xfe, 0x6e, 0xb0, 0x0a, 0x9e, 0xcb, 0x14, 0xd3, 0xc7, 0xc8, 0x40, 0x15,
+	0x2b, 0xfd, 0x54, 0xc8, 0xe4, 0x56, 0x28, 0x15, 0xcc, 0xce, 0xb6, 0xc9, 0x77, 0x02, 0x9c, 0xf3,
+	0xb9, 0x1c, 0x72, 0x6b, 0xe8, 0x35, 0xe6, 0x33, 0x5c, 0x62, 0x2a, 0x64, 0x34, 0xb2, 0xbe, 0xdb,
+	0xcb, 0x7a, 0x61, 0xf8, 0x90, 0x77, 0x2f, 0x37, 0x73, 0xc3, 0xf2, 0x5d, 0xde, 0xbb, 0x02, 0x8c,
+	0xfb, 0xfd, 0x0b, 0x19, 0x40, 0x86, 0x63, 0xb0, 0x44, 0x39, 0x6c, 0x38, 0x92, 0x5f, 0xdf, 0xeb,
+	0x7d, 0x85, 0x3d, 0xf2, 0xf3, 0x24, 0xcb, 0x25, 0xef, 0x3a, 0xa7, 0x42, 0xc7, 0x8c, 0xf9, 0xde,
+	0x1e, 0xa5, 0xd5, 0x59, 0x68, 0x93, 0x1f, 0x05, 0x38, 0x1f, 0xf0, 0x21, 0x64, 0x00, 0x2f, 0x9e,
+	0xbf, 0x12, 0x95, 0xd0, 0xf1, 0x28, 0xe4, 0xfe, 0x40, 0x21, 0xb7, 0x89, 0xac, 0x0c, 0xfa, 0x47,
+	0xd4, 0x26, 0x43, 0xf3, 0x94, 0xe4, 0xde, 0x7a, 0x7e, 0x10, 0x17, 0x5e, 0x1c, 0xc4, 0x85, 0x3f,
+	0x0e, 0xe2, 0xc2, 0x17, 0x87, 0xf1, 0xc8, 0x8b, 0xc3, 0x78, 0xe4, 0xd7, 0xc3, 0x78, 0xe4, 0xd1,
+	0x74, 0xc5, 0x70, 0x36, 0x1b, 0x45, 0xb9, 0x64, 0x55, 0x5d, 0x4c, 0xaf, 0xae, 0xf2, 0x94, 0x15,
+	0x70, 0x9a, 0x35, 0xdd, 0x2e, 0x9e, 0xa6, 0x7f, 0xfa, 0xce, 0xfe, 0x33, 0x00, 0x7f, 0x7a, 0xc0,
+	0x14, 0xd6, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1068,6 +1296,10 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// SequenceLane queries the sequence of a sequence lane of an account.
+	SequenceLane(ctx context.Context, in *QuerySequenceLaneRequest, opts ...grpc.CallOption) (*QuerySequenceLaneResponse, error)
+	// PubKeyHistory queries the public key rotations of an account, oldest first.
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1168,6 +1400,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) SequenceLane(ctx context.Context, in *QuerySequenceLaneRequest, opts ...grpc.CallOption) (*QuerySequenceLaneResponse, error) {
+	out := new(QuerySequenceLaneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/SequenceLane", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1193,6 +1443,10 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// SequenceLane queries the sequence of a sequence lane of an account.
+	SequenceLane(context.Context, *QuerySequenceLaneRequest) (*QuerySequenceLaneResponse, error)
+	// PubKeyHistory queries the public key rotations of an account, oldest first.
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1229,6 +1483,12 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) SequenceLane(ctx context.Context, req *QuerySequenceLaneRequest) (*QuerySequenceLaneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceLane not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1414,6 +1674,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SequenceLane_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySequenceLaneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SequenceLane(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/SequenceLane",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SequenceLane(ctx, req.(*QuerySequenceLaneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
@@ -1459,6 +1755,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "SequenceLane",
+			Handler:    _Query_SequenceLane_Handler,
+		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2095,67 +2399,221 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySequenceLaneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QuerySequenceLaneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySequenceLaneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Lane != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySequenceLaneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySequenceLaneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySequenceLaneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Account != nil {
 		l = m.Account.Size()
@@ -2362,6 +2820,70 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySequenceLaneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Lane != 0 {
+		n += 1 + sovQuery(uint64(m.Lane))
+	}
+	return n
+}
+
+func (m *QuerySequenceLaneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3979,6 +4501,414 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySequenceLaneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceLaneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceLaneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequenceLaneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceLaneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceLaneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
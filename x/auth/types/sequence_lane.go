@@ -0,0 +1,68 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SequenceLaneFromTx returns the sequence lane selected by the transaction, in either the
+// critical or the non-critical extension options, or 0 if none is selected. It returns an
+// error if more than one is selected.
+func SequenceLaneFromTx(tx sdk.Tx) (uint64, error) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+		GetNonCriticalExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return 0, nil
+	}
+
+	var sequenceLane *ExtensionOptionSequenceLane
+	for _, opts := range [][]*codectypes.Any{extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()} {
+		for _, opt := range opts {
+			selected, ok := opt.GetCachedValue().(*ExtensionOptionSequenceLane)
+			if !ok {
+				continue
+			}
+			if sequenceLane != nil {
+				return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multiple sequence lanes selected")
+			}
+			sequenceLane = selected
+		}
+	}
+
+	if sequenceLane == nil {
+		return 0, nil
+	}
+	return sequenceLane.Lane, nil
+}
+
+// ValidateSequenceLanes validates the sequence lanes of the genesis state, the lane 0 is
+// stored in the account and can't be part of them.
+func ValidateSequenceLanes(sequenceLanes []SequenceLane) error {
+	type laneKey struct {
+		address string
+		lane    uint64
+	}
+
+	seen := make(map[laneKey]struct{}, len(sequenceLanes))
+	for _, sl := range sequenceLanes {
+		if _, err := sdk.AccAddressFromBech32(sl.Address); err != nil {
+			return errorsmod.Wrapf(err, "invalid sequence lane address: %s", sl.Address)
+		}
+		if sl.Lane == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence lane 0 of %s is the account sequence", sl.Address)
+		}
+
+		key := laneKey{address: sl.Address, lane: sl.Lane}
+		if _, ok := seen[key]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate sequence lane %d of %s", sl.Lane, sl.Address)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
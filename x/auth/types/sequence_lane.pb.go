@@ -0,0 +1,557 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/sequence_lane.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionSequenceLane is a tx extension option selecting the sequence
+// lane of the signers. Each lane of an account has its own sequence, so the
+// transactions of different lanes don't have to be ordered between them. The
+// lane 0 is the sequence of the account.
+//
+// It's recommended to set it as a critical extension option, so chains that
+// don't support sequence lanes reject the transaction.
+type ExtensionOptionSequenceLane struct {
+	Lane uint64 `protobuf:"varint,1,opt,name=lane,proto3" json:"lane,omitempty"`
+}
+
+func (m *ExtensionOptionSequenceLane) Reset()         { *m = ExtensionOptionSequenceLane{} }
+func (m *ExtensionOptionSequenceLane) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionSequenceLane) ProtoMessage()    {}
+func (*ExtensionOptionSequenceLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b57f96ba61a00e5, []int{0}
+}
+func (m *ExtensionOptionSequenceLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionSequenceLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionSequenceLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionSequenceLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionSequenceLane.Merge(m, src)
+}
+func (m *ExtensionOptionSequenceLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionSequenceLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionSequenceLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionSequenceLane proto.InternalMessageInfo
+
+func (m *ExtensionOptionSequenceLane) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+// SequenceLane is the sequence of a sequence lane of an account.
+type SequenceLane struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Lane     uint64 `protobuf:"varint,2,opt,name=lane,proto3" json:"lane,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *SequenceLane) Reset()         { *m = SequenceLane{} }
+func (m *SequenceLane) String() string { return proto.CompactTextString(m) }
+func (*SequenceLane) ProtoMessage()    {}
+func (*SequenceLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b57f96ba61a00e5, []int{1}
+}
+func (m *SequenceLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceLane.Merge(m, src)
+}
+func (m *SequenceLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceLane proto.InternalMessageInfo
+
+func (m *SequenceLane) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SequenceLane) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+func (m *SequenceLane) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ExtensionOptionSequenceLane)(nil), "cosmos.auth.v1beta1.ExtensionOptionSequenceLane")
+	proto.RegisterType((*SequenceLane)(nil), "cosmos.auth.v1beta1.SequenceLane")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/sequence_lane.proto", fileDescriptor_5b57f96ba61a00e5)
+}
+
+var fileDescriptor_5b57f96ba61a00e5 = []byte{
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
+	0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x8d, 0xcf, 0x49, 0xcc, 0x4b, 0xd5, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd4, 0x03, 0x29, 0xd4, 0x83, 0x2a, 0x94, 0x92, 0x84,
+	0x08, 0xc6, 0x83, 0x95, 0xe8, 0x43, 0x55, 0x80, 0x39, 0x4a, 0x79, 0x5c, 0xd2, 0xae, 0x15, 0x25,
+	0xa9, 0x79, 0xc5, 0x99, 0xf9, 0x79, 0xfe, 0x05, 0x25, 0x99, 0xf9, 0x79, 0xc1, 0x50, 0x53, 0x7d,
+	0x12, 0xf3, 0x52, 0x85, 0x84, 0xb8, 0x58, 0x40, 0x86, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04,
+	0x81, 0xd9, 0x56, 0xd6, 0xa7, 0xb6, 0xe8, 0xaa, 0x40, 0x0d, 0x29, 0xa9, 0x80, 0x59, 0xa2, 0x17,
+	0x52, 0x81, 0x66, 0x90, 0xe7, 0xa5, 0x2d, 0xba, 0xfc, 0x10, 0x75, 0xba, 0xc5, 0x29, 0xd9, 0x0a,
+	0x06, 0x7a, 0xa6, 0x26, 0x4a, 0xed, 0x8c, 0x5c, 0x3c, 0x28, 0x36, 0x18, 0x71, 0xb1, 0x27, 0xa6,
+	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x83, 0x2d, 0xe1, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a,
+	0xbc, 0x23, 0x44, 0x26, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa6, 0x10, 0xee, 0x2a, 0x26,
+	0x84, 0xab, 0x84, 0xa4, 0xb8, 0x38, 0x60, 0xe1, 0x21, 0xc1, 0x0c, 0x16, 0x87, 0xf3, 0xad, 0x84,
+	0xb1, 0xb8, 0xc4, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
+	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34,
+	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0x81, 0xa5, 0x8f, 0xd0, 0xac,
+	0x5f, 0x01, 0x89, 0x84, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x28, 0x1a, 0x03, 0x06,
+	0x00, 0xed, 0xf7, 0x1f, 0x10, 0xa0, 0x01, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionSequenceLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionSequenceLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionSequenceLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lane != 0 {
+		i = encodeVarintSequenceLane(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SequenceLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintSequenceLane(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Lane != 0 {
+		i = encodeVarintSequenceLane(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSequenceLane(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSequenceLane(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSequenceLane(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionSequenceLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lane != 0 {
+		n += 1 + sovSequenceLane(uint64(m.Lane))
+	}
+	return n
+}
+
+func (m *SequenceLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSequenceLane(uint64(l))
+	}
+	if m.Lane != 0 {
+		n += 1 + sovSequenceLane(uint64(m.Lane))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSequenceLane(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovSequenceLane(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSequenceLane(x uint64) (n int) {
+	return sovSequenceLane(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionSequenceLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequenceLane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionSequenceLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionSequenceLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequenceLane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequenceLane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequenceLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequenceLane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequenceLane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequenceLane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequenceLane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequenceLane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSequenceLane(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSequenceLane
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSequenceLane
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSequenceLane
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSequenceLane
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSequenceLane
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSequenceLane        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSequenceLane          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSequenceLane = fmt.Errorf("proto: unexpected end of group")
+)
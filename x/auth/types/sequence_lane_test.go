@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSequenceLaneFromTx(t *testing.T) {
+	any, err := codectypes.NewAnyWithValue(&types.ExtensionOptionSequenceLane{Lane: 3})
+	require.NoError(t, err)
+
+	lane, err := types.SequenceLaneFromTx(mockExtTx{critical: []*codectypes.Any{any}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), lane)
+
+	lane, err = types.SequenceLaneFromTx(mockExtTx{})
+	require.NoError(t, err)
+	require.Zero(t, lane)
+
+	_, err = types.SequenceLaneFromTx(mockExtTx{critical: []*codectypes.Any{any}, nonCritical: []*codectypes.Any{any}})
+	require.ErrorContains(t, err, "multiple sequence lanes selected")
+}
+
+func TestValidateSequenceLanes(t *testing.T) {
+	addr := sdk.AccAddress(addr1).String()
+
+	require.NoError(t, types.ValidateSequenceLanes([]types.SequenceLane{
+		{Address: addr, Lane: 1, Sequence: 4},
+		{Address: addr, Lane: 2, Sequence: 0},
+		{Address: sdk.AccAddress(addr2).String(), Lane: 1, Sequence: 1},
+	}))
+	require.ErrorContains(t, types.ValidateSequenceLanes([]types.SequenceLane{
+		{Address: addr, Lane: 1},
+		{Address: addr, Lane: 1},
+	}), "duplicate sequence lane")
+	require.ErrorContains(t, types.ValidateSequenceLanes([]types.SequenceLane{{Address: addr}}), "is the account sequence")
+	require.Error(t, types.ValidateSequenceLanes([]types.SequenceLane{{Address: "invalid", Lane: 1}}))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/accumulator"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -72,8 +73,13 @@ type BaseKeeper struct {
 	logger                 log.Logger
 }
 
+// WithObjStoreKey enables the virtual accounts, which accumulate the coins sent to them in
+// the object store and credit the sums at the end of block, see `CreditVirtualAccounts`.
 func (k BaseKeeper) WithObjStoreKey(okey storetypes.StoreKey) BaseKeeper {
 	k.objStoreKey = okey
+	k.virtualBalances = accumulator.New(okey, types.VirtualBalancesPrefix, sdk.AccAddressKey, func(a, b sdk.Coins) sdk.Coins {
+		return a.Add(b...)
+	})
 	return k
 }
 
@@ -14,6 +14,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/accumulator"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -63,6 +64,9 @@ type BaseSendKeeper struct {
 	logger       log.Logger
 	objStoreKey  storetypes.StoreKey
 
+	// per-transaction coins of the virtual accounts, set together with objStoreKey
+	virtualBalances accumulator.Accumulator[sdk.AccAddress, sdk.Coins]
+
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
 
@@ -1,11 +1,7 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
-	"encoding/hex"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -56,7 +52,9 @@ func (k BaseSendKeeper) SendCoinsToVirtual(ctx context.Context, fromAddr, toAddr
 		return err
 	}
 
-	k.addVirtualCoins(ctx, toAddr, amt)
+	if err := k.addVirtualCoins(ctx, toAddr, amt); err != nil {
+		return err
+	}
 	if err := k.emitSendCoinsEvents(ctx, fromAddr, toAddr, amt); err != nil {
 		return err
 	}
@@ -88,41 +86,22 @@ func (k BaseSendKeeper) SendCoinsFromVirtual(ctx context.Context, fromAddr, toAd
 	return nil
 }
 
-func (k BaseSendKeeper) addVirtualCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	store := sdkCtx.ObjectStore(k.objStoreKey)
-
-	// bytes containing account address followed by the txn index
-	key := make([]byte, len(addr)+8)
-	copy(key, addr)
-	binary.BigEndian.PutUint64(key[len(addr):], uint64(sdkCtx.TxIndex()))
-
-	var coins sdk.Coins
-	value := store.Get(key)
-	if value != nil {
-		coins = value.(sdk.Coins)
-	}
-	coins = coins.Add(amt...)
-	store.Set(key, coins)
+func (k BaseSendKeeper) addVirtualCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return k.virtualBalances.Add(ctx, addr, amt)
 }
 
 func (k BaseSendKeeper) subVirtualCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	store := sdkCtx.ObjectStore(k.objStoreKey)
-
-	key := make([]byte, len(addr)+8)
-	copy(key, addr)
-	binary.BigEndian.PutUint64(key[len(addr):], uint64(sdkCtx.TxIndex()))
-
-	value := store.Get(key)
-	if value == nil {
+	spendable, found, err := k.virtualBalances.Pending(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !found {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrInsufficientFunds,
 			"spendable balance 0 is smaller than %s",
 			amt,
 		)
 	}
-	spendable := value.(sdk.Coins)
 	balance, hasNeg := spendable.SafeSub(amt...)
 	if hasNeg {
 		return errorsmod.Wrapf(
@@ -132,12 +111,9 @@ func (k BaseSendKeeper) subVirtualCoins(ctx context.Context, addr sdk.AccAddress
 		)
 	}
 	if balance.IsZero() {
-		store.Delete(key)
-	} else {
-		store.Set(key, balance)
+		return k.virtualBalances.RemovePending(ctx, addr)
 	}
-
-	return nil
+	return k.virtualBalances.SetPending(ctx, addr, balance)
 }
 
 // CreditVirtualAccounts sum up the transient coins and add them to the real account,
@@ -147,42 +123,17 @@ func (k BaseSendKeeper) CreditVirtualAccounts(ctx context.Context) error {
 	if k.objStoreKey == nil {
 		return nil
 	}
-	store := sdk.UnwrapSDKContext(ctx).ObjectStore(k.objStoreKey)
 
-	var toAddr sdk.AccAddress
-	sum := sdk.NewMapCoins(nil)
-	flushCurrentAddr := func() error {
-		if len(sum) == 0 {
-			// nothing to flush
+	return k.virtualBalances.Flush(ctx, func(addr sdk.AccAddress, sum sdk.Coins) error {
+		if sum.IsZero() {
 			return nil
 		}
 
-		if err := k.addCoins(ctx, toAddr, sum.ToCoins()); err != nil {
+		if err := k.addCoins(ctx, addr, sum); err != nil {
 			return err
 		}
-		clear(sum)
 
-		k.ensureAccountCreated(ctx, toAddr)
+		k.ensureAccountCreated(ctx, addr)
 		return nil
-	}
-
-	it := store.Iterator(nil, nil)
-	defer it.Close()
-	for ; it.Valid(); it.Next() {
-		if len(it.Key()) <= 8 {
-			return fmt.Errorf("unexpected key length: %s", hex.EncodeToString(it.Key()))
-		}
-
-		addr := it.Key()[:len(it.Key())-8]
-		if !bytes.Equal(toAddr, addr) {
-			if err := flushCurrentAddr(); err != nil {
-				return err
-			}
-			toAddr = addr
-		}
-
-		sum.Add(it.Value().(sdk.Coins)...)
-	}
-
-	return flushCurrentAddr()
+	})
 }
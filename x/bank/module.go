@@ -12,11 +12,13 @@ import (
 	"github.com/spf13/cobra"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log/v2"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -44,6 +46,7 @@ var (
 
 	_ appmodule.AppModule     = AppModule{}
 	_ appmodule.HasEndBlocker = AppModule{}
+	_ schema.HasModuleCodec   = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -144,6 +147,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// ModuleCodec implements schema.HasModuleCodec, it decodes the state of the module for the indexer.
+func (am AppModule) ModuleCodec() (schema.ModuleCodec, error) {
+	k, ok := am.keeper.(keeper.BaseKeeper)
+	if !ok {
+		return schema.ModuleCodec{}, fmt.Errorf("cannot decode the state of the bank keeper %T", am.keeper)
+	}
+	return k.Schema.ModuleCodec(collections.IndexingOptions{})
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -162,6 +174,11 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.(keeper.BaseKeeper).Schema)
 }
 
+// CollectionsSchema returns the collections schema of the bank module.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.(keeper.BaseKeeper).Schema
+}
+
 // WeightedOperations returns the all the bank module operations with their respective weights.
 // migrate to WeightedOperationsX. This method is ignored when WeightedOperationsX exists and will be removed in the future
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
@@ -37,13 +37,32 @@ var (
 	ParamsKey = collections.NewPrefix(5)
 )
 
+// Object store keys
+var (
+	// VirtualBalancesPrefix is the prefix of the per-transaction virtual balances accumulated
+	// in the object store, block-stm can treat it as commutative.
+	VirtualBalancesPrefix = collections.NewPrefix(0)
+)
+
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
 // Historically, balances were represented as Coin, now they're represented as a simple math.Int
-var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []byte) (math.Int, error) {
-	c := new(sdk.Coin)
-	err := c.Unmarshal(bytes)
-	if err != nil {
-		return math.Int{}, err
-	}
-	return c.Amount, nil
-})
+var BalanceValueCodec collcodec.ValueCodec[math.Int] = balanceValueCodec{
+	ValueCodec: collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []byte) (math.Int, error) {
+		c := new(sdk.Coin)
+		err := c.Unmarshal(bytes)
+		if err != nil {
+			return math.Int{}, err
+		}
+		return c.Amount, nil
+	}),
+}
+
+// balanceValueCodec indexes the balances like sdk.IntValue.
+type balanceValueCodec struct {
+	collcodec.ValueCodec[math.Int]
+}
+
+// SchemaCodec implements collcodec.HasSchemaCodec.
+func (balanceValueCodec) SchemaCodec() (collcodec.SchemaCodec[math.Int], error) {
+	return sdk.IntValue.(collcodec.HasSchemaCodec[math.Int]).SchemaCodec()
+}
@@ -0,0 +1,29 @@
+package feemarket
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker collects the base fee portion of the fees paid in the block and
+// adjusts the base fees for the next block given the gas of the block.
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker) //nolint:staticcheck // TODO: switch to OpenTelemetry
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CollectBaseFees(ctx, params); err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+	return k.UpdateBaseFees(ctx, params)
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// GetBaseFees returns the base fee of every denom accepted to pay the fees, per
+// unit of gas, or nil if the base fee is disabled.
+func (k Keeper) GetBaseFees(ctx context.Context) (sdk.DecCoins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.getBaseFees(ctx, params)
+}
+
+// GetBaseFee returns the base fee of a denom, per unit of gas.
+func (k Keeper) GetBaseFee(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	baseFees, err := k.GetBaseFees(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseFee := baseFees.AmountOf(denom)
+	if !baseFee.IsPositive() {
+		return sdk.DecCoin{}, types.ErrUnknownDenom.Wrap(denom)
+	}
+	return sdk.NewDecCoinFromDec(denom, baseFee), nil
+}
+
+// getBaseFees returns the base fee of every denom of the params, the denoms
+// without a base fee yet are at their minimum base fee.
+func (k Keeper) getBaseFees(ctx context.Context, params types.Params) (sdk.DecCoins, error) {
+	if !params.Enabled {
+		return nil, nil
+	}
+
+	baseFees := make(sdk.DecCoins, 0, len(params.MinBaseFees))
+	for _, minBaseFee := range params.MinBaseFees {
+		baseFee, err := k.BaseFees.Get(ctx, minBaseFee.Denom)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			baseFee = minBaseFee.Amount
+		case err != nil:
+			return nil, err
+		}
+
+		baseFees = append(baseFees, sdk.NewDecCoinFromDec(minBaseFee.Denom, math.LegacyMaxDec(baseFee, minBaseFee.Amount)))
+	}
+
+	return baseFees, nil
+}
+
+// UpdateBaseFees adjusts the base fees for the next block given the gas of the
+// transactions of the current block, see types.NextBaseFee.
+func (k Keeper) UpdateBaseFees(ctx context.Context, params types.Params) error {
+	baseFees, err := k.getBaseFees(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	var blockGas uint64
+	if err := k.blockGas.Flush(ctx, func(_ string, gas uint64) error {
+		blockGas = gas
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	targetGas := params.TargetBlockGas
+	if targetGas == 0 {
+		if block := sdk.UnwrapSDKContext(ctx).ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+			targetGas = uint64(block.MaxGas) / 2
+		}
+	}
+
+	// the base fees of the denoms removed from the params are discarded
+	if err := k.BaseFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	nextBaseFees := make(sdk.DecCoins, 0, len(baseFees))
+	for _, baseFee := range baseFees {
+		minBaseFee, _ := params.MinBaseFee(baseFee.Denom)
+		next := types.NextBaseFee(baseFee.Amount, minBaseFee, params.MaxChangeRate, blockGas, targetGas)
+		if err := k.BaseFees.Set(ctx, baseFee.Denom, next); err != nil {
+			return err
+		}
+		nextBaseFees = append(nextBaseFees, sdk.NewDecCoinFromDec(baseFee.Denom, next))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFees, nextBaseFees.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGas, strconv.FormatUint(blockGas, 10)),
+		),
+	)
+
+	return nil
+}
+
+// CollectBaseFees burns the base fee portion of the fees paid in the block, or
+// sends it to the base fee recipient of the params, from the fee collector.
+func (k Keeper) CollectBaseFees(ctx context.Context, params types.Params) error {
+	// an invalid recipient fails the block instead of panicking in x/bank
+	if err := k.ValidateBaseFeeRecipient(params.BaseFeeRecipient); err != nil {
+		return err
+	}
+
+	var collected sdk.Coins
+	if err := k.collectedBaseFees.Flush(ctx, func(denom string, amount math.Int) error {
+		collected = collected.Add(sdk.NewCoin(denom, amount))
+		return nil
+	}); err != nil {
+		return err
+	}
+	if collected.IsZero() {
+		return nil
+	}
+
+	switch params.BaseFeeRecipient {
+	case "":
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, collected); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, collected); err != nil {
+			return err
+		}
+	case types.CommunityPoolRecipient:
+		if err := k.distrKeeper.FundCommunityPool(ctx, collected, k.accountKeeper.GetModuleAddress(k.feeCollectorName)); err != nil {
+			return err
+		}
+	default:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, params.BaseFeeRecipient, collected); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCollectBaseFee,
+			sdk.NewAttribute(types.AttributeKeyAmount, collected.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, params.BaseFeeRecipient),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxFee is an ante.TxFeeChecker requiring the fees of a transaction to pay
+// for its gas limit at the base fee, in one of the denoms with a base fee. It
+// replaces the default fee checker of the DeductFeeDecorator:
+//
+//	anteOptions.TxFeeChecker = app.FeeMarketKeeper.CheckTxFee
+//
+// During CheckTx, the gas price must also be at least the minimum gas price of
+// the validator. The priority of the transaction is its tip, the gas price paid
+// above the base fee. When the base fee is disabled, only the minimum gas prices
+// of the validator are enforced.
+//
+// The base fee portion of the fees is burned or sent to the base fee recipient
+// at the end of the block. The gas limit of the transaction counts toward the
+// gas of the block, which adjusts the base fee at the end of the block, until
+// the GasUsedDecorator replaces it with the gas used by the transaction.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	baseFees, err := k.getBaseFees(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gasPrices := baseFees
+	if ctx.IsCheckTx() {
+		gasPrices = maxGasPrices(baseFees, ctx.MinGasPrices(), params.Enabled)
+	}
+	if gasPrices.IsZero() {
+		return feeCoins, txPriority(feeCoins, gas, nil), nil
+	}
+
+	// the fees are paid in the first denom covering the gas limit at its gas
+	// price, fee = ceil(gasPrice * gasLimit).
+	glDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+	for _, fee := range feeCoins {
+		gasPrice := gasPrices.AmountOf(fee.Denom)
+		if !gasPrice.IsPositive() || fee.Amount.LT(gasPrice.Mul(glDec).Ceil().RoundInt()) {
+			continue
+		}
+
+		if params.Enabled && ctx.ExecMode() == sdk.ExecModeFinalize {
+			baseFee := baseFees.AmountOf(fee.Denom).Mul(glDec).Ceil().RoundInt()
+			if err := k.blockGas.Add(ctx, blockGasKey, gas); err != nil {
+				return nil, 0, err
+			}
+			if err := k.collectedBaseFees.Add(ctx, fee.Denom, baseFee); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		return feeCoins, txPriority(sdk.NewCoins(fee), gas, baseFees), nil
+	}
+
+	requiredFees := make(sdk.Coins, 0, len(gasPrices))
+	for _, gp := range gasPrices {
+		requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt()))
+	}
+	return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: any of %s", feeCoins, requiredFees)
+}
+
+// GasUsedDecorator is a sdk.PostDecorator counting the gas used by the
+// transactions toward the gas of the block, instead of their gas limit counted
+// by CheckTxFee. It must be the last decorator of the post handler chain of the
+// app, so that the gas consumed by the other decorators is counted:
+//
+//	postHandler := sdk.ChainPostDecorators(
+//		// ...
+//		feemarketkeeper.NewGasUsedDecorator(app.FeeMarketKeeper),
+//	)
+//
+// The writes of the post handler are reverted with the messages of a failed
+// transaction, a failed transaction thus keeps counting for its gas limit.
+type GasUsedDecorator struct {
+	k Keeper
+}
+
+var _ sdk.PostDecorator = GasUsedDecorator{}
+
+// NewGasUsedDecorator returns a GasUsedDecorator for the keeper.
+func NewGasUsedDecorator(k Keeper) GasUsedDecorator {
+	return GasUsedDecorator{k: k}
+}
+
+func (d GasUsedDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the accounting doesn't consume gas, so that the gas counted is the gas used
+	// reported in the result of the transaction
+	gasUsed := ctx.GasMeter().GasConsumed()
+	accountingCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// only the transactions paying the base fee count toward the gas of the block
+	_, found, err := d.k.blockGas.Pending(accountingCtx, blockGasKey)
+	if err != nil {
+		return ctx, err
+	}
+	if found {
+		if err := d.k.blockGas.SetPending(accountingCtx, blockGasKey, gasUsed); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// maxGasPrices returns the gas prices required during CheckTx, the greater of
+// the base fee and the minimum gas price of the validator for every denom with
+// a base fee, or the minimum gas prices of the validator if the base fee is
+// disabled.
+func maxGasPrices(baseFees, minGasPrices sdk.DecCoins, enabled bool) sdk.DecCoins {
+	if !enabled {
+		return minGasPrices
+	}
+
+	gasPrices := make(sdk.DecCoins, len(baseFees))
+	for i, baseFee := range baseFees {
+		gasPrices[i] = sdk.NewDecCoinFromDec(baseFee.Denom, sdkmath.LegacyMaxDec(baseFee.Amount, minGasPrices.AmountOf(baseFee.Denom)))
+	}
+	return gasPrices
+}
+
+// txPriority returns the lowest tip, the gas price above the base fee, of the
+// fees. The priority saturates at math.MaxInt64.
+func txPriority(fees sdk.Coins, gas uint64, baseFees sdk.DecCoins) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for i, fee := range fees {
+		p := int64(math.MaxInt64)
+		tip := sdkmath.LegacyNewDecFromInt(fee.Amount).QuoInt(sdkmath.NewIntFromUint64(gas)).Sub(baseFees.AmountOf(fee.Denom)).TruncateInt()
+		if tip.IsInt64() {
+			p = tip.Int64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDec(2)))
+
+	testCases := []struct {
+		name             string
+		checkTx          bool
+		disabled         bool
+		fee              sdk.Coins
+		expectedPriority int64
+		expectErr        bool
+	}{
+		{name: "base fee paid", fee: stake(1000)},
+		{name: "tip", fee: stake(3500), expectedPriority: 2},
+		{name: "base fee not paid", fee: stake(999), expectErr: true},
+		{name: "no base fee for denom", fee: sdk.NewCoins(sdk.NewInt64Coin("other", 1000)), expectErr: true},
+		{name: "any denom paying the base fee", fee: sdk.NewCoins(sdk.NewInt64Coin("other", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))},
+		{name: "below validator min gas price", checkTx: true, fee: stake(1000), expectErr: true},
+		{name: "validator min gas price", checkTx: true, fee: stake(2000), expectedPriority: 1},
+		{name: "disabled", disabled: true, fee: stake(1), expectedPriority: 0},
+		{name: "disabled below validator min gas price", disabled: true, checkTx: true, fee: stake(1000), expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+			if tc.disabled {
+				params, err := s.feeMarketKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				params.Enabled = false
+				s.Require().NoError(s.feeMarketKeeper.Params.Set(ctx, params))
+			}
+
+			fee, priority, err := s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(tc.fee, 1000))
+			if tc.expectErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.expectedPriority, priority)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000))
+
+	// two transactions using twice the target gas, the tips stay in the fee collector
+	for i := range 2 {
+		fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500))
+		_, _, err := s.feeMarketKeeper.CheckTxFee(ctx.WithTxIndex(i), s.newTx(fee, 1000))
+		s.Require().NoError(err)
+	}
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, collected).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, collected).Return(nil)
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(sdkmath.LegacyMustNewDecFromStr("1.125").Equal(baseFee.Amount), baseFee.String())
+
+	// an empty block lowers the base fee, down to the min base fee
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+	baseFee, err = s.feeMarketKeeper.GetBaseFee(ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(sdkmath.LegacyOneDec().Equal(baseFee.Amount), baseFee.String())
+}
+
+func (s *KeeperTestSuite) TestGasUsedDecorator() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	postHandler := sdk.ChainPostDecorators(keeper.NewGasUsedDecorator(s.feeMarketKeeper))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	// two transactions with twice the target gas limit, using 1200 gas in total
+	for i := range 2 {
+		txCtx := ctx.WithTxIndex(i).WithGasMeter(storetypes.NewGasMeter(1000))
+		tx := s.newTx(fee, 1000)
+		_, _, err := s.feeMarketKeeper.CheckTxFee(txCtx, tx)
+		s.Require().NoError(err)
+
+		txCtx.GasMeter().ConsumeGas(600, "test")
+		_, err = postHandler(txCtx, tx, false, true)
+		s.Require().NoError(err)
+	}
+
+	// a transaction not paying the base fee doesn't count
+	txCtx := ctx.WithTxIndex(2).WithGasMeter(storetypes.NewGasMeter(1000))
+	txCtx.GasMeter().ConsumeGas(600, "test")
+	_, err := postHandler(txCtx, s.newTx(nil, 1000), false, true)
+	s.Require().NoError(err)
+
+	// the base fees are still paid for the gas limits
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, collected).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, collected).Return(nil)
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(sdkmath.LegacyMustNewDecFromStr("1.025").Equal(baseFee.Amount), baseFee.String())
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_BaseFeeRecipient() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	params, err := s.feeMarketKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.BaseFeeRecipient = "mint"
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(fee, 500))
+	s.Require().NoError(err)
+
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	s.accountKeeper.EXPECT().GetModuleAddress("mint").Return(authtypes.NewModuleAddress("mint"))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, "mint", collected).Return(nil)
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+
+	// the block used half the target gas
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(sdkmath.LegacyOneDec().Equal(baseFee.Amount), baseFee.String())
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_CommunityPool() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	params, err := s.feeMarketKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.BaseFeeRecipient = types.CommunityPoolRecipient
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(fee, 500))
+	s.Require().NoError(err)
+
+	// the community pool is funded through x/distribution, which accounts for it
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollector)
+	s.distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), collected, feeCollector).Return(nil)
+	s.Require().NoError(feemarket.EndBlocker(ctx, s.feeMarketKeeper))
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_InvalidBaseFeeRecipient() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	params, err := s.feeMarketKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.BaseFeeRecipient = "unknown"
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(fee, 500))
+	s.Require().NoError(err)
+
+	// the end blocker fails instead of panicking in x/bank
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	s.Require().ErrorIs(feemarket.EndBlocker(ctx, s.feeMarketKeeper), types.ErrInvalidBaseFeeRecipient)
+}
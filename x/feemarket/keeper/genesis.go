@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis new feemarket genesis
+func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types.GenesisState) {
+	if err := keeper.ValidateBaseFeeRecipient(data.Params.BaseFeeRecipient); err != nil {
+		panic(err)
+	}
+	if err := keeper.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, baseFee := range data.BaseFees {
+		if err := keeper.BaseFees.Set(ctx, baseFee.Denom, baseFee.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var baseFees sdk.DecCoins
+	err = keeper.BaseFees.Walk(ctx, nil, func(denom string, baseFee sdkmath.LegacyDec) (bool, error) {
+		baseFees = append(baseFees, sdk.NewDecCoinFromDec(denom, baseFee))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, baseFees)
+}
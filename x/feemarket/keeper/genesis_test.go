@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	genesisState := types.NewGenesisState(
+		types.DefaultParams(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(3, 2))),
+	)
+
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName).Return(nil)
+	s.feeMarketKeeper.InitGenesis(s.ctx, s.accountKeeper, genesisState)
+
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(s.ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(genesisState.BaseFees[0].IsEqual(baseFee), baseFee.String())
+
+	exported := s.feeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(genesisState.Params, exported.Params)
+	s.Require().True(genesisState.BaseFees.Equal(exported.BaseFees), exported.BaseFees.String())
+}
+
+func (s *KeeperTestSuite) TestInitGenesis_InvalidBaseFeeRecipient() {
+	params := types.DefaultParams()
+	params.BaseFeeRecipient = distrtypes.ModuleName
+	genesisState := types.NewGenesisState(params, nil)
+
+	s.Require().PanicsWithError("use community_pool to fund the community pool: invalid base fee recipient", func() {
+		s.feeMarketKeeper.InitGenesis(s.ctx, s.accountKeeper, genesisState)
+	})
+}
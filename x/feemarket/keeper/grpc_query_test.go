@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	expectedParams, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedParams, params.Params)
+
+	s.Require().NoError(s.feeMarketKeeper.BaseFees.Set(s.ctx, sdk.DefaultBondDenom, sdkmath.LegacyNewDec(2)))
+	expected := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDec(2))
+
+	baseFees, err := s.queryServer.BaseFees(s.ctx, &types.QueryBaseFeesRequest{})
+	s.Require().NoError(err)
+	s.Require().True(sdk.NewDecCoins(expected).Equal(baseFees.BaseFees), baseFees.BaseFees.String())
+
+	baseFee, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{Denom: sdk.DefaultBondDenom})
+	s.Require().NoError(err)
+	s.Require().True(expected.IsEqual(baseFee.BaseFee), baseFee.BaseFee.String())
+
+	_, err = s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().Error(err)
+	_, err = s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{Denom: "other"})
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+}
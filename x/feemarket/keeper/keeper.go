@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/accumulator"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// blockGasKey is the key of the block gas accumulator.
+const blockGasKey = "block_gas"
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeService     corestore.KVStoreService
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// BaseFees are the base fees of the denoms, per unit of gas.
+	BaseFees collections.Map[string, math.LegacyDec]
+
+	// blockGas accumulates the gas used by the transactions of the block, see
+	// GasUsedDecorator.
+	blockGas accumulator.Accumulator[string, uint64]
+	// collectedBaseFees accumulates the base fee portion of the fees paid in
+	// the block, by denom.
+	collectedBaseFees accumulator.Accumulator[string, math.Int]
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The object store of okey
+// holds the gas and the base fees accumulated by the transactions of the block.
+// The distribution keeper dk funds the community pool, it may be nil if the
+// base fee is never sent to the community pool.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	okey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFees:         collections.NewMap(sb, types.BaseFeesPrefix, "base_fees", collections.StringKey, sdk.LegacyDecValue),
+		blockGas: accumulator.New(okey, types.BlockGasPrefix, collections.StringKey, func(a, b uint64) uint64 {
+			return a + b
+		}),
+		collectedBaseFees: accumulator.New(okey, types.CollectedBaseFeesPrefix, collections.StringKey, func(a, b math.Int) math.Int {
+			return a.Add(b)
+		}),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateBaseFeeRecipient checks that the base fee can be sent to recipient:
+// either burned, sent to the community pool, or sent to a module account. The
+// distribution module account is rejected, as its balance must be accounted by
+// the community pool.
+func (k Keeper) ValidateBaseFeeRecipient(recipient string) error {
+	switch {
+	case recipient == "":
+		return nil
+	case recipient == types.CommunityPoolRecipient:
+		if k.distrKeeper == nil {
+			return errorsmod.Wrap(types.ErrInvalidBaseFeeRecipient, "no distribution keeper to fund the community pool")
+		}
+		return nil
+	case recipient == distrtypes.ModuleName:
+		return errorsmod.Wrapf(types.ErrInvalidBaseFeeRecipient, "use %s to fund the community pool", types.CommunityPoolRecipient)
+	case k.accountKeeper.GetModuleAddress(recipient) == nil:
+		return errorsmod.Wrapf(types.ErrInvalidBaseFeeRecipient, "%s is not a module account", recipient)
+	}
+	return nil
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	feeMarketKeeper keeper.Keeper
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	distrKeeper     *feemarkettestutil.MockDistributionKeeper
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+	txConfig        client.TxConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	okey := storetypes.NewObjectStoreKey(types.ObjectStoreKey)
+	testCtx := testutil.DefaultContextWithObjectStore(s.T(), key, storetypes.NewTransientStoreKey("transient_test"), okey)
+	s.ctx = testCtx.Ctx
+	s.txConfig = encCfg.TxConfig
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+	s.distrKeeper = feemarkettestutil.NewMockDistributionKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	s.feeMarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		okey,
+		s.accountKeeper,
+		s.bankKeeper,
+		s.distrKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
+		s.feeMarketKeeper.Logger(testCtx.Ctx))
+
+	params := types.NewParams(
+		true,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyOneDec())),
+		1000,
+		sdkmath.LegacyNewDecWithPrec(125, 3),
+		"",
+	)
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.feeMarketKeeper)
+}
+
+// newTx returns a transaction paying fee for its gas limit.
+func (s *KeeperTestSuite) newTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := s.txConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	return txBuilder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestGetBaseFees() {
+	baseFees, err := s.feeMarketKeeper.GetBaseFees(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyOneDec())).Equal(baseFees), baseFees.String())
+
+	// the base fee never goes below the min base fee
+	s.Require().NoError(s.feeMarketKeeper.BaseFees.Set(s.ctx, sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(5, 1)))
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(s.ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().True(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyOneDec()).IsEqual(baseFee), baseFee.String())
+
+	_, err = s.feeMarketKeeper.GetBaseFee(s.ctx, "other")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	baseFees, err = s.feeMarketKeeper.GetBaseFees(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(baseFees)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func withBaseFeeRecipient(recipient string) types.Params {
+	params := types.DefaultParams()
+	params.BaseFeeRecipient = recipient
+	return params
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params: types.Params{
+					Enabled:       true,
+					MaxChangeRate: sdkmath.LegacyNewDecWithPrec(125, 3),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set a base fee recipient which is not a module account",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    withBaseFeeRecipient("unknown"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set the distribution module account as base fee recipient",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    withBaseFeeRecipient(distrtypes.ModuleName),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set the community pool as base fee recipient",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    withBaseFeeRecipient(types.CommunityPoolRecipient),
+			},
+			expectErr: false,
+		},
+		{
+			name: "set a module account as base fee recipient",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    withBaseFeeRecipient("mint"),
+			},
+			expectErr: false,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.DefaultParams(),
+			},
+			expectErr: false,
+		},
+	}
+
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress("mint").Return(authtypes.NewModuleAddress("mint"))
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Params, params)
+		})
+	}
+}
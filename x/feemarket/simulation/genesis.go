@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	TargetBlockGas = "target_block_gas"
+	MaxChangeRate  = "max_change_rate"
+)
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10_000_000))
+}
+
+// GenMaxChangeRate randomized MaxChangeRate
+func GenMaxChangeRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket
+func RandomizedGenState(simState *module.SimulationState) {
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(TargetBlockGas, &targetBlockGas, simState.Rand, func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) })
+
+	var maxChangeRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(MaxChangeRate, &maxChangeRate, simState.Rand, func(r *rand.Rand) { maxChangeRate = GenMaxChangeRate(r) })
+
+	// the base fee is disabled as the simulated transactions pay random fees
+	minBaseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec(simState.BondDenom, types.DefaultMinBaseFee))
+	params := types.NewParams(false, minBaseFees, targetBlockGas, maxChangeRate, "")
+
+	feemarketGenesis := types.NewGenesisState(params, nil)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// NextBaseFee returns the base fee of the next block given the base fee and the
+// gas of the current block, following EIP-1559: the base fee changes in
+// proportion to the deviation of the block gas from the target gas, by at most
+// maxChangeRate when the block gas is twice the target or zero, and never goes
+// below minBaseFee. The base fee does not change when the target gas is zero.
+func NextBaseFee(baseFee, minBaseFee, maxChangeRate sdkmath.LegacyDec, blockGas, targetGas uint64) sdkmath.LegacyDec {
+	if targetGas == 0 {
+		return sdkmath.LegacyMaxDec(baseFee, minBaseFee)
+	}
+
+	target := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(targetGas))
+	deviation := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(blockGas)).Sub(target).Quo(target)
+	deviation = sdkmath.LegacyMinDec(deviation, sdkmath.LegacyOneDec())
+
+	next := baseFee.Add(baseFee.Mul(deviation).Mul(maxChangeRate))
+	return sdkmath.LegacyMaxDec(next, minBaseFee)
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	baseFee := sdkmath.LegacyNewDec(100)
+	minBaseFee := sdkmath.LegacyNewDec(10)
+	maxChangeRate := sdkmath.LegacyNewDecWithPrec(125, 3)
+
+	tests := []struct {
+		name      string
+		baseFee   sdkmath.LegacyDec
+		blockGas  uint64
+		targetGas uint64
+		expected  sdkmath.LegacyDec
+	}{
+		{"at target", baseFee, 500, 500, baseFee},
+		{"twice the target", baseFee, 1000, 500, sdkmath.LegacyMustNewDecFromStr("112.5")},
+		{"above twice the target", baseFee, 5000, 500, sdkmath.LegacyMustNewDecFromStr("112.5")},
+		{"half the target", baseFee, 250, 500, sdkmath.LegacyMustNewDecFromStr("93.75")},
+		{"empty block", baseFee, 0, 500, sdkmath.LegacyMustNewDecFromStr("87.5")},
+		{"floor", sdkmath.LegacyNewDec(11), 0, 500, minBaseFee},
+		{"no target", baseFee, 1000, 0, baseFee},
+		{"no target below floor", sdkmath.LegacyNewDec(1), 1000, 0, minBaseFee},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := types.NextBaseFee(tc.baseFee, minBaseFee, maxChangeRate, tc.blockGas, tc.targetGas)
+			require.True(t, tc.expected.Equal(next), "expected %s, got %s", tc.expected, next)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feemarket module sentinel errors
+var (
+	ErrUnknownDenom            = errors.Register(ModuleName, 2, "no base fee for denom")
+	ErrInvalidBaseFeeRecipient = errors.Register(ModuleName, 3, "invalid base fee recipient")
+)
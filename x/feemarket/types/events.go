@@ -0,0 +1,12 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFee        = "base_fee"
+	EventTypeCollectBaseFee = "collect_base_fee"
+
+	AttributeKeyBaseFees  = "base_fees"
+	AttributeKeyBlockGas  = "block_gas"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyRecipient = "recipient"
+)
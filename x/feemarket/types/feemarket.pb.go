@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/feemarket module.
+type Params struct {
+	// enabled enables the base fee. When disabled, the base fee is neither
+	// adjusted nor required, and only the validator minimum gas prices apply.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_base_fees is the minimum base fee, per unit of gas, of every denom
+	// accepted to pay the fees. It is also the initial base fee of a denom.
+	MinBaseFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_base_fees,json=minBaseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_base_fees"`
+	// target_block_gas is the block gas at which the base fee remains unchanged.
+	// The base fee increases when a block uses more gas and decreases when it
+	// uses less. A value of 0 targets half of the consensus block max gas.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// max_change_rate is the maximum rate at which the base fee changes from one
+	// block to the next, reached when a block uses twice the target gas or none.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// base_fee_recipient is the name of the module account receiving the base
+	// fee portion of the tx fees. The base fee portion is burned if empty.
+	BaseFeeRecipient string `protobuf:"bytes,5,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetMinBaseFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinBaseFees
+	}
+	return nil
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1/feemarket.proto", fileDescriptor_481036a621b23787)
+}
+
+var fileDescriptor_481036a621b23787 = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x31, 0x6f, 0xd4, 0x30,
+	0x18, 0xbd, 0xb4, 0xa5, 0x40, 0xaa, 0x42, 0x09, 0x0c, 0xa1, 0x40, 0xee, 0x54, 0x96, 0xa8, 0x50,
+	0x5b, 0x07, 0x12, 0x42, 0x8c, 0xe9, 0x09, 0x18, 0x18, 0x50, 0x46, 0x06, 0xac, 0x2f, 0xbe, 0xaf,
+	0x39, 0xeb, 0x6a, 0xfb, 0x14, 0x9b, 0xd3, 0x95, 0x9f, 0xc0, 0xc4, 0xcf, 0x40, 0x4c, 0x1d, 0xf8,
+	0x11, 0x1d, 0x2b, 0x26, 0xc4, 0x50, 0xd0, 0xdd, 0xd0, 0xff, 0xc0, 0x84, 0x12, 0x3b, 0xba, 0x9b,
+	0xba, 0x24, 0xf1, 0x7b, 0x9f, 0xdf, 0x7b, 0x7e, 0x71, 0xf8, 0x98, 0x6b, 0x23, 0xb5, 0xa1, 0x47,
+	0x88, 0x12, 0xaa, 0x31, 0x5a, 0x3a, 0xed, 0x2f, 0x17, 0x64, 0x52, 0x69, 0xab, 0xa3, 0xbb, 0x6e,
+	0x88, 0x2c, 0xf1, 0x69, 0x7f, 0xf7, 0x5e, 0xa9, 0x4b, 0xdd, 0xf0, 0xb4, 0xfe, 0x72, 0xa3, 0xbb,
+	0xf7, 0xdd, 0x28, 0x73, 0x84, 0xdf, 0xe7, 0xa8, 0x3b, 0x20, 0x85, 0xd2, 0xb4, 0x79, 0x7a, 0x28,
+	0xf1, 0xee, 0x05, 0x18, 0xa4, 0xd3, 0x7e, 0x81, 0x16, 0xfa, 0x94, 0x6b, 0xa1, 0x1c, 0xbf, 0xf7,
+	0x6f, 0x2d, 0xdc, 0x7c, 0x0f, 0x15, 0x48, 0x13, 0xc5, 0xe1, 0x75, 0x54, 0x50, 0x1c, 0xe3, 0x30,
+	0x0e, 0x7a, 0x41, 0x7a, 0x23, 0x6f, 0x97, 0xd1, 0xe7, 0x70, 0x5b, 0x0a, 0xc5, 0x6a, 0x0d, 0x76,
+	0x84, 0x68, 0xe2, 0xb5, 0xde, 0x7a, 0xba, 0xf5, 0xec, 0x21, 0xf1, 0xee, 0x35, 0x41, 0xbc, 0x38,
+	0x19, 0x20, 0x3f, 0xd4, 0x42, 0x65, 0x2f, 0xcf, 0x2e, 0xba, 0x9d, 0xef, 0x7f, 0xba, 0x4f, 0x4a,
+	0x61, 0x47, 0x9f, 0x0a, 0xc2, 0xb5, 0xf4, 0x69, 0xfd, 0xeb, 0xc0, 0x0c, 0xc7, 0xd4, 0x9e, 0x4c,
+	0xd0, 0xb4, 0x7b, 0xcc, 0xb7, 0xcb, 0xd3, 0xfd, 0x20, 0xdf, 0x92, 0x42, 0x65, 0x60, 0xf0, 0x35,
+	0xa2, 0x89, 0xd2, 0x70, 0xc7, 0x42, 0x55, 0xa2, 0x65, 0xc5, 0xb1, 0xe6, 0x63, 0x56, 0x82, 0x89,
+	0xd7, 0x7b, 0x41, 0xba, 0x91, 0xdf, 0x72, 0x78, 0x56, 0xc3, 0x6f, 0xc0, 0x44, 0x1f, 0xc3, 0xdb,
+	0x12, 0x66, 0x8c, 0x8f, 0x40, 0x95, 0xc8, 0x2a, 0xb0, 0x18, 0x6f, 0xf4, 0x82, 0xf4, 0x66, 0xf6,
+	0xa2, 0x4e, 0xf2, 0xfb, 0xa2, 0xfb, 0xc0, 0xf9, 0x9a, 0xe1, 0x98, 0x08, 0x4d, 0x25, 0xd8, 0x11,
+	0x79, 0x87, 0x25, 0xf0, 0x93, 0x01, 0xf2, 0x9f, 0x3f, 0x0e, 0x42, 0x7f, 0x9a, 0x01, 0x72, 0x97,
+	0x63, 0x5b, 0xc2, 0xec, 0xb0, 0x51, 0xcb, 0xc1, 0x62, 0xf4, 0x34, 0x8c, 0xda, 0x06, 0x58, 0x85,
+	0x5c, 0x4c, 0x04, 0x2a, 0x1b, 0x5f, 0xab, 0x2d, 0xf2, 0x9d, 0xc2, 0xe5, 0xcd, 0x5b, 0xfc, 0xd5,
+	0xde, 0x97, 0xcb, 0xd3, 0xfd, 0x47, 0x2b, 0x27, 0x9d, 0xad, 0xdc, 0x00, 0xd7, 0x78, 0xf6, 0xf6,
+	0x6c, 0x9e, 0x04, 0xe7, 0xf3, 0x24, 0xf8, 0x3b, 0x4f, 0x82, 0xaf, 0x8b, 0xa4, 0x73, 0xbe, 0x48,
+	0x3a, 0xbf, 0x16, 0x49, 0xe7, 0x03, 0xb9, 0xb2, 0xb4, 0x55, 0xa9, 0xa6, 0xc0, 0x62, 0xb3, 0xf9,
+	0x9b, 0xcf, 0xff, 0x0f, 0x00, 0x3a, 0x4f, 0xdd, 0x19, 0x6d, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinBaseFees) > 0 {
+		for iNdEx := len(m.MinBaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.MinBaseFees) > 0 {
+		for _, e := range m.MinBaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBaseFees = append(m.MinBaseFees, types.DecCoin{})
+			if err := m.MinBaseFees[len(m.MinBaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
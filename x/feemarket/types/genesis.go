@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFees sdk.DecCoins) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		BaseFees: baseFees,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.BaseFees.Validate(); err != nil {
+		return fmt.Errorf("invalid base fees: %w", err)
+	}
+	for _, baseFee := range data.BaseFees {
+		minBaseFee, ok := data.Params.MinBaseFee(baseFee.Denom)
+		if !ok {
+			return fmt.Errorf("base fee of %s has no min base fee", baseFee.Denom)
+		}
+		if baseFee.Amount.LT(minBaseFee) {
+			return fmt.Errorf("base fee %s is lower than the min base fee %s", baseFee, sdk.NewDecCoinFromDec(baseFee.Denom, minBaseFee))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// ObjectStoreKey defines the store name for the object store
+	ObjectStoreKey = "object:" + ModuleName
+)
+
+// KVStore keys
+var (
+	ParamsKey      = collections.NewPrefix(0)
+	BaseFeesPrefix = collections.NewPrefix(1)
+)
+
+// Object store keys
+var (
+	// BlockGasPrefix is the prefix of the per-transaction gas accumulated in the
+	// object store, block-stm can treat it as commutative.
+	BlockGasPrefix = collections.NewPrefix(0)
+	// CollectedBaseFeesPrefix is the prefix of the per-transaction base fees
+	// accumulated in the object store, block-stm can treat it as commutative.
+	CollectedBaseFeesPrefix = collections.NewPrefix(1)
+)
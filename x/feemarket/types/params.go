@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityPoolRecipient is the base fee recipient funding the community pool.
+const CommunityPoolRecipient = "community_pool"
+
+// DefaultMinBaseFee is the default minimum base fee of the bond denom, per unit of gas.
+var DefaultMinBaseFee = sdkmath.LegacyNewDecWithPrec(25, 4)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, minBaseFees sdk.DecCoins, targetBlockGas uint64, maxChangeRate sdkmath.LegacyDec, baseFeeRecipient string) Params {
+	return Params{
+		Enabled:          enabled,
+		MinBaseFees:      minBaseFees,
+		TargetBlockGas:   targetBlockGas,
+		MaxChangeRate:    maxChangeRate,
+		BaseFeeRecipient: baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		Enabled:          true,
+		MinBaseFees:      sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, DefaultMinBaseFee)),
+		TargetBlockGas:   0,                                    // half of the consensus block max gas
+		MaxChangeRate:    sdkmath.LegacyNewDecWithPrec(125, 3), // 12.5% per block, as in EIP-1559
+		BaseFeeRecipient: "",                                   // burn the base fee
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := validateMinBaseFees(p.MinBaseFees); err != nil {
+		return err
+	}
+	if p.Enabled && p.MinBaseFees.Empty() {
+		return errors.New("min base fees cannot be empty when the base fee is enabled")
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+	if p.BaseFeeRecipient != strings.TrimSpace(p.BaseFeeRecipient) {
+		return fmt.Errorf("invalid base fee recipient: %q", p.BaseFeeRecipient)
+	}
+
+	return nil
+}
+
+// MinBaseFee returns the minimum base fee of a denom, and whether the denom is
+// accepted to pay the fees.
+func (p Params) MinBaseFee(denom string) (sdkmath.LegacyDec, bool) {
+	minBaseFee := p.MinBaseFees.AmountOf(denom)
+	return minBaseFee, minBaseFee.IsPositive()
+}
+
+func validateMinBaseFees(minBaseFees sdk.DecCoins) error {
+	if err := minBaseFees.Validate(); err != nil {
+		return fmt.Errorf("invalid min base fees: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxChangeRate(maxChangeRate sdkmath.LegacyDec) error {
+	if maxChangeRate.IsNil() {
+		return fmt.Errorf("max change rate cannot be nil: %s", maxChangeRate)
+	}
+	if maxChangeRate.IsNegative() {
+		return fmt.Errorf("max change rate cannot be negative: %s", maxChangeRate)
+	}
+	if maxChangeRate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max change rate too large: %s", maxChangeRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	minBaseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 2)))
+	rate := sdkmath.LegacyNewDecWithPrec(125, 3)
+
+	tests := []struct {
+		name        string
+		params      types.Params
+		errContains string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"valid", types.NewParams(true, minBaseFees, 1_000_000, rate, "distribution"), ""},
+		{"disabled without min base fees", types.NewParams(false, nil, 0, rate, ""), ""},
+		{"enabled without min base fees", types.NewParams(true, nil, 0, rate, ""), "cannot be empty"},
+		{"invalid min base fees", types.NewParams(true, sdk.DecCoins{{Denom: "stake", Amount: sdkmath.LegacyZeroDec()}}, 0, rate, ""), "invalid min base fees"},
+		{"nil max change rate", types.NewParams(true, minBaseFees, 0, sdkmath.LegacyDec{}, ""), "cannot be nil"},
+		{"negative max change rate", types.NewParams(true, minBaseFees, 0, sdkmath.LegacyNewDec(-1), ""), "cannot be negative"},
+		{"max change rate too large", types.NewParams(true, minBaseFees, 0, sdkmath.LegacyNewDec(2), ""), "too large"},
+		{"invalid recipient", types.NewParams(true, minBaseFees, 0, rate, " distribution"), "invalid base fee recipient"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	baseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyOneDec()))
+	require.NoError(t, types.ValidateGenesis(*types.NewGenesisState(params, baseFees)))
+
+	baseFees = sdk.NewDecCoins(sdk.NewDecCoinFromDec("other", sdkmath.LegacyOneDec()))
+	require.ErrorContains(t, types.ValidateGenesis(*types.NewGenesisState(params, baseFees)), "has no min base fee")
+
+	baseFees = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(1, 4)))
+	require.ErrorContains(t, types.ValidateGenesis(*types.NewGenesisState(params, baseFees)), "lower than the min base fee")
+}
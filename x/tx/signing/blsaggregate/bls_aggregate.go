@@ -0,0 +1,47 @@
+package blsaggregate
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// SignBytesDomain prefixes the sign bytes, so that they are never the sign bytes of another sign
+// mode: a zero byte is an invalid protobuf tag, e.g. they can't be a SIGN_MODE_DIRECT SignDoc with
+// a zero account number.
+const SignBytesDomain = "\x00cosmos-sdk/SIGN_MODE_BLS_AGGREGATE/v1\x00"
+
+var (
+	_                  signing.SignModeHandler = SignModeHandler{}
+	protov2MarshalOpts                         = proto.MarshalOptions{Deterministic: true}
+)
+
+// SignModeHandler is the SIGN_MODE_BLS_AGGREGATE implementation of signing.SignModeHandler.
+// The sign bytes are the SIGN_MODE_DIRECT SignDoc without the account number, prefixed with
+// SignBytesDomain, they don't depend on the signer so that the signatures of all the signers can
+// be aggregated. Replay protection is kept by the sequences of the signers, which are part of the
+// AuthInfo.
+type SignModeHandler struct{}
+
+// Mode implements signing.SignModeHandler.Mode.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE
+}
+
+// GetSignBytes implements signing.SignModeHandler.GetSignBytes.
+func (SignModeHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	signDoc, err := protov2MarshalOpts.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+		ChainId:       signerData.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(SignBytesDomain), signDoc...), nil
+}
@@ -0,0 +1,56 @@
+package blsaggregate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/blsaggregate"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/direct"
+)
+
+func TestBLSAggregateModeHandler(t *testing.T) {
+	handler := blsaggregate.SignModeHandler{}
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE, handler.Mode())
+
+	txData := signing.TxData{
+		BodyBytes:     []byte("body"),
+		AuthInfoBytes: []byte("auth info"),
+	}
+	signerA := signing.SignerData{Address: "a", ChainID: "test-chain", AccountNumber: 1, Sequence: 3}
+	signerB := signing.SignerData{Address: "b", ChainID: "test-chain", AccountNumber: 7, Sequence: 0}
+
+	signBytesA, err := handler.GetSignBytes(context.Background(), signerA, txData)
+	require.NoError(t, err)
+	signBytesB, err := handler.GetSignBytes(context.Background(), signerB, txData)
+	require.NoError(t, err)
+
+	// all the signers sign the same bytes
+	require.Equal(t, signBytesA, signBytesB)
+
+	expected, err := proto.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+		ChainId:       "test-chain",
+	})
+	require.NoError(t, err)
+	require.Equal(t, append([]byte(blsaggregate.SignBytesDomain), expected...), signBytesA)
+
+	// the sign bytes differ from SIGN_MODE_DIRECT ones, even with a zero account number
+	for _, accountNumber := range []uint64{0, 1} {
+		directBytes, err := direct.SignModeHandler{}.GetSignBytes(context.Background(), signing.SignerData{ChainID: "test-chain", AccountNumber: accountNumber}, txData)
+		require.NoError(t, err)
+		require.NotEqual(t, directBytes, signBytesA)
+	}
+	require.Error(t, proto.Unmarshal(signBytesA, &txv1beta1.SignDoc{}))
+
+	signBytesOtherChain, err := handler.GetSignBytes(context.Background(), signing.SignerData{ChainID: "other-chain"}, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytesA, signBytesOtherChain)
+}
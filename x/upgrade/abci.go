@@ -18,6 +18,7 @@ import (
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// On every block, it also processes the next batch of every incremental migration in progress.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -61,6 +62,11 @@ func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlo
 		}
 	}
 
+	// Process the next batch of the incremental migrations started by previous upgrades
+	if err := k.RunIncrementalMigrations(ctx); err != nil {
+		return nil, err
+	}
+
 	if !found {
 		return &sdk.ResponsePreBlock{
 			ConsensusParamsChanged: false,
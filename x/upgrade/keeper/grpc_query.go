@@ -87,3 +87,25 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// IncrementalMigrations implements the Query/IncrementalMigrations gRPC method
+func (k Keeper) IncrementalMigrations(c context.Context, req *types.QueryIncrementalMigrationsRequest) (*types.QueryIncrementalMigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		migration, err := k.GetIncrementalMigrationProgress(ctx, req.ModuleName)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "x/upgrade: QueryIncrementalMigrations module %s", req.ModuleName)
+		}
+
+		return &types.QueryIncrementalMigrationsResponse{Migrations: []*types.IncrementalMigrationProgress{&migration}}, nil
+	}
+
+	migrations, err := k.GetIncrementalMigrationsProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIncrementalMigrationsResponse{Migrations: migrations}, nil
+}
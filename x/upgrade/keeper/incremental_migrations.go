@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// migrationsInProgress caches the modules whose incremental migration is in
+// progress, so that IsAllowed doesn't read the store for every message. It is
+// loaded on first use and refreshed in PreBlock, when the migrations are started,
+// run and completed.
+type migrationsInProgress struct {
+	mu      sync.RWMutex
+	loaded  bool
+	modules []string
+}
+
+// SetIncrementalMigration registers the incremental migration of a module. The
+// migration only runs once it is started by an upgrade handler with
+// StartIncrementalMigration. The migration must stay registered until it
+// completes, as it is resumed across blocks and node restarts.
+func (k Keeper) SetIncrementalMigration(moduleName string, migration types.IncrementalMigration) {
+	if migration.Handler == nil {
+		panic(fmt.Sprintf("incremental migration handler of module %s cannot be nil", moduleName))
+	}
+	k.incrementalMigrations[moduleName] = migration
+}
+
+// StartIncrementalMigration starts the registered incremental migration of a
+// module. It is meant to be called from an upgrade handler, in place of the
+// module's in-block migration. The first batch is processed in the block
+// following the upgrade, and the module's messages are rejected until the
+// migration completes.
+func (k Keeper) StartIncrementalMigration(ctx context.Context, moduleName string) error {
+	if _, ok := k.incrementalMigrations[moduleName]; !ok {
+		return errorsmod.Wrapf(types.ErrNoIncrementalMigrationFound, "module %s has no registered incremental migration", moduleName)
+	}
+
+	migration, err := k.GetIncrementalMigrationProgress(ctx, moduleName)
+	switch {
+	case err == nil && migration.DoneHeight == 0:
+		return errorsmod.Wrapf(types.ErrIncrementalMigrationInProgress, "module %s", moduleName)
+	case err != nil && !errors.Is(err, types.ErrNoIncrementalMigrationFound):
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.Logger(ctx).Info("starting incremental migration", "module", moduleName)
+	err = k.setIncrementalMigrationProgress(ctx, types.IncrementalMigrationProgress{
+		ModuleName:  moduleName,
+		StartHeight: sdkCtx.HeaderInfo().Height,
+	})
+	if err != nil {
+		return err
+	}
+	return k.refreshMigrationsInProgress(ctx)
+}
+
+// RunIncrementalMigrations processes the next batch of every incremental
+// migration in progress, in module name order. It is called in PreBlock.
+func (k Keeper) RunIncrementalMigrations(ctx context.Context) error {
+	migrations, err := k.GetIncrementalMigrationsProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, migration := range migrations {
+		if migration.DoneHeight != 0 {
+			continue
+		}
+
+		registered, ok := k.incrementalMigrations[migration.ModuleName]
+		if !ok {
+			return errorsmod.Wrapf(types.ErrNoIncrementalMigrationFound, "incremental migration of module %s is in progress but not registered", migration.ModuleName)
+		}
+
+		done, err := registered.Handler(sdkCtx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter()))
+		if err != nil {
+			return errorsmod.Wrapf(err, "incremental migration of module %s", migration.ModuleName)
+		}
+
+		migration.Batches++
+		if done {
+			migration.DoneHeight = sdkCtx.HeaderInfo().Height
+			k.Logger(ctx).Info("completed incremental migration", "module", migration.ModuleName, "batches", migration.Batches)
+		}
+		if err := k.setIncrementalMigrationProgress(ctx, *migration); err != nil {
+			return err
+		}
+	}
+
+	return k.refreshMigrationsInProgress(ctx)
+}
+
+// refreshMigrationsInProgress reloads the modules whose incremental migration is
+// in progress from the store.
+func (k Keeper) refreshMigrationsInProgress(ctx context.Context) error {
+	migrations, err := k.GetIncrementalMigrationsProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	modules := make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		if migration.DoneHeight == 0 {
+			modules = append(modules, migration.ModuleName)
+		}
+	}
+
+	k.migrationsInProgress.mu.Lock()
+	defer k.migrationsInProgress.mu.Unlock()
+	k.migrationsInProgress.modules = modules
+	k.migrationsInProgress.loaded = true
+	return nil
+}
+
+// getMigrationsInProgress returns the modules whose incremental migration is in
+// progress, loading them from the store on first use, e.g. after a restart.
+func (k Keeper) getMigrationsInProgress(ctx context.Context) ([]string, error) {
+	k.migrationsInProgress.mu.RLock()
+	modules, loaded := k.migrationsInProgress.modules, k.migrationsInProgress.loaded
+	k.migrationsInProgress.mu.RUnlock()
+	if loaded {
+		return modules, nil
+	}
+
+	if err := k.refreshMigrationsInProgress(ctx); err != nil {
+		return nil, err
+	}
+	return k.getMigrationsInProgress(ctx)
+}
+
+// GetIncrementalMigrationProgress returns the progress of the incremental
+// migration of a module. If not found it returns ErrNoIncrementalMigrationFound.
+func (k Keeper) GetIncrementalMigrationProgress(ctx context.Context, moduleName string) (migration types.IncrementalMigrationProgress, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.IncrementalMigrationKey(moduleName))
+	if err != nil {
+		return migration, err
+	}
+
+	if bz == nil {
+		return migration, types.ErrNoIncrementalMigrationFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &migration)
+	return migration, err
+}
+
+// GetIncrementalMigrationsProgress returns the progress of the incremental
+// migrations in state, both in progress and completed, ordered by module name.
+func (k Keeper) GetIncrementalMigrationsProgress(ctx context.Context) ([]*types.IncrementalMigrationProgress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := []byte{types.IncrementalMigrationByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	migrations := make([]*types.IncrementalMigrationProgress, 0)
+	for ; it.Valid(); it.Next() {
+		var migration types.IncrementalMigrationProgress
+		if err := k.cdc.Unmarshal(it.Value(), &migration); err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, &migration)
+	}
+
+	return migrations, nil
+}
+
+func (k Keeper) setIncrementalMigrationProgress(ctx context.Context, migration types.IncrementalMigrationProgress) error {
+	bz, err := k.cdc.Marshal(&migration)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.IncrementalMigrationKey(migration.ModuleName), bz)
+}
+
+// IsAllowed implements the baseapp.CircuitBreaker interface. It rejects the
+// messages of the modules whose incremental migration is in progress. The
+// migrations in progress are kept in memory, the check does not read the store
+// nor consume the gas of the transaction.
+func (k Keeper) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	modules, err := k.getMigrationsInProgress(sdkCtx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, moduleName := range modules {
+		prefixes := k.incrementalMigrations[moduleName].MsgTypeURLPrefixes
+		if len(prefixes) == 0 {
+			if msgBelongsToModule(typeURL, moduleName) {
+				return false, nil
+			}
+			continue
+		}
+
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(typeURL, prefix) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// msgBelongsToModule reports whether the protobuf package of a message type
+// URL contains the module name, e.g. /cosmos.bank.v1beta1.MsgSend belongs to
+// the bank module.
+func msgBelongsToModule(typeURL, moduleName string) bool {
+	segments := strings.Split(strings.TrimPrefix(typeURL, "/"), ".")
+	for _, segment := range segments[:len(segments)-1] {
+		if segment == moduleName {
+			return true
+		}
+	}
+	return false
+}
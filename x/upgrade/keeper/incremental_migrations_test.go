@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestIncrementalMigrations() {
+	ctx := s.ctx
+	batches := 0
+	s.upgradeKeeper.SetIncrementalMigration("bank", types.IncrementalMigration{
+		Handler: func(context.Context) (bool, error) {
+			batches++
+			return batches == 3, nil
+		},
+	})
+
+	// migrations must be registered before being started
+	err := s.upgradeKeeper.StartIncrementalMigration(ctx, "staking")
+	s.Require().ErrorIs(err, types.ErrNoIncrementalMigrationFound)
+
+	s.Require().NoError(s.upgradeKeeper.StartIncrementalMigration(ctx, "bank"))
+	err = s.upgradeKeeper.StartIncrementalMigration(ctx, "bank")
+	s.Require().ErrorIs(err, types.ErrIncrementalMigrationInProgress)
+
+	// messages of the module are rejected while the migration is in progress
+	allowed, err := s.upgradeKeeper.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+	allowed, err = s.upgradeKeeper.IsAllowed(ctx, "/cosmos.staking.v1beta1.MsgDelegate")
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+	allowed, err = s.upgradeKeeper.IsAllowed(ctx, "/cosmos.banker.v1.MsgBank")
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	for height := int64(11); height <= 13; height++ {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height})
+		s.Require().NoError(s.upgradeKeeper.RunIncrementalMigrations(ctx))
+	}
+
+	migration, err := s.upgradeKeeper.GetIncrementalMigrationProgress(ctx, "bank")
+	s.Require().NoError(err)
+	s.Require().Equal(types.IncrementalMigrationProgress{ModuleName: "bank", StartHeight: 10, Batches: 3, DoneHeight: 13}, migration)
+
+	// completed migrations are not run anymore
+	s.Require().NoError(s.upgradeKeeper.RunIncrementalMigrations(ctx.WithHeaderInfo(header.Info{Height: 14})))
+	s.Require().Equal(3, batches)
+
+	allowed, err = s.upgradeKeeper.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	res, err := s.upgradeKeeper.IncrementalMigrations(ctx, &types.QueryIncrementalMigrationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.IncrementalMigrationProgress{&migration}, res.Migrations)
+
+	_, err = s.upgradeKeeper.IncrementalMigrations(ctx, &types.QueryIncrementalMigrationsRequest{ModuleName: "staking"})
+	s.Require().ErrorIs(err, types.ErrNoIncrementalMigrationFound)
+
+	// a completed migration can be started again by a later upgrade
+	s.Require().NoError(s.upgradeKeeper.StartIncrementalMigration(ctx, "bank"))
+}
+
+func (s *KeeperTestSuite) TestIncrementalMigrationsMsgTypeURLPrefixes() {
+	s.upgradeKeeper.SetIncrementalMigration("bank", types.IncrementalMigration{
+		Handler:            func(context.Context) (bool, error) { return false, nil },
+		MsgTypeURLPrefixes: []string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	})
+	s.Require().NoError(s.upgradeKeeper.StartIncrementalMigration(s.ctx, "bank"))
+
+	allowed, err := s.upgradeKeeper.IsAllowed(s.ctx, "/cosmos.bank.v1beta1.MsgMultiSend")
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+	allowed, err = s.upgradeKeeper.IsAllowed(s.ctx, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+}
+
+func (s *KeeperTestSuite) TestIncrementalMigrationsNotRegistered() {
+	s.upgradeKeeper.SetIncrementalMigration("bank", types.IncrementalMigration{
+		Handler: func(context.Context) (bool, error) { return false, nil },
+	})
+	s.Require().NoError(s.upgradeKeeper.StartIncrementalMigration(s.ctx, "bank"))
+
+	// a binary without the registered migration cannot resume it
+	k := keeper.NewKeeper(map[int64]bool{}, runtime.NewKVStoreService(s.key), s.encCfg.Codec, s.homeDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	err := k.RunIncrementalMigrations(s.ctx)
+	s.Require().ErrorIs(err, types.ErrNoIncrementalMigrationFound)
+}
+
+func (s *KeeperTestSuite) TestIncrementalMigrationsLoadedOnRestart() {
+	s.upgradeKeeper.SetIncrementalMigration("bank", types.IncrementalMigration{
+		Handler: func(context.Context) (bool, error) { return false, nil },
+	})
+	s.Require().NoError(s.upgradeKeeper.StartIncrementalMigration(s.ctx, "bank"))
+
+	// a restarted node loads the migrations in progress from the store
+	k := keeper.NewKeeper(map[int64]bool{}, runtime.NewKVStoreService(s.key), s.encCfg.Codec, s.homeDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	allowed, err := k.IsAllowed(s.ctx, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+}
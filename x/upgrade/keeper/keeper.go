@@ -46,6 +46,9 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+
+	incrementalMigrations map[string]types.IncrementalMigration // map of module name to incremental migration
+	migrationsInProgress  *migrationsInProgress                 // modules whose incremental migration is in progress, checked by IsAllowed
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -64,6 +67,9 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeService corestore.KVStore
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
 		authority:          authority,
+
+		incrementalMigrations: map[string]types.IncrementalMigration{},
+		migrationsInProgress:  &migrationsInProgress{},
 	}
 
 	return k
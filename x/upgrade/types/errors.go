@@ -16,4 +16,8 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrNoIncrementalMigrationFound error if there is no incremental migration for a module
+	ErrNoIncrementalMigrationFound = errors.Register(ModuleName, 7, "incremental migration not found")
+	// ErrIncrementalMigrationInProgress error if an incremental migration is started while another one of the same module is running
+	ErrIncrementalMigrationInProgress = errors.Register(ModuleName, 8, "incremental migration in progress")
 )
@@ -25,3 +25,25 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx context.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// IncrementalMigrationHandler migrates the next bounded batch of a module's
+// state. It is called once per block, in PreBlock, until it reports that the
+// migration is done. A collections.Migration MigrateBatch method satisfies
+// this signature.
+type IncrementalMigrationHandler func(ctx context.Context) (done bool, err error)
+
+// IncrementalMigration is a module store migration that is spread across
+// multiple blocks after an upgrade, instead of running inside the upgrade
+// block.
+//
+// While the migration is in progress, messages of the module are rejected.
+// By default, a message belongs to the module if its protobuf package
+// contains the module name, e.g. /cosmos.bank.v1beta1.MsgSend belongs to the
+// bank module. MsgTypeURLPrefixes overrides this default.
+type IncrementalMigration struct {
+	// Handler migrates the next batch of the module's state.
+	Handler IncrementalMigrationHandler
+	// MsgTypeURLPrefixes are the prefixes of the message type URLs rejected
+	// while the migration is in progress, e.g. "/cosmos.bank.".
+	MsgTypeURLPrefixes []string
+}
@@ -26,6 +26,9 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// IncrementalMigrationByte is a prefix to look up incremental migrations by module name
+	IncrementalMigrationByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +45,11 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// IncrementalMigrationKey is the key under which the incremental migration of a module is saved
+func IncrementalMigrationKey(moduleName string) []byte {
+	return append([]byte{IncrementalMigrationByte}, moduleName...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -484,6 +484,101 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryIncrementalMigrationsRequest is the request type for the
+// Query/IncrementalMigrations RPC method.
+type QueryIncrementalMigrationsRequest struct {
+	// module_name is a field to query the migration of a specific module.
+	// Leaving this empty will fetch all the migrations from state.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryIncrementalMigrationsRequest) Reset()         { *m = QueryIncrementalMigrationsRequest{} }
+func (m *QueryIncrementalMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncrementalMigrationsRequest) ProtoMessage()    {}
+func (*QueryIncrementalMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryIncrementalMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncrementalMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncrementalMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncrementalMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncrementalMigrationsRequest.Merge(m, src)
+}
+func (m *QueryIncrementalMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncrementalMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncrementalMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncrementalMigrationsRequest proto.InternalMessageInfo
+
+func (m *QueryIncrementalMigrationsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryIncrementalMigrationsResponse is the response type for the
+// Query/IncrementalMigrations RPC method.
+type QueryIncrementalMigrationsResponse struct {
+	// migrations is the list of incremental migrations, ordered by module name.
+	Migrations []*IncrementalMigrationProgress `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+}
+
+func (m *QueryIncrementalMigrationsResponse) Reset()         { *m = QueryIncrementalMigrationsResponse{} }
+func (m *QueryIncrementalMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncrementalMigrationsResponse) ProtoMessage()    {}
+func (*QueryIncrementalMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryIncrementalMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncrementalMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncrementalMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncrementalMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncrementalMigrationsResponse.Merge(m, src)
+}
+func (m *QueryIncrementalMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncrementalMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncrementalMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncrementalMigrationsResponse proto.InternalMessageInfo
+
+func (m *QueryIncrementalMigrationsResponse) GetMigrations() []*IncrementalMigrationProgress {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +590,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryIncrementalMigrationsRequest)(nil), "cosmos.upgrade.v1beta1.QueryIncrementalMigrationsRequest")
+	proto.RegisterType((*QueryIncrementalMigrationsResponse)(nil), "cosmos.upgrade.v1beta1.QueryIncrementalMigrationsResponse")
 }
 
 func init() {
@@ -502,51 +599,56 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x1c, 0x65, 0x0a, 0x82, 0xfc, 0x6a, 0xc0, 0x0c, 0xb1, 0x2e, 0x2b, 0xa9, 0x75, 0x40, 0xc5, 0x48,
-	0x77, 0xa1, 0x35, 0x1c, 0xd0, 0x18, 0x85, 0x44, 0xc5, 0x08, 0xd1, 0x1a, 0x3d, 0x78, 0x69, 0x86,
-	0xee, 0xa4, 0x6d, 0x6c, 0x77, 0x96, 0x9d, 0x59, 0x22, 0x21, 0x78, 0xe0, 0xe4, 0xd1, 0xc4, 0x9b,
-	0x07, 0x6f, 0x26, 0x7e, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0, 0x83,
-	0x98, 0x9d, 0x9d, 0x62, 0x4b, 0x77, 0x0b, 0x7a, 0xeb, 0xec, 0xbc, 0xdf, 0xfb, 0xb3, 0x33, 0x6f,
-	0x0b, 0xa4, 0xc2, 0x45, 0x93, 0x0b, 0x3b, 0xf0, 0xaa, 0x3e, 0x75, 0x98, 0xbd, 0x31, 0xb7, 0xc6,
-	0x24, 0x9d, 0xb3, 0xd7, 0x03, 0xe6, 0x6f, 0x5a, 0x9e, 0xcf, 0x25, 0xc7, 0x99, 0x08, 0x63, 0x69,
-	0x8c, 0xa5, 0x31, 0xe6, 0x44, 0x95, 0xf3, 0x6a, 0x83, 0xd9, 0xd4, 0xab, 0xdb, 0xd4, 0x75, 0xb9,
-	0xa4, 0xb2, 0xce, 0x5d, 0x11, 0x4d, 0x99, 0x53, 0x09, 0xcc, 0x2d, 0x96, 0x08, 0x35, 0x1e, 0xa1,
-	0xca, 0x6a, 0x65, 0x6b, 0x21, 0xb5, 0x20, 0xe3, 0x70, 0xf6, 0x51, 0xe8, 0x62, 0x29, 0xf0, 0x7d,
-	0xe6, 0xca, 0x87, 0x0d, 0xea, 0x96, 0xd8, 0x7a, 0xc0, 0x84, 0x24, 0x0f, 0xc0, 0xe8, 0xde, 0x12,
-	0x1e, 0x77, 0x05, 0xc3, 0xb3, 0x30, 0xe0, 0x35, 0xa8, 0x6b, 0xa0, 0x1c, 0x9a, 0x4e, 0x17, 0x26,
-	0xac, 0x78, 0xf3, 0x96, 0x9a, 0x51, 0x48, 0x92, 0xd7, 0x42, 0xb7, 0x3d, 0xaf, 0x51, 0x67, 0x4e,
-	0x9b, 0x10, 0xc6, 0x30, 0xe0, 0xd2, 0x26, 0x53, 0x64, 0xc3, 0x25, 0xf5, 0x9b, 0x14, 0xb4, 0x78,
-	0x07, 0x5c, 0x8b, 0x67, 0x60, 0xb0, 0xc6, 0xea, 0xd5, 0x9a, 0x54, 0x13, 0xfd, 0x25, 0xbd, 0x22,
-	0xcb, 0x40, 0xd4, 0xcc, 0x93, 0xc8, 0x85, 0xb3, 0x14, 0xa2, 0x5d, 0x11, 0x88, 0xc7, 0x92, 0x4a,
-	0xd6, 0x52, 0x3b, 0x0f, 0xe9, 0x06, 0x15, 0xb2, 0xdc, 0x41, 0x01, 0xe1, 0xa3, 0x7b, 0xea, 0xc9,
-	0x42, 0xca, 0x40, 0xe4, 0x25, 0x4c, 0xf6, 0xa4, 0xd2, 0x4e, 0x56, 0xc0, 0xd0, 0x91, 0x9d, 0x72,
-	0xa5, 0x05, 0x29, 0x8b, 0x10, 0x63, 0xa4, 0x72, 0x68, 0xfa, 0xd4, 0xe2, 0xd8, 0x8f, 0x8f, 0xf9,
-	0xd1, 0xe8, 0xed, 0xe4, 0x85, 0xf3, 0x3c, 0x37, 0x6b, 0x5d, 0x2b, 0x96, 0x32, 0x41, 0x2c, 0x6d,
-	0xa8, 0x7c, 0x7f, 0xe0, 0x24, 0x3a, 0x9d, 0x22, 0x25, 0x30, 0x95, 0xfe, 0x0a, 0x77, 0x82, 0x06,
-	0x7b, 0xca, 0x7c, 0x11, 0x1e, 0x7a, 0x5b, 0x84, 0xa6, 0xda, 0x28, 0xb7, 0xbd, 0x37, 0x88, 0x1e,
-	0xad, 0xd2, 0x26, 0x5b, 0x18, 0xfb, 0xda, 0xad, 0x4a, 0x76, 0x10, 0x9c, 0x8b, 0x25, 0xd5, 0x61,
-	0x56, 0x61, 0x54, 0xb3, 0x6e, 0xe8, 0x2d, 0x03, 0xe5, 0xfa, 0xa7, 0xd3, 0x85, 0x8b, 0x49, 0xc7,
-	0xdb, 0x41, 0x54, 0x1a, 0x69, 0x76, 0xf0, 0xc6, 0x9b, 0x98, 0x81, 0x33, 0xd1, 0xb9, 0x06, 0xb2,
-	0xc6, 0xfd, 0xba, 0xdc, 0xd4, 0x99, 0xe2, 0xd0, 0xf3, 0xe4, 0x2e, 0x64, 0x0e, 0xa3, 0xb5, 0x59,
-	0x03, 0x86, 0xa8, 0xe3, 0xf8, 0x4c, 0x08, 0x1d, 0xbf, 0xb5, 0x8c, 0x25, 0x2a, 0xbc, 0x1d, 0x82,
-	0x13, 0x8a, 0x09, 0xbf, 0x43, 0x90, 0x6e, 0xbb, 0xd1, 0xd8, 0x4e, 0x0a, 0x97, 0x50, 0x0b, 0x73,
-	0xf6, 0xf8, 0x03, 0x91, 0x57, 0x32, 0xb3, 0xf3, 0xed, 0xf7, 0x9b, 0xd4, 0x25, 0x3c, 0x65, 0x27,
-	0xb4, 0xb5, 0x12, 0x0d, 0x95, 0xc3, 0xa2, 0xe0, 0xf7, 0x08, 0xd2, 0x6d, 0xb7, 0xfe, 0x08, 0x83,
-	0xdd, 0x75, 0x3a, 0xc2, 0x60, 0x4c, 0xa1, 0x48, 0x51, 0x19, 0xcc, 0xe3, 0xab, 0x49, 0x06, 0x69,
-	0x34, 0xa4, 0x0c, 0xda, 0x5b, 0xe1, 0xa5, 0xdb, 0xc6, 0x3f, 0x11, 0x64, 0xe2, 0xeb, 0x81, 0x17,
-	0x7a, 0x3a, 0xe8, 0x59, 0x4f, 0xf3, 0xfa, 0x7f, 0xcd, 0xea, 0x20, 0xcb, 0x2a, 0xc8, 0x2d, 0x7c,
-	0xd3, 0xee, 0xfd, 0x5d, 0xec, 0x6a, 0xab, 0xbd, 0xd5, 0xf6, 0x4d, 0xd8, 0x7e, 0x95, 0x42, 0xf8,
-	0x13, 0x82, 0x91, 0xce, 0xa2, 0xe0, 0x42, 0x4f, 0x6b, 0xb1, 0x55, 0x35, 0x8b, 0xff, 0x34, 0xa3,
-	0x63, 0x2c, 0x7e, 0xe9, 0x6e, 0x8e, 0x4a, 0x76, 0x05, 0x5f, 0x4e, 0x4a, 0x76, 0xa8, 0xba, 0xf8,
-	0x03, 0x82, 0xe1, 0x83, 0xda, 0xe0, 0x7c, 0xef, 0x3b, 0x71, 0xa8, 0x8c, 0xa6, 0x75, 0x5c, 0xb8,
-	0x36, 0x7c, 0xa3, 0xdb, 0xf0, 0xbc, 0x32, 0x3c, 0x89, 0x2f, 0x24, 0xde, 0xa9, 0x16, 0xcb, 0xe2,
-	0x9d, 0xcf, 0x7b, 0x59, 0xb4, 0xbb, 0x97, 0x45, 0xbf, 0xf6, 0xb2, 0xe8, 0xf5, 0x7e, 0xb6, 0x6f,
-	0x77, 0x3f, 0xdb, 0xf7, 0x7d, 0x3f, 0xdb, 0xf7, 0x6c, 0xa6, 0x5a, 0x97, 0xb5, 0x60, 0xcd, 0xaa,
-	0xf0, 0x66, 0x8b, 0xe6, 0xaf, 0x8a, 0xfd, 0xe2, 0x80, 0x53, 0x6e, 0x7a, 0x4c, 0xac, 0x0d, 0xaa,
-	0xbf, 0xb4, 0xe2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x53, 0xf4, 0x4d, 0x6f, 0x07, 0x00,
-	0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xd3, 0x48,
+	0x18, 0xed, 0xa4, 0xdd, 0x6e, 0xfb, 0x65, 0xd5, 0xae, 0xa6, 0x6a, 0xd6, 0xf5, 0x56, 0xd9, 0xd4,
+	0xed, 0xee, 0x76, 0xb5, 0x8d, 0x9d, 0x26, 0x55, 0xa5, 0xcd, 0x22, 0x04, 0x2d, 0x02, 0x8a, 0x68,
+	0x55, 0xc2, 0x8f, 0x03, 0x97, 0xc8, 0x8d, 0x47, 0x89, 0x45, 0xfc, 0xa3, 0x9e, 0x71, 0x45, 0xa9,
+	0xca, 0xa1, 0x27, 0x8e, 0x48, 0xdc, 0xb9, 0x21, 0xf1, 0x07, 0x70, 0xe5, 0x8a, 0x10, 0xa7, 0x0a,
+	0x2e, 0x08, 0x71, 0x40, 0x0d, 0x7f, 0x08, 0xf2, 0x78, 0x92, 0x26, 0x8d, 0x6d, 0x42, 0x6f, 0x99,
+	0x99, 0xf7, 0xbd, 0xef, 0xbd, 0x19, 0x7f, 0x4f, 0x01, 0xa5, 0xe6, 0x50, 0xcb, 0xa1, 0x9a, 0xef,
+	0xd6, 0x3d, 0xdd, 0x20, 0xda, 0xde, 0xf2, 0x0e, 0x61, 0xfa, 0xb2, 0xb6, 0xeb, 0x13, 0x6f, 0x5f,
+	0x75, 0x3d, 0x87, 0x39, 0x38, 0x13, 0x62, 0x54, 0x81, 0x51, 0x05, 0x46, 0x9e, 0xad, 0x3b, 0x4e,
+	0xbd, 0x49, 0x34, 0xdd, 0x35, 0x35, 0xdd, 0xb6, 0x1d, 0xa6, 0x33, 0xd3, 0xb1, 0x69, 0x58, 0x25,
+	0x2f, 0xc4, 0x30, 0xb7, 0x59, 0x42, 0xd4, 0x4c, 0x88, 0xaa, 0xf2, 0x95, 0x26, 0x1a, 0xf1, 0x85,
+	0x32, 0x03, 0xbf, 0xdd, 0x0a, 0x54, 0xac, 0xfb, 0x9e, 0x47, 0x6c, 0xb6, 0xdd, 0xd4, 0xed, 0x0a,
+	0xd9, 0xf5, 0x09, 0x65, 0xca, 0x4d, 0x90, 0xfa, 0x8f, 0xa8, 0xeb, 0xd8, 0x94, 0xe0, 0x02, 0x8c,
+	0xb8, 0x4d, 0xdd, 0x96, 0x50, 0x0e, 0x2d, 0xa6, 0x8b, 0xb3, 0x6a, 0xb4, 0x78, 0x95, 0xd7, 0x70,
+	0xa4, 0x92, 0x17, 0x8d, 0x2e, 0xbb, 0x6e, 0xd3, 0x24, 0x46, 0x57, 0x23, 0x8c, 0x61, 0xc4, 0xd6,
+	0x2d, 0xc2, 0xc9, 0xc6, 0x2b, 0xfc, 0xb7, 0x52, 0x04, 0xa9, 0x1f, 0x2e, 0x9a, 0x67, 0x60, 0xb4,
+	0x41, 0xcc, 0x7a, 0x83, 0xf1, 0x8a, 0xe1, 0x8a, 0x58, 0x29, 0x1b, 0xa0, 0xf0, 0x9a, 0xbb, 0xa1,
+	0x0a, 0x63, 0x3d, 0x40, 0xdb, 0xd4, 0xa7, 0xb7, 0x99, 0xce, 0x48, 0xbb, 0xdb, 0x1f, 0x90, 0x6e,
+	0xea, 0x94, 0x55, 0x7b, 0x28, 0x20, 0xd8, 0xba, 0xce, 0x77, 0xca, 0x29, 0x09, 0x29, 0x8f, 0x61,
+	0x3e, 0x91, 0x4a, 0x28, 0xd9, 0x04, 0x49, 0x58, 0x36, 0xaa, 0xb5, 0x36, 0xa4, 0x4a, 0x03, 0x8c,
+	0x94, 0xca, 0xa1, 0xc5, 0x5f, 0xd6, 0xa6, 0x3e, 0xbd, 0xca, 0x4f, 0x86, 0xb7, 0x93, 0xa7, 0xc6,
+	0x83, 0x5c, 0x41, 0x5d, 0x29, 0x55, 0x32, 0x7e, 0x24, 0x6d, 0xd0, 0xf9, 0xc6, 0xc8, 0x18, 0xfa,
+	0x35, 0xa5, 0x54, 0x40, 0xe6, 0xfd, 0x37, 0x1d, 0xc3, 0x6f, 0x92, 0x7b, 0xc4, 0xa3, 0xc1, 0xa3,
+	0x77, 0x59, 0xb0, 0xf8, 0x41, 0xb5, 0xeb, 0xde, 0x20, 0xdc, 0xda, 0xd2, 0x2d, 0x52, 0x9e, 0x7a,
+	0xdf, 0xdf, 0x55, 0x39, 0x42, 0xf0, 0x7b, 0x24, 0xa9, 0x30, 0xb3, 0x05, 0x93, 0x82, 0x75, 0x4f,
+	0x1c, 0x49, 0x28, 0x37, 0xbc, 0x98, 0x2e, 0xfe, 0x19, 0xf7, 0xbc, 0x3d, 0x44, 0x95, 0x09, 0xab,
+	0x87, 0x37, 0x5a, 0xc4, 0x12, 0x4c, 0x87, 0xef, 0xea, 0xb3, 0x86, 0xe3, 0x99, 0x6c, 0x5f, 0x78,
+	0x8a, 0x42, 0xaf, 0x2a, 0xd7, 0x20, 0x73, 0x16, 0x2d, 0xc4, 0x4a, 0xf0, 0xb3, 0x6e, 0x18, 0x1e,
+	0xa1, 0x54, 0xd8, 0x6f, 0x2f, 0xa3, 0x89, 0xae, 0xc0, 0x1c, 0x27, 0xda, 0xb0, 0x6b, 0x1e, 0xb1,
+	0x88, 0xcd, 0xf4, 0xe6, 0xa6, 0x59, 0xf7, 0xc2, 0x59, 0x1a, 0xf4, 0x5a, 0x95, 0x47, 0xa0, 0x24,
+	0xb1, 0x08, 0x69, 0x77, 0x00, 0xac, 0xce, 0xae, 0xb8, 0xc2, 0x95, 0xb8, 0x2b, 0x8c, 0xa2, 0xda,
+	0xf6, 0x9c, 0x7a, 0x60, 0xa5, 0xd2, 0xc5, 0x53, 0x6c, 0x8d, 0xc1, 0x4f, 0xbc, 0x39, 0x7e, 0x8e,
+	0x20, 0xdd, 0x35, 0x93, 0x58, 0x8b, 0xe3, 0x8e, 0x19, 0x6c, 0xb9, 0x30, 0x78, 0x41, 0x68, 0x49,
+	0x59, 0x3a, 0xfa, 0xf0, 0xf5, 0x59, 0xea, 0x2f, 0xbc, 0xa0, 0xc5, 0xe4, 0x4d, 0x2d, 0x2c, 0xaa,
+	0x06, 0xa3, 0x8e, 0x5f, 0x20, 0x48, 0x77, 0xcd, 0xed, 0x77, 0x04, 0xf6, 0x07, 0x82, 0x5c, 0x18,
+	0xbc, 0x40, 0x08, 0x2c, 0x71, 0x81, 0x79, 0xfc, 0x6f, 0x9c, 0x40, 0x3d, 0x2c, 0xe2, 0x02, 0xb5,
+	0x83, 0xe0, 0x7d, 0x0f, 0xf1, 0x67, 0x04, 0x99, 0xe8, 0x01, 0xc7, 0xe5, 0x44, 0x05, 0x89, 0x01,
+	0x23, 0xff, 0x7f, 0xae, 0x5a, 0x61, 0x64, 0x83, 0x1b, 0xb9, 0x84, 0x2f, 0x6a, 0xc9, 0xc9, 0xde,
+	0x97, 0x37, 0xda, 0x41, 0x57, 0xaa, 0x1d, 0x3e, 0x49, 0x21, 0xfc, 0x1a, 0xc1, 0x44, 0xef, 0xa8,
+	0xe3, 0x62, 0xa2, 0xb4, 0xc8, 0xb0, 0x91, 0x4b, 0x3f, 0x54, 0x23, 0x6c, 0xac, 0xbd, 0xeb, 0x9f,
+	0x7d, 0xee, 0xec, 0x1f, 0xfc, 0x77, 0x9c, 0xb3, 0x33, 0xe1, 0x83, 0x5f, 0x22, 0x18, 0xef, 0x0c,
+	0x3e, 0xce, 0x27, 0x7f, 0x13, 0x67, 0xe2, 0x44, 0x56, 0x07, 0x85, 0x0b, 0xc1, 0x17, 0xfa, 0x05,
+	0xaf, 0x72, 0xc1, 0xf3, 0x78, 0x2e, 0xf6, 0x9b, 0xea, 0x88, 0x7b, 0x83, 0x60, 0x3a, 0x32, 0x14,
+	0xf0, 0x7f, 0x89, 0x3a, 0x92, 0xe2, 0x48, 0x2e, 0x9f, 0xa7, 0x54, 0xd8, 0x09, 0xb5, 0x17, 0xb0,
+	0x1a, 0xa7, 0xdd, 0x3c, 0x2d, 0xaf, 0x9e, 0xa6, 0xcc, 0xda, 0xd5, 0xb7, 0x27, 0x59, 0x74, 0x7c,
+	0x92, 0x45, 0x5f, 0x4e, 0xb2, 0xe8, 0x69, 0x2b, 0x3b, 0x74, 0xdc, 0xca, 0x0e, 0x7d, 0x6c, 0x65,
+	0x87, 0xee, 0x2f, 0xd5, 0x4d, 0xd6, 0xf0, 0x77, 0xd4, 0x9a, 0x63, 0xb5, 0x39, 0x4f, 0xaf, 0x4b,
+	0x7b, 0xd8, 0x69, 0xc0, 0xf6, 0x5d, 0x42, 0x77, 0x46, 0xf9, 0xbf, 0x8b, 0xd2, 0xb7, 0x01, 0x00,
+	0x26, 0x9f, 0x92, 0xa9, 0xfa, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -576,6 +678,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// IncrementalMigrations queries the progress of the incremental store
+	// migrations started by upgrades.
+	IncrementalMigrations(ctx context.Context, in *QueryIncrementalMigrationsRequest, opts ...grpc.CallOption) (*QueryIncrementalMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -632,6 +737,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) IncrementalMigrations(ctx context.Context, in *QueryIncrementalMigrationsRequest, opts ...grpc.CallOption) (*QueryIncrementalMigrationsResponse, error) {
+	out := new(QueryIncrementalMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/IncrementalMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -649,6 +763,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// IncrementalMigrations queries the progress of the incremental store
+	// migrations started by upgrades.
+	IncrementalMigrations(context.Context, *QueryIncrementalMigrationsRequest) (*QueryIncrementalMigrationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -670,6 +787,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) IncrementalMigrations(ctx context.Context, req *QueryIncrementalMigrationsRequest) (*QueryIncrementalMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementalMigrations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -765,6 +885,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncrementalMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncrementalMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncrementalMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/IncrementalMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncrementalMigrations(ctx, req.(*QueryIncrementalMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
@@ -790,6 +928,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "IncrementalMigrations",
+			Handler:    _Query_IncrementalMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1089,6 +1231,73 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryIncrementalMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncrementalMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncrementalMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncrementalMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncrementalMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncrementalMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1222,6 +1431,34 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIncrementalMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncrementalMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1966,6 +2203,172 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIncrementalMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncrementalMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncrementalMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncrementalMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncrementalMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncrementalMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, &IncrementalMigrationProgress{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -223,11 +223,59 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// IncrementalMigrationProgress tracks the progress of a module store migration that
+// runs incrementally, one bounded batch per block, after an upgrade.
+type IncrementalMigrationProgress struct {
+	// module_name is the name of the module being migrated.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// start_height is the height at which the migration was started.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// batches is the number of batches processed so far.
+	Batches uint64 `protobuf:"varint,3,opt,name=batches,proto3" json:"batches,omitempty"`
+	// done_height is the height at which the migration completed, or zero while
+	// the migration is in progress.
+	DoneHeight int64 `protobuf:"varint,4,opt,name=done_height,json=doneHeight,proto3" json:"done_height,omitempty"`
+}
+
+func (m *IncrementalMigrationProgress) Reset()         { *m = IncrementalMigrationProgress{} }
+func (m *IncrementalMigrationProgress) String() string { return proto.CompactTextString(m) }
+func (*IncrementalMigrationProgress) ProtoMessage()    {}
+func (*IncrementalMigrationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *IncrementalMigrationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncrementalMigrationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncrementalMigrationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncrementalMigrationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementalMigrationProgress.Merge(m, src)
+}
+func (m *IncrementalMigrationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncrementalMigrationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementalMigrationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncrementalMigrationProgress proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*IncrementalMigrationProgress)(nil), "cosmos.upgrade.v1beta1.IncrementalMigrationProgress")
 }
 
 func init() {
@@ -235,41 +283,46 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xb5, 0x6e, 0x51, 0x2f, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0x08, 0x1c, 0xcb, 0x62, 0x88,
-	0x2a, 0x62, 0xd3, 0x96, 0x29, 0x0c, 0x88, 0x64, 0xe3, 0x8f, 0x54, 0x5c, 0xe8, 0xc0, 0x12, 0x5d,
-	0xec, 0x8b, 0x63, 0x61, 0xdf, 0x59, 0xf6, 0x25, 0x90, 0xaf, 0xc0, 0xd4, 0x8f, 0xc0, 0x88, 0x98,
-	0x3a, 0xf4, 0x43, 0x44, 0x4c, 0x15, 0x13, 0x12, 0x12, 0x7f, 0x92, 0xa1, 0x6c, 0x7c, 0x05, 0x74,
-	0x77, 0x76, 0x65, 0x41, 0x41, 0x0c, 0x2c, 0xd6, 0x7b, 0xef, 0xde, 0xcf, 0xbf, 0xdf, 0xfb, 0xdd,
-	0x3d, 0x78, 0xd3, 0x67, 0x79, 0xc2, 0x72, 0x77, 0x9c, 0x86, 0x19, 0x0e, 0x88, 0x3b, 0xd9, 0x19,
-	0x10, 0x8e, 0x77, 0xca, 0xdc, 0x49, 0x33, 0xc6, 0x99, 0xb1, 0xa1, 0xba, 0x9c, 0xb2, 0x5a, 0x74,
-	0x35, 0xb6, 0x42, 0xc6, 0xc2, 0x98, 0xb8, 0xb2, 0x6b, 0x30, 0x1e, 0xba, 0x98, 0x4e, 0x15, 0xa4,
-	0x51, 0x0f, 0x59, 0xc8, 0x64, 0xe8, 0x8a, 0xa8, 0xa8, 0x36, 0x7f, 0x05, 0xf0, 0x28, 0x21, 0x39,
-	0xc7, 0x49, 0x5a, 0x34, 0x6c, 0x29, 0xa6, 0xbe, 0x42, 0x16, 0xb4, 0xea, 0xe8, 0x0a, 0x4e, 0x22,
-	0xca, 0x5c, 0xf9, 0x55, 0x25, 0xfb, 0x07, 0x80, 0xfa, 0x7e, 0x8c, 0xa9, 0x61, 0x40, 0x9d, 0xe2,
-	0x84, 0x20, 0x60, 0x81, 0xd6, 0x9a, 0x27, 0x63, 0xe3, 0x1e, 0xd4, 0xc5, 0xdf, 0xd1, 0x92, 0x05,
-	0x5a, 0xb5, 0xdd, 0x86, 0xa3, 0xa8, 0x9d, 0x92, 0xda, 0x79, 0x5a, 0x52, 0x77, 0xd7, 0x67, 0x9f,
-	0x9b, 0xda, 0xd1, 0x97, 0x26, 0x78, 0x7b, 0x76, 0xbc, 0x0d, 0x10, 0xf0, 0x24, 0xd0, 0xd8, 0x80,
-	0xab, 0x23, 0x12, 0x85, 0x23, 0x8e, 0x96, 0x2d, 0xd0, 0x5a, 0xf6, 0x8a, 0x4c, 0x90, 0x45, 0x74,
-	0xc8, 0x90, 0xae, 0xc8, 0x44, 0x6c, 0x3c, 0x82, 0xd7, 0x0a, 0x73, 0x82, 0xbe, 0x1f, 0x47, 0x84,
-	0xf2, 0x7e, 0xce, 0x31, 0x27, 0x68, 0x45, 0xb2, 0xd7, 0x7f, 0x63, 0xbf, 0x4f, 0xa7, 0xdd, 0x25,
-	0x04, 0xbc, 0xab, 0x25, 0xac, 0x27, 0x51, 0x07, 0x02, 0xd4, 0x41, 0xdf, 0xdf, 0x34, 0xc1, 0xeb,
-	0xb3, 0xe3, 0xed, 0x75, 0xe5, 0x40, 0x3b, 0x0f, 0x5e, 0xb8, 0x62, 0x50, 0xfb, 0x13, 0x80, 0x9b,
-	0x07, 0x6c, 0xc8, 0x5f, 0xe2, 0x8c, 0x3c, 0x53, 0xc8, 0xfd, 0x8c, 0xa5, 0x2c, 0xc7, 0xb1, 0x51,
-	0x87, 0x2b, 0x3c, 0xe2, 0x71, 0xe9, 0x82, 0x4a, 0x0c, 0x0b, 0xd6, 0x02, 0x92, 0xfb, 0x59, 0x94,
-	0xf2, 0x88, 0x51, 0xe9, 0xc6, 0x9a, 0x57, 0x2d, 0x19, 0x77, 0xa1, 0x9e, 0xc6, 0x98, 0xca, 0x29,
-	0x6b, 0xbb, 0xd7, 0x9d, 0x8b, 0x2f, 0xdb, 0x11, 0xfc, 0xdd, 0x35, 0x61, 0x95, 0xb4, 0xc9, 0x93,
-	0xa0, 0xce, 0x43, 0x21, 0xf5, 0xfd, 0x49, 0xbb, 0x51, 0xa0, 0x42, 0x36, 0x39, 0x47, 0xf4, 0x18,
-	0xe5, 0x84, 0x72, 0x31, 0x88, 0x5d, 0x19, 0xe4, 0x0f, 0xfa, 0x11, 0xb0, 0xdf, 0x01, 0x78, 0xa3,
-	0x87, 0xa9, 0x4f, 0xe2, 0xff, 0x3c, 0x63, 0xe7, 0xc9, 0xbf, 0xc9, 0x6c, 0x55, 0x64, 0xfe, 0x55,
-	0x08, 0x02, 0xf6, 0x21, 0xbc, 0xfc, 0x98, 0x05, 0xe3, 0x98, 0x1c, 0x92, 0x2c, 0x17, 0x3e, 0x5e,
-	0xf4, 0x08, 0x11, 0xbc, 0x34, 0x51, 0xc7, 0x52, 0x95, 0xee, 0x95, 0x69, 0x67, 0x53, 0x28, 0xfa,
-	0x70, 0xd2, 0xae, 0x5c, 0xb1, 0x75, 0xdb, 0xb9, 0xb3, 0xd7, 0x7d, 0x30, 0xfb, 0x66, 0x6a, 0xb3,
-	0xb9, 0x09, 0x4e, 0xe7, 0x26, 0xf8, 0x3a, 0x37, 0xc1, 0xd1, 0xc2, 0xd4, 0x4e, 0x17, 0xa6, 0xf6,
-	0x71, 0x61, 0x6a, 0xcf, 0x6f, 0x85, 0x11, 0x1f, 0x8d, 0x07, 0x8e, 0xcf, 0x92, 0x62, 0x3d, 0xdc,
-	0x8a, 0xe6, 0x57, 0xe7, 0x8b, 0xcc, 0xa7, 0x29, 0xc9, 0x07, 0xab, 0xf2, 0xbd, 0xed, 0xfd, 0x0c,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x7b, 0xab, 0xbf, 0xe7, 0x03, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0xb4, 0x6e, 0xab, 0x4e, 0xbe, 0x4f, 0x15, 0xa6, 0xb4, 0xd3, 0xa8, 0x38, 0xc1, 0x62,
+	0x11, 0x55, 0xd4, 0xa6, 0x2d, 0xab, 0xb0, 0x40, 0xa4, 0x1b, 0xfe, 0x8a, 0x8a, 0x0b, 0x5d, 0xb0,
+	0x89, 0x26, 0xf6, 0xd4, 0xb1, 0xb0, 0x67, 0x2c, 0xcf, 0x24, 0x90, 0x57, 0x60, 0xd5, 0x47, 0x40,
+	0x62, 0x83, 0x58, 0x75, 0xd1, 0x87, 0x88, 0x58, 0x55, 0xac, 0x90, 0x90, 0xf8, 0x49, 0x16, 0x65,
+	0xc7, 0x2b, 0xa0, 0x99, 0xb1, 0x2b, 0x0b, 0x0a, 0x62, 0xc1, 0x26, 0xba, 0xf7, 0xfa, 0x9e, 0x39,
+	0xe7, 0x9e, 0xb9, 0x13, 0x78, 0xd5, 0x67, 0x3c, 0x61, 0xdc, 0xed, 0xa7, 0x61, 0x86, 0x03, 0xe2,
+	0x0e, 0x36, 0xba, 0x44, 0xe0, 0x8d, 0x22, 0x77, 0xd2, 0x8c, 0x09, 0x66, 0x2e, 0xe9, 0x2e, 0xa7,
+	0xa8, 0xe6, 0x5d, 0xb5, 0x95, 0x90, 0xb1, 0x30, 0x26, 0xae, 0xea, 0xea, 0xf6, 0x0f, 0x5c, 0x4c,
+	0x87, 0x1a, 0x52, 0x5b, 0x0c, 0x59, 0xc8, 0x54, 0xe8, 0xca, 0x28, 0xaf, 0xd6, 0x7f, 0x06, 0x88,
+	0x28, 0x21, 0x5c, 0xe0, 0x24, 0xcd, 0x1b, 0x56, 0x34, 0x53, 0x47, 0x23, 0x73, 0x5a, 0xfd, 0xe9,
+	0x02, 0x4e, 0x22, 0xca, 0x5c, 0xf5, 0xab, 0x4b, 0xf6, 0x77, 0x00, 0x8d, 0xdd, 0x18, 0x53, 0xd3,
+	0x84, 0x06, 0xc5, 0x09, 0x41, 0xa0, 0x01, 0x9a, 0xf3, 0x9e, 0x8a, 0xcd, 0x5b, 0xd0, 0x90, 0xa7,
+	0xa3, 0xa9, 0x06, 0x68, 0x56, 0x37, 0x6b, 0x8e, 0xa6, 0x76, 0x0a, 0x6a, 0xe7, 0x71, 0x41, 0xdd,
+	0x5e, 0x18, 0x7d, 0xaa, 0x57, 0x0e, 0x3f, 0xd7, 0xc1, 0x9b, 0xd3, 0xa3, 0x35, 0x80, 0x80, 0xa7,
+	0x80, 0xe6, 0x12, 0x9c, 0xed, 0x91, 0x28, 0xec, 0x09, 0x34, 0xdd, 0x00, 0xcd, 0x69, 0x2f, 0xcf,
+	0x24, 0x59, 0x44, 0x0f, 0x18, 0x32, 0x34, 0x99, 0x8c, 0xcd, 0x07, 0xf0, 0x52, 0x6e, 0x4e, 0xd0,
+	0xf1, 0xe3, 0x88, 0x50, 0xd1, 0xe1, 0x02, 0x0b, 0x82, 0x66, 0x14, 0xfb, 0xe2, 0x2f, 0xec, 0xb7,
+	0xe9, 0xb0, 0x3d, 0x85, 0x80, 0x77, 0xb1, 0x80, 0x6d, 0x2b, 0xd4, 0x9e, 0x04, 0xb5, 0xd0, 0xb7,
+	0x57, 0x75, 0xf0, 0xf2, 0xf4, 0x68, 0x6d, 0x41, 0x3b, 0xb0, 0xce, 0x83, 0x67, 0xae, 0x1c, 0xd4,
+	0xfe, 0x08, 0xe0, 0xf2, 0x1e, 0x3b, 0x10, 0xcf, 0x71, 0x46, 0x9e, 0x68, 0xe4, 0x6e, 0xc6, 0x52,
+	0xc6, 0x71, 0x6c, 0x2e, 0xc2, 0x19, 0x11, 0x89, 0xb8, 0x70, 0x41, 0x27, 0x66, 0x03, 0x56, 0x03,
+	0xc2, 0xfd, 0x2c, 0x4a, 0x45, 0xc4, 0xa8, 0x72, 0x63, 0xde, 0x2b, 0x97, 0xcc, 0x9b, 0xd0, 0x48,
+	0x63, 0x4c, 0xd5, 0x94, 0xd5, 0xcd, 0x55, 0xe7, 0xfc, 0xcb, 0x76, 0x24, 0x7f, 0x7b, 0x5e, 0x5a,
+	0xa5, 0x6c, 0xf2, 0x14, 0xa8, 0x75, 0x5f, 0x4a, 0x7d, 0x77, 0xbc, 0x5e, 0xcb, 0x51, 0x21, 0x1b,
+	0x9c, 0x21, 0xb6, 0x19, 0x15, 0x84, 0x0a, 0x39, 0x88, 0x5d, 0x1a, 0xe4, 0x37, 0xfa, 0x11, 0xb0,
+	0xdf, 0x02, 0x78, 0x79, 0x1b, 0x53, 0x9f, 0xc4, 0xff, 0x78, 0xc6, 0xd6, 0xa3, 0xbf, 0x93, 0xd9,
+	0x2c, 0xc9, 0xfc, 0xa3, 0x10, 0x04, 0xec, 0x7d, 0xf8, 0xff, 0x0e, 0x0b, 0xfa, 0x31, 0xd9, 0x27,
+	0x19, 0x8f, 0xd8, 0xf9, 0x4b, 0x88, 0xe0, 0xdc, 0x40, 0x7f, 0x56, 0xaa, 0x0c, 0xaf, 0x48, 0x5b,
+	0xcb, 0x52, 0xd1, 0xfb, 0xe3, 0xf5, 0xd2, 0x15, 0x37, 0xae, 0x3b, 0x37, 0xb6, 0xec, 0xd7, 0x00,
+	0xae, 0xde, 0xa5, 0x7e, 0x46, 0x12, 0x42, 0x05, 0x8e, 0x77, 0xa2, 0x30, 0xc3, 0x72, 0x86, 0xdd,
+	0x8c, 0x85, 0x19, 0xe1, 0xdc, 0xac, 0xc3, 0x6a, 0xa2, 0x88, 0x3b, 0x25, 0x3a, 0xa8, 0x4b, 0x0f,
+	0x25, 0xe9, 0x15, 0xf8, 0x1f, 0x17, 0x38, 0x13, 0x9d, 0x7c, 0x7d, 0xa7, 0xd4, 0xfa, 0x56, 0x55,
+	0xed, 0x8e, 0xde, 0x61, 0x04, 0xe7, 0xba, 0x58, 0xf8, 0x3d, 0xc2, 0xd5, 0xb5, 0x1b, 0x5e, 0x91,
+	0xca, 0xd3, 0x03, 0x46, 0x49, 0x81, 0x35, 0x14, 0x16, 0xca, 0x92, 0x86, 0xb6, 0x0c, 0x29, 0xbc,
+	0x7d, 0x6f, 0xf4, 0xd5, 0xaa, 0x8c, 0xc6, 0x16, 0x38, 0x19, 0x5b, 0xe0, 0xcb, 0xd8, 0x02, 0x87,
+	0x13, 0xab, 0x72, 0x32, 0xb1, 0x2a, 0x1f, 0x26, 0x56, 0xe5, 0xe9, 0xb5, 0x30, 0x12, 0xbd, 0x7e,
+	0xd7, 0xf1, 0x59, 0x92, 0x3f, 0x62, 0xb7, 0xe4, 0xec, 0x8b, 0xb3, 0xbf, 0x1b, 0x31, 0x4c, 0x09,
+	0xef, 0xce, 0xaa, 0x57, 0xb1, 0xf5, 0x63, 0x00, 0x8a, 0xe2, 0x94, 0xf1, 0x8d, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -392,6 +445,39 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *IncrementalMigrationProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IncrementalMigrationProgress)
+	if !ok {
+		that2, ok := that.(IncrementalMigrationProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.Batches != that1.Batches {
+		return false
+	}
+	if this.DoneHeight != that1.DoneHeight {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -573,6 +659,51 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IncrementalMigrationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementalMigrationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrementalMigrationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DoneHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.DoneHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Batches != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Batches))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -662,6 +793,28 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *IncrementalMigrationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.StartHeight))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovUpgrade(uint64(m.Batches))
+	}
+	if m.DoneHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.DoneHeight))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1232,6 +1385,145 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IncrementalMigrationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementalMigrationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementalMigrationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneHeight", wireType)
+			}
+			m.DoneHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoneHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0